@@ -0,0 +1,274 @@
+// Command hashiwich-schema prints an OpenAPI description of the objects the
+// hw provider's mock backend stores, derived from the resource schemas, so
+// Terraform schemas can be compared side by side with an API contract.
+//
+// Usage:
+//
+//	go run ./cmd/hashiwich-schema [-output openapi.json] [-data-sources]
+//
+// Each resource becomes a component schema named after its Terraform type
+// (hw_store, hw_sandwich, ...). Required arguments are listed as required,
+// attributes only the provider sets are marked readOnly, whole-number
+// attributes are integers and dollar amounts are multiples of a cent.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/bevelwork/terraform-provider-hashiwich/internal/provider"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// jsonSchema is the subset of JSON Schema (as used by OpenAPI 3.1) the
+// Terraform type system maps onto.
+type jsonSchema struct {
+	Type                 string                 `json:"type,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	MultipleOf           float64                `json:"multipleOf,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	AdditionalProperties *jsonSchema            `json:"additionalProperties,omitempty"`
+	UniqueItems          bool                   `json:"uniqueItems,omitempty"`
+	ReadOnly             bool                   `json:"readOnly,omitempty"`
+	WriteOnly            bool                   `json:"writeOnly,omitempty"`
+	Deprecated           bool                   `json:"deprecated,omitempty"`
+}
+
+type openAPIDocument struct {
+	OpenAPI    string            `json:"openapi"`
+	Info       openAPIInfo       `json:"info"`
+	Components openAPIComponents `json:"components"`
+}
+
+type openAPIInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Version     string `json:"version"`
+}
+
+type openAPIComponents struct {
+	Schemas map[string]*jsonSchema `json:"schemas"`
+}
+
+func main() {
+	var output string
+	var dataSources bool
+
+	flag.StringVar(&output, "output", "", "file to write the OpenAPI document to (default stdout)")
+	flag.BoolVar(&dataSources, "data-sources", false, "also describe the data sources' results")
+	flag.Parse()
+
+	doc, err := describe(context.Background(), dataSources)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	encoded, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	encoded = append(encoded, '\n')
+
+	if output == "" {
+		_, err = os.Stdout.Write(encoded)
+	} else {
+		err = os.WriteFile(output, encoded, 0o644)
+	}
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+}
+
+// describe builds the OpenAPI document from the provider's protocol schema,
+// refined with the framework schemas' types.
+func describe(ctx context.Context, dataSources bool) (*openAPIDocument, error) {
+	server, err := providerserver.NewProtocol6WithError(provider.New("dev")())()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			return nil, fmt.Errorf("%s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	doc := &openAPIDocument{
+		OpenAPI: "3.1.0",
+		Info: openAPIInfo{
+			Title:       "Hashiwich mock backend",
+			Description: "Objects stored by the hw provider's mock backend, derived from its Terraform resource schemas.",
+			Version:     "dev",
+		},
+		Components: openAPIComponents{Schemas: map[string]*jsonSchema{}},
+	}
+	for name, schema := range resp.ResourceSchemas {
+		doc.Components.Schemas[name] = blockSchema(schema.Block)
+	}
+	if dataSources {
+		for name, schema := range resp.DataSourceSchemas {
+			doc.Components.Schemas["data."+name] = blockSchema(schema.Block)
+		}
+	}
+
+	// The protocol schema only knows numbers; the framework types tell
+	// integers and money apart
+	p := provider.New("dev")()
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+		var metadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "hw"}, &metadata)
+		var schema resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schema)
+		refine(doc.Components.Schemas[metadata.TypeName], schema.Schema.Type())
+	}
+	if dataSources {
+		for _, newDataSource := range p.DataSources(ctx) {
+			d := newDataSource()
+			var metadata datasource.MetadataResponse
+			d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "hw"}, &metadata)
+			var schema datasource.SchemaResponse
+			d.Schema(ctx, datasource.SchemaRequest{}, &schema)
+			refine(doc.Components.Schemas["data."+metadata.TypeName], schema.Schema.Type())
+		}
+	}
+	return doc, nil
+}
+
+// refine narrows the number properties of schema using the framework type
+// typ describes it with.
+func refine(schema *jsonSchema, typ attr.Type) {
+	if schema == nil {
+		return
+	}
+
+	switch t := typ.(type) {
+	case provider.MoneyType:
+		schema.MultipleOf = 0.01
+	case basetypes.Int64Typable:
+		schema.Type = "integer"
+	case basetypes.ObjectTypable:
+		object, ok := t.(types.ObjectType)
+		if !ok {
+			return
+		}
+		for name, attributeType := range object.AttrTypes {
+			refine(schema.Properties[name], attributeType)
+		}
+	case attr.TypeWithElementType:
+		if schema.Items != nil {
+			refine(schema.Items, t.ElementType())
+		} else {
+			refine(schema.AdditionalProperties, t.ElementType())
+		}
+	}
+}
+
+// blockSchema describes a schema block as an object. Only the first paragraph
+// of the block's description is kept; the rest is usage examples.
+func blockSchema(block *tfprotov6.SchemaBlock) *jsonSchema {
+	schema := attributesSchema(block.Attributes)
+	schema.Description, _, _ = strings.Cut(block.Description, "\n\n")
+	schema.Deprecated = block.Deprecated
+
+	for _, nested := range block.BlockTypes {
+		object := blockSchema(nested.Block)
+		switch nested.Nesting {
+		case tfprotov6.SchemaNestedBlockNestingModeList:
+			schema.Properties[nested.TypeName] = &jsonSchema{Type: "array", Items: object}
+		case tfprotov6.SchemaNestedBlockNestingModeSet:
+			schema.Properties[nested.TypeName] = &jsonSchema{Type: "array", Items: object, UniqueItems: true}
+		case tfprotov6.SchemaNestedBlockNestingModeMap:
+			schema.Properties[nested.TypeName] = &jsonSchema{Type: "object", AdditionalProperties: object}
+		default:
+			schema.Properties[nested.TypeName] = object
+		}
+	}
+	return schema
+}
+
+// attributesSchema describes a set of attributes as an object's properties.
+func attributesSchema(attributes []*tfprotov6.SchemaAttribute) *jsonSchema {
+	schema := &jsonSchema{Type: "object", Properties: map[string]*jsonSchema{}}
+	for _, attribute := range attributes {
+		var property *jsonSchema
+		if attribute.NestedType != nil {
+			property = nestedSchema(attribute.NestedType)
+		} else {
+			property = typeSchema(attribute.Type)
+		}
+		property.Description = attribute.Description
+		property.ReadOnly = attribute.Computed && !attribute.Optional
+		property.WriteOnly = attribute.WriteOnly
+		property.Deprecated = attribute.Deprecated
+
+		schema.Properties[attribute.Name] = property
+		if attribute.Required {
+			schema.Required = append(schema.Required, attribute.Name)
+		}
+	}
+	return schema
+}
+
+// nestedSchema describes a nested attribute according to its nesting mode.
+func nestedSchema(object *tfprotov6.SchemaObject) *jsonSchema {
+	schema := attributesSchema(object.Attributes)
+	switch object.Nesting {
+	case tfprotov6.SchemaObjectNestingModeList:
+		return &jsonSchema{Type: "array", Items: schema}
+	case tfprotov6.SchemaObjectNestingModeSet:
+		return &jsonSchema{Type: "array", Items: schema, UniqueItems: true}
+	case tfprotov6.SchemaObjectNestingModeMap:
+		return &jsonSchema{Type: "object", AdditionalProperties: schema}
+	default:
+		return schema
+	}
+}
+
+// typeSchema maps a Terraform type onto its JSON Schema equivalent.
+func typeSchema(typ tftypes.Type) *jsonSchema {
+	switch t := typ.(type) {
+	case tftypes.List:
+		return &jsonSchema{Type: "array", Items: typeSchema(t.ElementType)}
+	case tftypes.Set:
+		return &jsonSchema{Type: "array", Items: typeSchema(t.ElementType), UniqueItems: true}
+	case tftypes.Map:
+		return &jsonSchema{Type: "object", AdditionalProperties: typeSchema(t.ElementType)}
+	case tftypes.Object:
+		schema := &jsonSchema{Type: "object", Properties: map[string]*jsonSchema{}}
+		for name, attributeType := range t.AttributeTypes {
+			schema.Properties[name] = typeSchema(attributeType)
+		}
+		return schema
+	}
+
+	switch {
+	case typ.Is(tftypes.String):
+		return &jsonSchema{Type: "string"}
+	case typ.Is(tftypes.Number):
+		return &jsonSchema{Type: "number"}
+	case typ.Is(tftypes.Bool):
+		return &jsonSchema{Type: "boolean"}
+	default:
+		// Dynamic values may hold any type
+		return &jsonSchema{}
+	}
+}
@@ -37,7 +37,7 @@ resource "hw_oven" "budget_oven" {
 
 resource "hw_cook" "budget_cook_1" {
   name        = "Cook 1"
-  experience  = "junior" # $120/day
+  experience  = "junior" # $600/week
   description = "Junior cook for budget configuration"
 }
 
@@ -61,7 +61,7 @@ resource "hw_fridge" "budget_fridge" {
 # Calculate total cost for budget configuration
 locals {
   opt_budget_oven_cost   = hw_oven.budget_oven.cost
-  opt_budget_cook_cost   = hw_cook.budget_cook_1.cost
+  opt_budget_cook_cost   = hw_cook.budget_cook_1.weekly_cost
   opt_budget_tables_cost = hw_tables.budget_tables.cost
   opt_budget_chairs_cost = hw_chairs.budget_chairs.cost
   opt_budget_fridge_cost = hw_fridge.budget_fridge.cost
@@ -85,13 +85,13 @@ resource "hw_oven" "balanced_oven" {
 
 resource "hw_cook" "balanced_cook_1" {
   name        = "Cook 1"
-  experience  = "experienced" # $160/day
+  experience  = "experienced" # $800/week
   description = "Experienced cook for balanced configuration"
 }
 
 resource "hw_cook" "balanced_cook_2" {
   name        = "Cook 2"
-  experience  = "junior" # $120/day
+  experience  = "junior" # $600/week
   description = "Junior cook for balanced configuration"
 }
 
@@ -114,7 +114,7 @@ resource "hw_fridge" "balanced_fridge" {
 
 locals {
   opt_balanced_oven_cost   = hw_oven.balanced_oven.cost
-  opt_balanced_cook_cost   = hw_cook.balanced_cook_1.cost + hw_cook.balanced_cook_2.cost
+  opt_balanced_cook_cost   = hw_cook.balanced_cook_1.weekly_cost + hw_cook.balanced_cook_2.weekly_cost
   opt_balanced_tables_cost = hw_tables.balanced_tables.cost
   opt_balanced_chairs_cost = hw_chairs.balanced_chairs.cost
   opt_balanced_fridge_cost = hw_fridge.balanced_fridge.cost
@@ -138,13 +138,13 @@ resource "hw_oven" "capacity_oven" {
 
 resource "hw_cook" "capacity_cook_1" {
   name        = "Cook 1"
-  experience  = "expert" # $200/day
+  experience  = "expert" # $1000/week
   description = "Expert cook for high capacity"
 }
 
 resource "hw_cook" "capacity_cook_2" {
   name        = "Cook 2"
-  experience  = "experienced" # $160/day
+  experience  = "experienced" # $800/week
   description = "Experienced cook for high capacity"
 }
 
@@ -167,7 +167,7 @@ resource "hw_fridge" "capacity_fridge" {
 
 locals {
   opt_capacity_oven_cost   = hw_oven.capacity_oven.cost
-  opt_capacity_cook_cost   = hw_cook.capacity_cook_1.cost + hw_cook.capacity_cook_2.cost
+  opt_capacity_cook_cost   = hw_cook.capacity_cook_1.weekly_cost + hw_cook.capacity_cook_2.weekly_cost
   opt_capacity_tables_cost = hw_tables.capacity_tables.cost
   opt_capacity_chairs_cost = hw_chairs.capacity_chairs.cost
   opt_capacity_fridge_cost = hw_fridge.capacity_fridge.cost
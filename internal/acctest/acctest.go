@@ -0,0 +1,169 @@
+// Package acctest helps write acceptance tests against the hw provider
+// without copying its provider factories: it starts the provider with a
+// seeded registry, renders the provider block with the pricing settings a
+// test needs, and offers canned configurations of common resources.
+//
+// Tests with a backend_path get it from BackendPath, a new file per test
+// unless HW_ACC_BACKEND_PATH names a shared one. Sweepers clear a shared
+// backend of the records earlier runs left behind:
+//
+//	go test ./internal/acctest -sweep=/path/to/backend.json
+//
+// A test combines them in a resource.TestCase:
+//
+//	resource.Test(t, resource.TestCase{
+//		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(),
+//		Steps: []resource.TestStep{{
+//			Config: acctest.Config(acctest.ProviderConfig{Upcharge: "0.50"}, acctest.Store),
+//		}},
+//	})
+package acctest
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/bevelwork/terraform-provider-hashiwich/internal/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// ProviderName is the name configurations refer to the provider by.
+const ProviderName = "hw"
+
+// ProtoV6ProviderFactories returns the provider factories for a
+// resource.TestCase. Terraform starts a provider server per command.
+func ProtoV6ProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	return ProtoV6ProviderFactoriesWithRecords(nil)
+}
+
+// ProtoV6ProviderFactoriesWithRecords is ProtoV6ProviderFactories with every
+// provider server's registry seeded with records, keyed by ID. Resources that
+// reference others by ID then find these records, as if the configuration
+// managed them; see CookRecord.
+func ProtoV6ProviderFactoriesWithRecords(records map[string]any) map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		ProviderName: providerserver.NewProtocol6WithError(provider.NewWithRecords("test", records)()),
+	}
+}
+
+// BackendPathEnv names the environment variable pointing tests at a shared
+// backend file, which the sweepers clear.
+const BackendPathEnv = "HW_ACC_BACKEND_PATH"
+
+// BackendPath returns the backend file for a test's ProviderConfig: the one
+// BackendPathEnv names, or else a new file in the test's temporary directory.
+func BackendPath(t testing.TB) string {
+	if path := os.Getenv(BackendPathEnv); path != "" {
+		return path
+	}
+	return filepath.Join(t.TempDir(), "backend.json")
+}
+
+// ProviderConfig holds the provider arguments a test sets. Empty fields are
+// left out of the provider block, so the provider's defaults apply.
+type ProviderConfig struct {
+	// Upcharge is the flat dollar amount added to every price, such as "0.50"
+	Upcharge string
+	// Seed seeds everything the provider randomizes
+	Seed int64
+	// PriceOverrides replaces base prices, keyed by item, such as
+	// {"sandwich": "6.50"}
+	PriceOverrides map[string]string
+	// BackendPath is the file the provider keeps its records in between
+	// commands, usually from BackendPath; see provider.DeleteBackendRecord
+	BackendPath string
+	// TrashRetention keeps deleted records in the provider's trash for the
+	// given duration, such as "1h"; it needs BackendPath
+	TrashRetention string
+}
+
+// String renders c as a provider block.
+func (c ProviderConfig) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "provider %q {\n", ProviderName)
+	if c.Upcharge != "" {
+		fmt.Fprintf(&b, "  upcharge = %s\n", c.Upcharge)
+	}
+	if c.Seed != 0 {
+		fmt.Fprintf(&b, "  seed = %d\n", c.Seed)
+	}
+	if len(c.PriceOverrides) > 0 {
+		b.WriteString("  price_overrides = {\n")
+		for _, item := range slices.Sorted(maps.Keys(c.PriceOverrides)) {
+			fmt.Fprintf(&b, "    %s = %s\n", item, c.PriceOverrides[item])
+		}
+		b.WriteString("  }\n")
+	}
+	if c.BackendPath != "" {
+		fmt.Fprintf(&b, "  backend_path = %q\n", c.BackendPath)
+	}
+	if c.TrashRetention != "" {
+		fmt.Fprintf(&b, "  trash_retention = %q\n", c.TrashRetention)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// Config joins the provider block for c with configuration fragments, such as
+// the canned fixtures, into a test step's configuration.
+func Config(c ProviderConfig, fragments ...string) string {
+	return strings.Join(append([]string{c.String()}, fragments...), "\n")
+}
+
+// StoreComponents configures one of each resource hw_store requires, all
+// named fixture, for tests of resources that reference them.
+const StoreComponents = `
+resource "hw_oven" "fixture" {
+  type = "commercial"
+}
+
+resource "hw_cook" "fixture" {
+  name       = "Fixture Cook"
+  experience = "experienced"
+}
+
+resource "hw_tables" "fixture" {
+  quantity = 10
+  size     = "medium"
+}
+
+resource "hw_chairs" "fixture" {
+  quantity = 40
+  style    = "comfortable"
+}
+
+resource "hw_fridge" "fixture" {
+  size = "large"
+}
+`
+
+// Store configures hw_store.fixture with StoreComponents.
+const Store = StoreComponents + `
+resource "hw_store" "fixture" {
+  name      = "Fixture Store"
+  oven_id   = hw_oven.fixture.id
+  cook_ids  = [hw_cook.fixture.id]
+  tables_id = hw_tables.fixture.id
+  chairs_id = hw_chairs.fixture.id
+  fridge_id = hw_fridge.fixture.id
+}
+`
+
+// CookRecord returns a registry record of an hw_cook with the given ID and
+// experience level (junior, experienced or expert), for
+// ProtoV6ProviderFactoriesWithRecords. Resources read the experience from it,
+// as hw_store does for its capacity.
+func CookRecord(id, experience string) provider.CookResourceModel {
+	return provider.CookResourceModel{
+		Id:         types.StringValue(id),
+		Name:       types.StringValue("Fixture Cook"),
+		Experience: types.StringValue(experience),
+	}
+}
@@ -0,0 +1,458 @@
+package acctest
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/bevelwork/terraform-provider-hashiwich/internal/provider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestProviderConfigString(t *testing.T) {
+	tests := []struct {
+		name   string
+		config ProviderConfig
+		want   string
+	}{
+		{
+			name:   "defaults",
+			config: ProviderConfig{},
+			want:   "provider \"hw\" {\n}\n",
+		},
+		{
+			name: "all arguments",
+			config: ProviderConfig{
+				Upcharge:       "0.50",
+				Seed:           42,
+				PriceOverrides: map[string]string{"sandwich": "6.50", "napkin": "0.10"},
+				BackendPath:    "/tmp/hw.json",
+				TrashRetention: "1h",
+			},
+			want: "provider \"hw\" {\n" +
+				"  upcharge = 0.50\n" +
+				"  seed = 42\n" +
+				"  price_overrides = {\n" +
+				"    napkin = 0.10\n" +
+				"    sandwich = 6.50\n" +
+				"  }\n" +
+				"  backend_path = \"/tmp/hw.json\"\n" +
+				"  trash_retention = \"1h\"\n" +
+				"}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProtoV6ProviderFactories(t *testing.T) {
+	factory, ok := ProtoV6ProviderFactories()[ProviderName]
+	if !ok {
+		t.Fatalf("no factory for %q", ProviderName)
+	}
+	if _, err := factory(); err != nil {
+		t.Fatalf("starting the provider server: %s", err)
+	}
+}
+
+func TestAccStoreWithSeededCook(t *testing.T) {
+	// The expert cook isn't managed by the configuration; the store finds
+	// its record in the seeded registry and counts its throughput
+	records := map[string]any{
+		"cook-expert-6": CookRecord("cook-expert-6", "expert"),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactoriesWithRecords(records),
+		Steps: []resource.TestStep{
+			{
+				Config: Config(ProviderConfig{Upcharge: "0.50"}, StoreComponents, `
+resource "hw_store" "seeded" {
+  name      = "Seeded Store"
+  oven_id   = hw_oven.fixture.id
+  cook_ids  = ["cook-expert-6"]
+  tables_id = hw_tables.fixture.id
+  chairs_id = hw_chairs.fixture.id
+  fridge_id = hw_fridge.fixture.id
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hw_store.seeded", "cook_capacity", "15"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStoreUpdateWithUnchangedCook(t *testing.T) {
+	// The second step changes only the store, so its apply never reads the
+	// cook: the store updates without the cook's record
+	renamed := strings.Replace(Store, `"Fixture Store"`, `"Renamed Store"`, 1)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: Config(ProviderConfig{}, Store),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hw_store.fixture", "cook_capacity", "12"),
+				),
+			},
+			{
+				Config: Config(ProviderConfig{}, renamed),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hw_store.fixture", "name", "Renamed Store"),
+					resource.TestCheckResourceAttr("hw_store.fixture", "cook_capacity", "12"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrderAtUnchangedStore(t *testing.T) {
+	// The second step adds only the order, so its apply never reads the
+	// store and customer it references
+	base := Config(ProviderConfig{}, Store, `
+resource "hw_customer" "fixture" {
+  name = "Fixture Customer"
+}
+
+resource "hw_drink" "fixture" {
+  kind = "cola"
+}
+`)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: base,
+			},
+			{
+				Config: base + `
+resource "hw_order" "fixture" {
+  customer_id = hw_customer.fixture.id
+  store_id    = hw_store.fixture.id
+  item_ids    = [hw_drink.fixture.id]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("hw_order.fixture", "id"),
+					resource.TestCheckResourceAttrSet("hw_order.fixture", "total"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrdersWithTheSameItemCount(t *testing.T) {
+	// The customer places two one-item orders; each gets its own ID, so
+	// neither overwrites the other's record
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: Config(ProviderConfig{}, Store, `
+resource "hw_customer" "fixture" {
+  name = "Fixture Customer"
+}
+
+resource "hw_drink" "fixture" {
+  kind = "cola"
+}
+
+resource "hw_order" "lunch" {
+  customer_id = hw_customer.fixture.id
+  store_id    = hw_store.fixture.id
+  item_ids    = [hw_drink.fixture.id]
+}
+
+resource "hw_order" "dinner" {
+  customer_id = hw_customer.fixture.id
+  store_id    = hw_store.fixture.id
+  item_ids    = [hw_drink.fixture.id]
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("hw_order.lunch", "id"),
+					resource.TestCheckResourceAttrSet("hw_order.dinner", "id"),
+					func(s *terraform.State) error {
+						lunch := s.RootModule().Resources["hw_order.lunch"].Primary.ID
+						dinner := s.RootModule().Resources["hw_order.dinner"].Primary.ID
+						if lunch == dinner {
+							return fmt.Errorf("both orders have ID %q", lunch)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccCookDeletedOutsideTerraform(t *testing.T) {
+	// Deleting the cook's record from the backend stands in for deleting it
+	// outside Terraform: the refresh drops it from state, the plan creates it
+	// again, and applying that plan restores the record
+	backend := ProviderConfig{BackendPath: BackendPath(t)}
+	config := Config(backend, `
+resource "hw_cook" "fixture" {
+  name       = "Fixture Cook"
+  experience = "experienced"
+}
+`)
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("hw_cook.fixture", "id", func(value string) error {
+						id = value
+						return nil
+					}),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := provider.DeleteBackendRecord(backend.BackendPath, id); err != nil {
+						t.Fatalf("deleting the cook's record: %s", err)
+					}
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("hw_cook.fixture", "id", &id),
+					resource.TestCheckResourceAttr("hw_cook.fixture", "experience", "experienced"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWaitlistWithBackend(t *testing.T) {
+	// The second step adds only the waitlist entry, so its apply doesn't
+	// read the store; with a backend it finds the store's record anyway and
+	// estimates the wait right away
+	base := Config(ProviderConfig{BackendPath: BackendPath(t)}, Store)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: base,
+			},
+			{
+				Config: base + `
+resource "hw_waitlist" "fixture" {
+  store_id = hw_store.fixture.id
+  name     = "Fixture"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("hw_waitlist.fixture", "estimated_wait_minutes"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStoreAggregatesWithBackend(t *testing.T) {
+	// The store is read before the resources that feed it, so the first
+	// apply leaves its aggregates unset; the refresh runs in a new provider
+	// process, which finds their records in the backend
+	open := strings.Replace(Store, "  fridge_id = hw_fridge.fixture.id\n", `  fridge_id = hw_fridge.fixture.id
+
+  operating_hours {
+    day   = "monday"
+    open  = "08:00"
+    close = "16:00"
+  }
+`, 1)
+	var revenue float64
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: Config(ProviderConfig{BackendPath: BackendPath(t)}, open, `
+resource "hw_review" "glowing" {
+  store_id = hw_store.fixture.id
+  rating   = 5
+  text     = "Best Reuben in town."
+}
+
+resource "hw_review" "grumpy" {
+  store_id = hw_store.fixture.id
+  rating   = 2
+  text     = "Best Reuben in town."
+}
+
+resource "hw_music_playlist" "lunch" {
+  store_id = hw_store.fixture.id
+  genres   = ["jazz", "lofi"]
+  volume   = 4
+}
+
+resource "hw_marketing_campaign" "launch" {
+  store_id      = hw_store.fixture.id
+  name          = "Launch"
+  channel       = "social_media"
+  budget        = 1400
+  duration_days = 14
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("hw_store.fixture", "average_rating"),
+					resource.TestCheckNoResourceAttr("hw_store.fixture", "ambiance_score"),
+					resource.TestCheckResourceAttrWith("hw_store.fixture", "estimated_weekly_revenue", func(value string) (err error) {
+						revenue, err = strconv.ParseFloat(value, 64)
+						return err
+					}),
+				),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hw_store.fixture", "average_rating", "3.5"),
+					resource.TestCheckResourceAttr("hw_store.fixture", "ambiance_score", "67"),
+					resource.TestCheckResourceAttrWith("hw_store.fixture", "estimated_weekly_revenue", func(value string) error {
+						boosted, err := strconv.ParseFloat(value, 64)
+						if err != nil {
+							return err
+						}
+						if boosted <= revenue {
+							return fmt.Errorf("estimated_weekly_revenue = %s after the campaign, want more than %v", value, revenue)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStoreNameConflictWithBackend(t *testing.T) {
+	// The second step adds only the new store, so its apply doesn't read
+	// the first; with a backend it finds the first store's name anyway
+	base := Config(ProviderConfig{BackendPath: BackendPath(t)}, Store)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: base,
+			},
+			{
+				Config: base + `
+resource "hw_store" "twin" {
+  name      = "Fixture Store"
+  oven_id   = hw_oven.fixture.id
+  cook_ids  = [hw_cook.fixture.id]
+  tables_id = hw_tables.fixture.id
+  chairs_id = hw_chairs.fixture.id
+  fridge_id = hw_fridge.fixture.id
+}
+`,
+				ExpectError: regexp.MustCompile(`Store Name Conflict`),
+			},
+		},
+	})
+}
+
+func TestAccRestoreInLaterApply(t *testing.T) {
+	// Each step runs in a new provider process: the cook is destroyed in one
+	// apply, listed by hw_trash in the next and restored in a third, and the
+	// restored record can then be imported
+	backend := ProviderConfig{BackendPath: BackendPath(t), TrashRetention: "1h"}
+	cook := `
+resource "hw_cook" "fixture" {
+  name       = "Trashed Cook"
+  experience = "expert"
+}
+`
+	trash := `
+data "hw_trash" "all" {}
+`
+	restore := trash + `
+action "hw_restore" "cook" {
+  config {
+    id = "cook-trashed-cook-12"
+  }
+}
+
+resource "hw_oven" "restorer" {
+  type = "commercial"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.hw_restore.cook]
+    }
+  }
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: ProtoV6ProviderFactories(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: Config(backend, cook),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("hw_cook.fixture", "id", "cook-trashed-cook-12"),
+				),
+			},
+			{
+				Config: Config(backend),
+			},
+			{
+				Config: Config(backend, trash),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.hw_trash.all", "records.#", "1"),
+					resource.TestCheckResourceAttr("data.hw_trash.all", "records.0.id", "cook-trashed-cook-12"),
+					resource.TestCheckResourceAttr("data.hw_trash.all", "records.0.resource_type", "hw_cook"),
+				),
+			},
+			{
+				Config: Config(backend, restore),
+			},
+			{
+				Config: Config(backend, restore),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.hw_trash.all", "records.#", "0"),
+				),
+			},
+			{
+				Config:        Config(backend, restore, cook),
+				ResourceName:  "hw_cook.fixture",
+				ImportState:   true,
+				ImportStateId: "cook-trashed-cook-12",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 || states[0].ID != "cook-trashed-cook-12" {
+						return fmt.Errorf("imported %d cooks, want cook-trashed-cook-12", len(states))
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
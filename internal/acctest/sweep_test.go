@@ -0,0 +1,68 @@
+package acctest
+
+import (
+	"context"
+	"log"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/bevelwork/terraform-provider-hashiwich/internal/provider"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestMain runs the sweepers instead of the tests when go test is given
+// -sweep, with the backend files to clear in place of regions.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	for _, resourceType := range resourceTypes() {
+		resource.AddTestSweepers(resourceType, &resource.Sweeper{
+			Name: resourceType,
+			F:    sweepBackend(resourceType),
+		})
+	}
+}
+
+// resourceTypes returns the type names of the provider's resources.
+func resourceTypes() []string {
+	ctx := context.Background()
+	var typeNames []string
+	for _, newResource := range provider.New("test")().Resources(ctx) {
+		var metadata fwresource.MetadataResponse
+		newResource().Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: ProviderName}, &metadata)
+		typeNames = append(typeNames, metadata.TypeName)
+	}
+	return typeNames
+}
+
+// sweepBackend returns the sweeper of a resource type, which removes its
+// records from the backend file it is given as its region.
+func sweepBackend(resourceType string) resource.SweeperFunc {
+	return func(backendPath string) error {
+		swept, err := provider.SweepBackend(backendPath, resourceType)
+		if err != nil {
+			return err
+		}
+		log.Printf("[INFO] Swept %d %s records from %s", swept, resourceType, backendPath)
+		return nil
+	}
+}
+
+func TestSweepers(t *testing.T) {
+	// Every resource type gets a sweeper, and sweeping a backend that
+	// doesn't exist yet is a no-op
+	typeNames := resourceTypes()
+	if !slices.Contains(typeNames, "hw_cook") || !slices.Contains(typeNames, "hw_store") {
+		t.Fatalf("resourceTypes() = %v, want every resource type", typeNames)
+	}
+	backendPath := filepath.Join(t.TempDir(), "backend.json")
+	for _, resourceType := range typeNames {
+		if err := sweepBackend(resourceType)(backendPath); err != nil {
+			t.Errorf("sweeping %s: %s", resourceType, err)
+		}
+	}
+}
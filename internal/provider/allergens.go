@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"slices"
+	"strings"
+)
+
+// glutenFreeBreads are the hw_bread kinds made without wheat, barley or rye.
+// Any other kind is assumed to contain gluten.
+var glutenFreeBreads = []string{"corn tortilla", "gluten-free", "lettuce wrap", "rice cake"}
+
+// breadGlutenFree reports whether bread of the given kind is gluten-free.
+func breadGlutenFree(kind string) bool {
+	return slices.Contains(glutenFreeBreads, kind)
+}
+
+// allergensOf returns the sorted, distinct allergens of the given ingredients
+// from the shared ingredientAllergens table. Ingredients may be written with
+// dashes for spaces, as resource IDs carry them. Ingredients the table doesn't
+// list contribute none.
+func allergensOf(ingredients ...string) []string {
+	allergens := []string{}
+	for _, ingredient := range ingredients {
+		list, ok := ingredientAllergens[ingredient]
+		if !ok {
+			list = ingredientAllergens[strings.ReplaceAll(ingredient, "-", " ")]
+		}
+		allergens = append(allergens, list...)
+	}
+	slices.Sort(allergens)
+	return slices.Compact(allergens)
+}
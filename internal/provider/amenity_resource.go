@@ -0,0 +1,292 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &AmenityResource{}
+var _ resource.ResourceWithImportState = &AmenityResource{}
+
+func NewAmenityResource() resource.Resource {
+	return &AmenityResource{}
+}
+
+type AmenityResource struct {
+	client *ProviderConfig
+}
+
+type AmenityResourceModel struct {
+	Type            types.String `tfsdk:"type"`
+	Description     types.String `tfsdk:"description"`
+	Cost            MoneyValue   `tfsdk:"cost"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
+}
+
+// amenityTypes are the accepted values of the amenity type attribute.
+var amenityTypes = []string{"coffee_machine", "drive_thru", "patio", "dessert_case"}
+
+func (r *AmenityResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_amenity"
+}
+
+func (r *AmenityResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `An optional extra for a sandwich shop, from an espresso machine to a drive-thru lane. Attach amenities to a store with ` + "`amenity_ids`" + `; each type changes the store's cost, capacity, or revenue in its own way.
+
+**Example Usage:**
+
+` + "```hcl" + `
+# Serve coffee with every sandwich
+resource "hw_amenity" "espresso" {
+  type        = "coffee_machine"
+  description = "Two-group espresso machine"
+  # cost computed as $800
+}
+
+# Serve customers who never sit down
+resource "hw_amenity" "drive_thru" {
+  type = "drive_thru"
+  # cost computed as $4000
+}
+
+resource "hw_store" "main" {
+  name        = "Main Street Deli"
+  oven_id     = hw_oven.main.id
+  cook_ids    = [hw_cook.chef1.id]
+  tables_id   = hw_tables.dining.id
+  chairs_id   = hw_chairs.seating.id
+  fridge_id   = hw_fridge.storage.id
+  amenity_ids = [hw_amenity.espresso.id, hw_amenity.drive_thru.id]
+}
+` + "```" + `
+
+**Key Concepts:**
+- One resource, several **types** that each affect a store differently
+- coffee_machine ($800): adds $0.75 to the average ticket
+- drive_thru ($4000): serves 15 customers/hour without tables
+- patio ($2500): adds 10 customers/hour of outdoor seating
+- dessert_case ($600): adds $1.00 to the average ticket
+
+*Steam hisses softly,*
+*A window opens to cars,*
+*Small joys, added on.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of amenity: coffee_machine, drive_thru, patio, or dessert_case",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the amenity",
+				Optional:            true,
+			},
+			"cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Cost of the amenity in dollars (varies by type: coffee_machine=$800, drive_thru=$4000, patio=$2500, dessert_case=$600)",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Amenity identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AmenityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *AmenityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AmenityResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	amenityType := data.Type.ValueString()
+	if !slices.Contains(amenityTypes, amenityType) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid Amenity Type",
+			fmt.Sprintf("Amenity type %q is not supported. Supported types: %s.", amenityType, strings.Join(amenityTypes, ", ")),
+		)
+		return
+	}
+
+	finalPrice := ApplyUpcharge(r.client.BasePrice("amenity_"+amenityType), r.client.Upcharge)
+	data.Cost = r.client.Price(finalPrice)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+
+	id := r.client.NewId("amenity", fmt.Sprintf("%s-%d", amenityType, len(amenityType)))
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created an amenity resource", map[string]any{
+		"id":   data.Id.ValueString(),
+		"type": amenityType,
+		"cost": data.Cost.ValueBigFloat().String(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AmenityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AmenityResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost
+	finalPrice := ApplyUpcharge(r.client.BasePrice("amenity_"+data.Type.ValueString()), r.client.Upcharge)
+	data.Cost = r.client.Price(finalPrice)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AmenityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AmenityResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	amenityType := data.Type.ValueString()
+	if !slices.Contains(amenityTypes, amenityType) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid Amenity Type",
+			fmt.Sprintf("Amenity type %q is not supported. Supported types: %s.", amenityType, strings.Join(amenityTypes, ", ")),
+		)
+		return
+	}
+
+	// Recalculate cost
+	finalPrice := ApplyUpcharge(r.client.BasePrice("amenity_"+amenityType), r.client.Upcharge)
+	data.Cost = r.client.Price(finalPrice)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+
+	var state AmenityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Type.Equal(state.Type) {
+		id := r.client.NewId("amenity", fmt.Sprintf("%s-%d", amenityType, len(amenityType)))
+		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AmenityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AmenityResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted an amenity resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *AmenityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// amenityEffect is how one amenity changes the store it is attached to.
+type amenityEffect struct {
+	// ExtraCapacity is customers per hour served without a dining table
+	// (drive-thru lane, patio seating)
+	ExtraCapacity float64
+	// TicketBonus is the dollars each customer adds to the average ticket
+	TicketBonus float64
+}
+
+// amenityEffectFor dispatches on the amenity type to its effect on a store.
+// Unknown types have no effect.
+func amenityEffectFor(amenityType string) amenityEffect {
+	switch amenityType {
+	case "coffee_machine":
+		return amenityEffect{TicketBonus: 0.75}
+	case "drive_thru":
+		return amenityEffect{ExtraCapacity: 15.0}
+	case "patio":
+		return amenityEffect{ExtraCapacity: 10.0}
+	case "dessert_case":
+		return amenityEffect{TicketBonus: 1.00}
+	default:
+		return amenityEffect{}
+	}
+}
+
+// amenityTypeOf resolves the type of the amenity with the given ID from its
+// registry record, falling back to the type encoded in the ID when the record
+// is not known to this provider process. It reports false when the ID does
+// not name a supported amenity.
+func amenityTypeOf(client *ProviderConfig, id string) (string, bool) {
+	amenityType := client.KindFromId(id, "amenity")
+	if amenity, ok := LookupRecord[AmenityResourceModel](client.Registry, id); ok {
+		amenityType = amenity.Type.ValueString()
+	}
+	return amenityType, slices.Contains(amenityTypes, amenityType)
+}
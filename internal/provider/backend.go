@@ -0,0 +1,376 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Backend keeps the registry's records in a JSON file between provider
+// processes, standing in for the backend API a real provider would call.
+// Records are stored as the state of the resource type they belong to, so a
+// later process decodes them with that type's schema, and the file holds the
+// versions of records with optimistic locking and the registry's trash.
+//
+// The registry reads the file once, when the provider is configured, and
+// writes it whole on Flush.
+type Backend struct {
+	path string
+	// schemas are the resource schemas by type name, for decoding records
+	schemas map[string]schema.Schema
+	file    backendFile
+	// dirty is set by changes that haven't been written to the file yet
+	dirty bool
+	// deleted holds the records this process deleted, for trash to keep
+	deleted map[string]backendRecord
+}
+
+// backendFile is the JSON document a Backend reads and writes.
+type backendFile struct {
+	Records  map[string]backendRecord  `json:"records"`
+	Versions map[string]int64          `json:"versions"`
+	Trash    map[string]backendTrashed `json:"trash"`
+}
+
+// backendRecord is a stored record: the resource type it belongs to, such as
+// hw_cook, and its state, encoded as the provider protocol does.
+type backendRecord struct {
+	ResourceType string `json:"resource_type"`
+	State        []byte `json:"state"`
+}
+
+// backendTrashed is a stored record in the trash, with its retention window.
+type backendTrashed struct {
+	backendRecord
+	DeletedAt time.Time `json:"deleted_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// OpenRegistry returns a registry backed by the JSON file at path, loaded
+// with the records and versions it holds. A missing file is an empty backend,
+// created on the first Flush. schemas are the provider's resource schemas by
+// type name, as resourceSchemas returns them.
+func OpenRegistry(path string, schemas map[string]schema.Schema) (*Registry, error) {
+	backend := &Backend{path: path, schemas: schemas, deleted: map[string]backendRecord{}}
+	file, err := readBackendFile(path)
+	if err != nil {
+		return nil, err
+	}
+	backend.file = file
+
+	registry := NewRegistry()
+	registry.backend = backend
+	for id, version := range file.Versions {
+		registry.versions[id] = version
+	}
+	// Trashed records are decoded when they are restored
+	for id, trashed := range file.Trash {
+		registry.trash[id] = TrashedRecord{
+			Id:           id,
+			ResourceType: trashed.ResourceType,
+			DeletedAt:    trashed.DeletedAt,
+			ExpiresAt:    trashed.ExpiresAt,
+		}
+	}
+	return registry, nil
+}
+
+// readBackendFile reads the backend file at path, which may not exist yet.
+func readBackendFile(path string) (backendFile, error) {
+	file := backendFile{Records: map[string]backendRecord{}, Versions: map[string]int64{}, Trash: map[string]backendTrashed{}}
+	content, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return file, nil
+	}
+	if err != nil {
+		return file, err
+	}
+	if err := json.Unmarshal(content, &file); err != nil {
+		return file, fmt.Errorf("%s is not a backend file: %w", path, err)
+	}
+	if file.Records == nil {
+		file.Records = map[string]backendRecord{}
+	}
+	if file.Versions == nil {
+		file.Versions = map[string]int64{}
+	}
+	if file.Trash == nil {
+		file.Trash = map[string]backendTrashed{}
+	}
+	return file, nil
+}
+
+// write replaces the backend file with f. It writes a temporary file next to
+// it first, so an interrupted write never leaves half a file behind.
+func (f backendFile) write(path string) error {
+	content, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	temp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	if _, err := temp.Write(content); err != nil {
+		temp.Close()
+		os.Remove(temp.Name())
+		return err
+	}
+	if err := temp.Close(); err != nil {
+		os.Remove(temp.Name())
+		return err
+	}
+	return os.Rename(temp.Name(), path)
+}
+
+// resourceSchemas returns the schema of every resource, keyed by type name.
+func resourceSchemas(ctx context.Context, resources []func() resource.Resource) map[string]schema.Schema {
+	schemas := make(map[string]schema.Schema, len(resources))
+	for _, newResource := range resources {
+		r := newResource()
+		var metadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "hw"}, &metadata)
+		var resp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &resp)
+		schemas[metadata.TypeName] = resp.Schema
+	}
+	return schemas
+}
+
+// recordResourceType returns the resource type whose records are of type t,
+// by the naming of the provider's models: CookResourceModel is hw_cook and
+// ToGoBoxResourceModel is hw_to_go_box. It returns "" for other types.
+func recordResourceType(t reflect.Type) string {
+	name, ok := strings.CutSuffix(t.Name(), "ResourceModel")
+	if !ok || name == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("hw")
+	for _, r := range name {
+		if unicode.IsUpper(r) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// put stores record under id, encoded as the state of its resource type.
+// Records whose type isn't a resource model stay in memory only.
+func (b *Backend) put(id string, record any) {
+	if stored, ok := b.encode(record); ok {
+		b.file.Records[id] = stored
+		delete(b.file.Trash, id)
+		b.dirty = true
+	}
+}
+
+// encode encodes record as the state of its resource type. It reports false
+// when record isn't a resource model.
+func (b *Backend) encode(record any) (backendRecord, bool) {
+	if record == nil {
+		return backendRecord{}, false
+	}
+	resourceType := recordResourceType(reflect.TypeOf(record))
+	s, ok := b.schemas[resourceType]
+	if !ok {
+		return backendRecord{}, false
+	}
+
+	ctx := context.Background()
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, typedNulls(ctx, s, record)); diags.HasError() {
+		return backendRecord{}, false
+	}
+	encoded, err := tfprotov6.NewDynamicValue(state.Raw.Type(), state.Raw)
+	if err != nil {
+		return backendRecord{}, false
+	}
+	return backendRecord{ResourceType: resourceType, State: encoded.MsgPack}, true
+}
+
+// typedNulls returns a copy of record with its null attribute values replaced
+// by the schema's nulls. The zero value of a collection, as in records built
+// by hand such as seeded ones, lacks the element type the schema has.
+func typedNulls(ctx context.Context, s schema.Schema, record any) any {
+	copied := reflect.New(reflect.TypeOf(record)).Elem()
+	copied.Set(reflect.ValueOf(record))
+	for i := range copied.NumField() {
+		field := copied.Field(i)
+		value, ok := field.Interface().(attr.Value)
+		attribute, found := s.Attributes[copied.Type().Field(i).Tag.Get("tfsdk")]
+		if !ok || !found || !value.IsNull() {
+			continue
+		}
+
+		attributeType := attribute.GetType()
+		null, err := attributeType.ValueFromTerraform(ctx, tftypes.NewValue(attributeType.TerraformType(ctx), nil))
+		if err == nil && reflect.TypeOf(null).AssignableTo(field.Type()) {
+			field.Set(reflect.ValueOf(null))
+		}
+	}
+	return copied.Interface()
+}
+
+// putState stores the state of a resource of the given type under id.
+func (b *Backend) putState(id, resourceType string, raw tftypes.Value) {
+	encoded, err := tfprotov6.NewDynamicValue(raw.Type(), raw)
+	if err != nil {
+		return
+	}
+	b.file.Records[id] = backendRecord{ResourceType: resourceType, State: encoded.MsgPack}
+	delete(b.file.Trash, id)
+	b.dirty = true
+}
+
+// get decodes the record stored under id into a new value of type t. It
+// reports false when there is no such record, it belongs to a resource type
+// other than t's, or it no longer matches the type's schema.
+func (b *Backend) get(id string, t reflect.Type) (any, bool) {
+	stored, ok := b.file.Records[id]
+	if !ok || stored.ResourceType != recordResourceType(t) {
+		return nil, false
+	}
+	s, ok := b.schemas[stored.ResourceType]
+	if !ok {
+		return nil, false
+	}
+
+	ctx := context.Background()
+	raw, err := (&tfprotov6.DynamicValue{MsgPack: stored.State}).Unmarshal(s.Type().TerraformType(ctx))
+	if err != nil {
+		return nil, false
+	}
+	record := reflect.New(t)
+	if diags := (tfsdk.State{Schema: s, Raw: raw}).Get(ctx, record.Interface()); diags.HasError() {
+		return nil, false
+	}
+	return record.Elem().Interface(), true
+}
+
+// delete removes the record stored under id and its version.
+func (b *Backend) delete(id string) {
+	if stored, ok := b.file.Records[id]; ok {
+		b.deleted[id] = stored
+	}
+	delete(b.file.Records, id)
+	delete(b.file.Versions, id)
+	b.dirty = true
+}
+
+// trash keeps trashed's record in the trash, or else the record that was
+// stored under its ID, which an earlier process wrote. Records whose type
+// isn't a resource model stay in memory only.
+func (b *Backend) trash(trashed TrashedRecord) {
+	stored, ok := b.encode(trashed.record)
+	if !ok {
+		stored, ok = b.deleted[trashed.Id]
+	}
+	if ok {
+		b.file.Trash[trashed.Id] = backendTrashed{backendRecord: stored, DeletedAt: trashed.DeletedAt, ExpiresAt: trashed.ExpiresAt}
+		b.dirty = true
+	}
+}
+
+// restore moves the trashed record stored under id back to the records. It
+// reports false when the trash holds no such record.
+func (b *Backend) restore(id string) bool {
+	trashed, ok := b.file.Trash[id]
+	if !ok {
+		return false
+	}
+	b.file.Records[id] = trashed.backendRecord
+	delete(b.file.Trash, id)
+	b.dirty = true
+	return true
+}
+
+// purgeTrash drops the trashed records expired at now.
+func (b *Backend) purgeTrash(now time.Time) {
+	for id, trashed := range b.file.Trash {
+		if !now.Before(trashed.ExpiresAt) {
+			delete(b.file.Trash, id)
+			b.dirty = true
+		}
+	}
+}
+
+// setVersion records that the record stored under id is at version.
+func (b *Backend) setVersion(id string, version int64) {
+	b.file.Versions[id] = version
+	b.dirty = true
+}
+
+// flushActionChanges writes the records an action changed to the registry's
+// backend, if any. It does nothing without a backend.
+func flushActionChanges(registry *Registry, diags *diag.Diagnostics) {
+	if err := registry.Flush(); err != nil {
+		diags.AddError(
+			"Unable to Save Record",
+			fmt.Sprintf("The changed records could not be written to the backend: %s", err),
+		)
+	}
+}
+
+// SweepBackend removes every record of the given resource type, such as
+// hw_cook, from the backend file at path, and returns how many it removed. A
+// missing file has nothing to sweep.
+func SweepBackend(path, resourceType string) (int, error) {
+	file, err := readBackendFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	var swept int
+	for id, stored := range file.Records {
+		if stored.ResourceType == resourceType {
+			delete(file.Records, id)
+			delete(file.Versions, id)
+			swept++
+		}
+	}
+	for id, trashed := range file.Trash {
+		if trashed.ResourceType == resourceType {
+			delete(file.Trash, id)
+			swept++
+		}
+	}
+	if swept == 0 {
+		return 0, nil
+	}
+	return swept, file.write(path)
+}
+
+// DeleteBackendRecord removes the record stored under id from the backend
+// file at path, as if the resource had been deleted outside Terraform.
+func DeleteBackendRecord(path, id string) error {
+	file, err := readBackendFile(path)
+	if err != nil {
+		return err
+	}
+	if _, ok := file.Records[id]; !ok {
+		return fmt.Errorf("%s holds no record %q", path, id)
+	}
+	delete(file.Records, id)
+	delete(file.Versions, id)
+	return file.write(path)
+}
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// openTestRegistry opens a registry backed by the file at path with the
+// provider's resource schemas.
+func openTestRegistry(t *testing.T, path string) *Registry {
+	t.Helper()
+	registry, err := OpenRegistry(path, resourceSchemas(context.Background(), (&hwProvider{}).Resources(context.Background())))
+	if err != nil {
+		t.Fatalf("OpenRegistry: %v", err)
+	}
+	return registry
+}
+
+func TestRecordResourceType(t *testing.T) {
+	tests := map[reflect.Type]string{
+		reflect.TypeFor[CookResourceModel]():        "hw_cook",
+		reflect.TypeFor[ToGoBoxResourceModel]():     "hw_to_go_box",
+		reflect.TypeFor[DessertCaseResourceModel](): "hw_dessert_case",
+		reflect.TypeFor[TrashedRecord]():            "",
+	}
+	for typ, want := range tests {
+		if got := recordResourceType(typ); got != want {
+			t.Errorf("recordResourceType(%s) = %q, want %q", typ.Name(), got, want)
+		}
+	}
+}
+
+func TestBackendKeepsRecords(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "backend.json")
+
+	registry := openTestRegistry(t, path)
+	registry.Put("cook-expert-6", CookResourceModel{
+		Id:         types.StringValue("cook-expert-6"),
+		Name:       types.StringValue("Alex"),
+		Experience: types.StringValue("expert"),
+	})
+	registry.Put("cook-junior-6", CookResourceModel{Id: types.StringValue("cook-junior-6")})
+	registry.SetVersion("cook-expert-6", 3)
+	registry.Put("not-a-resource", TrashedRecord{Id: "not-a-resource"})
+	if err := registry.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+
+	// A later process sees the records and versions
+	registry = openTestRegistry(t, path)
+	cook, ok := LookupRecord[CookResourceModel](registry, "cook-expert-6")
+	if !ok || cook.Experience.ValueString() != "expert" || cook.Name.ValueString() != "Alex" {
+		t.Errorf("LookupRecord(cook-expert-6) = %v, %t, want the expert cook Alex", cook, ok)
+	}
+	if _, ok := LookupRecord[StoreResourceModel](registry, "cook-expert-6"); ok {
+		t.Error("LookupRecord found cook-expert-6 as a store")
+	}
+	if version, ok := registry.Version("cook-expert-6"); !ok || version != 3 {
+		t.Errorf("Version(cook-expert-6) = %d, %t, want 3", version, ok)
+	}
+	if cooks := ListRecords[CookResourceModel](registry); len(cooks) != 2 {
+		t.Errorf("ListRecords found %d cooks, want 2", len(cooks))
+	}
+	if !registry.Missing("not-a-resource") {
+		t.Error("a record that isn't a resource model was stored")
+	}
+
+	// Deleting outside the provider leaves the record missing
+	if err := DeleteBackendRecord(path, "cook-junior-6"); err != nil {
+		t.Fatalf("DeleteBackendRecord: %v", err)
+	}
+	registry = openTestRegistry(t, path)
+	if !registry.Missing("cook-junior-6") {
+		t.Error("Missing(cook-junior-6) = false after DeleteBackendRecord")
+	}
+	if registry.Missing("cook-expert-6") {
+		t.Error("Missing(cook-expert-6) = true, want false")
+	}
+}
+
+func TestSweepBackend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "backend.json")
+	if swept, err := SweepBackend(path, "hw_cook"); swept != 0 || err != nil {
+		t.Errorf("SweepBackend without a file = %d, %v, want 0, nil", swept, err)
+	}
+
+	registry := openTestRegistry(t, path)
+	registry.Put("cook-expert-6", CookResourceModel{Id: types.StringValue("cook-expert-6")})
+	registry.Put("cook-junior-6", CookResourceModel{Id: types.StringValue("cook-junior-6")})
+	registry.Put("wifi-fast-4", WifiResourceModel{Id: types.StringValue("wifi-fast-4")})
+	if err := registry.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+
+	if swept, err := SweepBackend(path, "hw_cook"); swept != 2 || err != nil {
+		t.Errorf("SweepBackend(hw_cook) = %d, %v, want 2, nil", swept, err)
+	}
+	registry = openTestRegistry(t, path)
+	if !registry.Missing("cook-expert-6") || !registry.Missing("cook-junior-6") {
+		t.Error("cooks left in the backend after sweeping hw_cook")
+	}
+	if registry.Missing("wifi-fast-4") {
+		t.Error("sweeping hw_cook removed the wifi")
+	}
+}
+
+func TestRegistryWithoutBackendMissesNothing(t *testing.T) {
+	registry := NewRegistry()
+	if registry.Missing("cook-expert-6") {
+		t.Error("Missing = true without a backend")
+	}
+	if err := registry.Flush(); err != nil {
+		t.Errorf("Flush without a backend: %v", err)
+	}
+}
+
+func TestBackendKeepsTrash(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "backend.json")
+
+	registry := openTestRegistry(t, path)
+	cook := CookResourceModel{Id: types.StringValue("cook-expert-6"), Experience: types.StringValue("expert")}
+	registry.Put("cook-expert-6", cook)
+	registry.Put("cook-junior-6", CookResourceModel{Id: types.StringValue("cook-junior-6")})
+	if err := registry.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+
+	// A later process destroys the cooks without having read them: their
+	// records come from the backend
+	registry = openTestRegistry(t, path)
+	registry.Delete("cook-expert-6")
+	registry.Trash("cook-expert-6", "hw_cook", nil, time.Hour)
+	registry.Delete("cook-junior-6")
+	registry.Trash("cook-junior-6", "hw_cook", nil, -time.Hour)
+	if err := registry.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+
+	// Another lists the trash, leaving out the expired cook, and restores
+	registry = openTestRegistry(t, path)
+	trashed := registry.ListTrash()
+	if len(trashed) != 1 || trashed[0].Id != "cook-expert-6" || trashed[0].ResourceType != "hw_cook" {
+		t.Fatalf("ListTrash = %+v, want cook-expert-6 only", trashed)
+	}
+	if _, ok := registry.Restore("cook-expert-6"); !ok {
+		t.Fatal("Restore(cook-expert-6) = false")
+	}
+	if err := registry.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+
+	registry = openTestRegistry(t, path)
+	if restored, ok := LookupRecord[CookResourceModel](registry, "cook-expert-6"); !ok || restored.Experience.ValueString() != "expert" {
+		t.Errorf("LookupRecord after Restore = %v, %t, want the expert cook", restored, ok)
+	}
+	if trashed := registry.ListTrash(); len(trashed) != 0 {
+		t.Errorf("ListTrash after Restore = %+v, want none", trashed)
+	}
+}
@@ -3,7 +3,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,6 +19,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &BagResource{}
 var _ resource.ResourceWithImportState = &BagResource{}
+var _ resource.ResourceWithUpgradeState = &BagResource{}
 
 func NewBagResource() resource.Resource {
 	return &BagResource{}
@@ -23,14 +27,18 @@ func NewBagResource() resource.Resource {
 
 // BagResource defines the resource implementation.
 type BagResource struct {
-	client any
+	client *ProviderConfig
 }
 
 // BagResourceModel describes the resource data model.
 type BagResourceModel struct {
-	Description types.String `tfsdk:"description"`
-	Sandwiches  types.List   `tfsdk:"sandwiches"`
-	Id          types.String `tfsdk:"id"`
+	Description  types.String `tfsdk:"description"`
+	Sandwiches   types.Set    `tfsdk:"sandwiches"`
+	PackagingIds types.Set    `tfsdk:"packaging_ids"`
+	Tags         types.Map    `tfsdk:"tags"`
+	TagsAll      types.Map    `tfsdk:"tags_all"`
+	Haiku        types.String `tfsdk:"haiku"`
+	Id           types.String `tfsdk:"id"`
 }
 
 func (r *BagResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -39,7 +47,9 @@ func (r *BagResource) Metadata(ctx context.Context, req resource.MetadataRequest
 
 func (r *BagResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: `A versatile container resource that holds multiple sandwiches, perfect for takeout orders or meal prep. The bag resource demonstrates list attributes and resource references, allowing you to group sandwiches together for convenient management and organization.
+		// Version 1 turned sandwiches into a set
+		Version: 1,
+		MarkdownDescription: `A versatile container resource that holds multiple sandwiches, perfect for takeout orders or meal prep. The bag resource demonstrates set attributes and resource references, allowing you to group sandwiches together for convenient management and organization.
 
 **Example Usage:**
 
@@ -87,10 +97,11 @@ resource "hw_bag" "party_bags" {
 ` + "```" + `
 
 **Key Concepts:**
-- Demonstrates **list attributes** with resource references
+- Demonstrates **set attributes** with resource references (order doesn't matter, so reordering causes no diff)
 - Shows how to group related resources together
 - Useful for managing collections of items
-- The ` + "`sandwiches`" + ` attribute accepts a list of sandwich resource IDs
+- The ` + "`sandwiches`" + ` attribute accepts a set of sandwich resource IDs
+- Optional ` + "`packaging_ids`" + ` reference ` + "`hw_to_go_box`" + `, ` + "`hw_cup`" + ` and ` + "`hw_straw`" + ` resources; the bag then needs a box for every sandwich and a straw for every cup
 
 *Brown paper rustles soft,*
 *Sandwiches nestle inside,*
@@ -101,11 +112,19 @@ resource "hw_bag" "party_bags" {
 				MarkdownDescription: "A description of the bag resource",
 				Optional:            true,
 			},
-			"sandwiches": schema.ListAttribute{
+			"sandwiches": schema.SetAttribute{
 				ElementType:         types.StringType,
-				MarkdownDescription: "List of sandwich resource IDs to include in the bag",
+				MarkdownDescription: "Set of sandwich resource IDs to include in the bag",
 				Required:            true,
 			},
+			"packaging_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Set of hw_to_go_box, hw_cup and hw_straw resource IDs to pack the bag with. When set, the boxes' quantities must cover every sandwich, and the straws' every cup",
+				Optional:            true,
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Bag identifier",
@@ -123,7 +142,16 @@ func (r *BagResource) Configure(ctx context.Context, req resource.ConfigureReque
 		return
 	}
 
-	r.client = req.ProviderData
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
 }
 
 func (r *BagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -136,7 +164,11 @@ func (r *BagResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
-	// Simulate API delay
+	// Check the packaging covers the sandwiches and cups
+	resp.Diagnostics.Append(r.checkPackaging(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Mock resource creation - generate a fake ID based on sandwich IDs
 	var sandwichIds []types.String
@@ -145,7 +177,7 @@ func (r *BagResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 	
-	id := fmt.Sprintf("bag-%d-sandwiches", len(sandwichIds))
+	id := r.client.NewId("bag", fmt.Sprintf("%d-sandwiches", len(sandwichIds)))
 	data.Id = types.StringValue(id)
 
 	tflog.Trace(ctx, "created a bag resource", map[string]any{
@@ -153,6 +185,11 @@ func (r *BagResource) Create(ctx context.Context, req resource.CreateRequest, re
 		"sandwiches": len(sandwichIds),
 	})
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
+	// Record the bag so hw_receipt can itemize it
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -169,8 +206,11 @@ func (r *BagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 	// Simulate API delay
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Mock resource read - just return the existing state
 	// In a real implementation, this would fetch from an API
+	r.client.Registry.Put(data.Id.ValueString(), data)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -186,7 +226,11 @@ func (r *BagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
-	// Simulate API delay
+	// Check the packaging covers the sandwiches and cups
+	resp.Diagnostics.Append(r.checkPackaging(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Mock resource update - regenerate ID if sandwiches changed
 	var state BagResourceModel
@@ -202,13 +246,17 @@ func (r *BagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		id := fmt.Sprintf("bag-%d-sandwiches", len(sandwichIds))
+		id := r.client.NewId("bag", fmt.Sprintf("%d-sandwiches", len(sandwichIds)))
 		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
 	} else {
 		// Keep existing ID
 		data.Id = state.Id
 	}
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -225,12 +273,101 @@ func (r *BagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 
 	// Simulate API delay
 
-	// Mock resource deletion - nothing to do
+	// Mock resource deletion - forget the bag's record
+	r.client.Registry.Delete(data.Id.ValueString())
+
 	tflog.Trace(ctx, "deleted a bag resource", map[string]any{
 		"id": data.Id.ValueString(),
 	})
 }
 
+func (r *BagResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: listToSetUpgrader("sandwiches"),
+	}
+}
+
 func (r *BagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// checkPackaging totals the packaging referenced by packaging_ids and checks
+// there is a to-go box for every sandwich and a straw for every cup. Bags
+// without packaging_ids aren't checked.
+func (r *BagResource) checkPackaging(ctx context.Context, data *BagResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.PackagingIds.IsNull() || data.PackagingIds.IsUnknown() {
+		return diags
+	}
+	var packagingIds []string
+	diags.Append(data.PackagingIds.ElementsAs(ctx, &packagingIds, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	totals := map[string]int64{"box": 0, "cup": 0, "straw": 0}
+	for _, id := range packagingIds {
+		kind, quantity, ok := packagingQuantity(r.client, id)
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("packaging_ids").AtSetValue(types.StringValue(id)),
+				"Unknown Packaging",
+				fmt.Sprintf("%q is not the ID of an hw_to_go_box, hw_cup, or hw_straw resource.", id),
+			)
+			continue
+		}
+		totals[kind] += quantity
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	sandwiches := int64(len(data.Sandwiches.Elements()))
+	if totals["box"] < sandwiches {
+		diags.AddAttributeError(
+			path.Root("packaging_ids"),
+			"Not Enough To-Go Boxes",
+			fmt.Sprintf("The bag holds %d sandwiches but its packaging has %d to-go boxes. Add an hw_to_go_box or raise its quantity.",
+				sandwiches, totals["box"]),
+		)
+	}
+	if totals["straw"] < totals["cup"] {
+		diags.AddAttributeError(
+			path.Root("packaging_ids"),
+			"Not Enough Straws",
+			fmt.Sprintf("The bag's packaging has %d cups but %d straws. Add an hw_straw or raise its quantity.",
+				totals["cup"], totals["straw"]),
+		)
+	}
+	return diags
+}
+
+// packagingQuantity resolves a packaging ID to its kind ("box", "cup", or
+// "straw") and quantity, from the registry when the record is known and from
+// the "qty-<quantity>" name in its ID otherwise. It reports false for IDs
+// that aren't packaging.
+func packagingQuantity(c *ProviderConfig, id string) (string, int64, bool) {
+	registry := c.Registry
+	if box, ok := LookupRecord[ToGoBoxResourceModel](registry, id); ok {
+		return "box", box.Quantity.ValueInt64(), true
+	}
+	if cup, ok := LookupRecord[CupResourceModel](registry, id); ok {
+		return "cup", cup.Quantity.ValueInt64(), true
+	}
+	if straw, ok := LookupRecord[StrawResourceModel](registry, id); ok {
+		return "straw", straw.Quantity.ValueInt64(), true
+	}
+
+	kind, ok := c.TypeOfId(id, "box", "cup", "straw")
+	if !ok {
+		return "", 0, false
+	}
+	name, _ := c.idFormat().Name(id, kind)
+	quantity, found := strings.CutPrefix(name, "qty-")
+	if !found {
+		return "", 0, false
+	}
+	parsed, err := strconv.ParseInt(quantity, 10, 64)
+	return kind, parsed, err == nil
+}
@@ -3,12 +3,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"math"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -23,14 +26,21 @@ func NewBreadResource() resource.Resource {
 
 // BreadResource defines the resource implementation.
 type BreadResource struct {
-	client any
+	client *ProviderConfig
 }
 
 // BreadResourceModel describes the resource data model.
 type BreadResourceModel struct {
-	Description types.String `tfsdk:"description"`
-	Kind        types.String `tfsdk:"kind"`
-	Id          types.String `tfsdk:"id"`
+	Description   types.String `tfsdk:"description"`
+	Kind          types.String `tfsdk:"kind"`
+	GlutenFree    types.Bool   `tfsdk:"gluten_free"`
+	BakedOn       types.String `tfsdk:"baked_on"`
+	ShelfLifeDays types.Int64  `tfsdk:"shelf_life_days"`
+	Expired       types.Bool   `tfsdk:"expired"`
+	Tags          types.Map    `tfsdk:"tags"`
+	TagsAll       types.Map    `tfsdk:"tags_all"`
+	Haiku         types.String `tfsdk:"haiku"`
+	Id            types.String `tfsdk:"id"`
 }
 
 func (r *BreadResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -55,6 +65,13 @@ resource "hw_bread" "sourdough" {
   kind        = "sourdough"
   description = "Artisan sourdough bread"
 }
+
+# Bread that goes stale: three days after baked_on, a refresh marks it
+# expired and the next plan replaces it with a fresh loaf
+resource "hw_bread" "baguette" {
+  kind            = "baguette"
+  shelf_life_days = 3
+}
 ` + "```" + `
 
 **Common Bread Types:**
@@ -64,9 +81,12 @@ resource "hw_bread" "sourdough" {
 - ` + "`ciabatta`" + ` - Italian ciabatta bread
 - ` + "`white`" + ` - White bread
 - ` + "`multigrain`" + ` - Multigrain bread
+- ` + "`gluten-free`" + ` - Gluten-free bread (also ` + "`corn tortilla`" + `, ` + "`rice cake`" + ` and ` + "`lettuce wrap`" + `)
 
 **Note:** The ` + "`kind`" + ` attribute accepts any string value, but using common bread types makes your configuration more readable. The resource ID is automatically computed and cannot be set manually.
 
+**Freshness:** With ` + "`shelf_life_days`" + ` set, the bread **ages out**. Once the shelf life after ` + "`baked_on`" + ` has passed, a refresh sets ` + "`expired`" + ` with a warning, and the plan replaces the bread. Leave ` + "`baked_on`" + ` unset so the replacement is baked on the day it is created; a fixed ` + "`baked_on`" + ` in the past would make every replacement stale too.
+
 *Golden crust rises,*
 *Warm and fragrant from the oven,*
 *Foundation of joy.*`,
@@ -110,6 +130,35 @@ kind = "whole wheat"
 - Any string value is accepted, but using standard bread types improves readability`,
 				Required: true,
 			},
+			"gluten_free": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the bread is gluten-free, from its kind: true for `gluten-free`, `corn tortilla`, `rice cake` and `lettuce wrap`, false for any other kind",
+			},
+			"baked_on": schema.StringAttribute{
+				MarkdownDescription: "Date the bread was baked (`YYYY-MM-DD`). Defaults to the day it is created (the provider's `as_of` date, when set)",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"shelf_life_days": schema.Int64Attribute{
+				MarkdownDescription: "Days the bread keeps after `baked_on`. Unset, the bread never expires",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Shelf Life", min: 1, max: math.MaxInt64},
+				},
+			},
+			"expired": schema.BoolAttribute{
+				MarkdownDescription: "Whether the bread is past its shelf life. Once a refresh finds it expired, the plan replaces the bread",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					replaceIfExpired{},
+				},
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: `Automatically generated unique identifier for this bread resource.
@@ -141,7 +190,16 @@ func (r *BreadResource) Configure(ctx context.Context, req resource.ConfigureReq
 		return
 	}
 
-	r.client = req.ProviderData
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
 }
 
 func (r *BreadResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -157,7 +215,7 @@ func (r *BreadResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Simulate API delay
 
 	// Mock resource creation - generate a fake ID based on the kind
-	id := fmt.Sprintf("bread-%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString()))
+	id := r.client.NewId("bread", fmt.Sprintf("%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString())))
 	data.Id = types.StringValue(id)
 
 	tflog.Trace(ctx, "created a bread resource", map[string]any{
@@ -165,6 +223,14 @@ func (r *BreadResource) Create(ctx context.Context, req resource.CreateRequest,
 		"kind": data.Kind.ValueString(),
 	})
 
+	data.GlutenFree = types.BoolValue(breadGlutenFree(data.Kind.ValueString()))
+	resp.Diagnostics.Append(r.setFreshness(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -184,6 +250,14 @@ func (r *BreadResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// Mock resource read - just return the existing state
 	// In a real implementation, this would fetch from an API
 
+	data.GlutenFree = types.BoolValue(breadGlutenFree(data.Kind.ValueString()))
+	resp.Diagnostics.Append(r.setFreshness(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -209,13 +283,21 @@ func (r *BreadResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	// If kind changed, regenerate ID
 	if !data.Kind.Equal(state.Kind) {
-		id := fmt.Sprintf("bread-%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString()))
+		id := r.client.NewId("bread", fmt.Sprintf("%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString())))
 		data.Id = types.StringValue(id)
 	} else {
 		// Keep existing ID
 		data.Id = state.Id
 	}
 
+	data.GlutenFree = types.BoolValue(breadGlutenFree(data.Kind.ValueString()))
+	resp.Diagnostics.Append(r.setFreshness(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -241,3 +323,26 @@ func (r *BreadResource) Delete(ctx context.Context, req resource.DeleteRequest,
 func (r *BreadResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// setFreshness sets whether the bread has expired, defaulting baked_on to
+// Today for new bread, and warns when it has.
+func (r *BreadResource) setFreshness(data *BreadResourceModel) diag.Diagnostics {
+	if data.BakedOn.IsUnknown() {
+		data.BakedOn = types.StringValue(r.client.Today().Format(dateLayout))
+	}
+
+	expired, diags := r.client.BreadExpired(data.BakedOn, data.ShelfLifeDays)
+	if diags.HasError() {
+		return diags
+	}
+	data.Expired = types.BoolValue(expired)
+
+	if expired {
+		diags.AddAttributeWarning(
+			path.Root("expired"),
+			"Bread Expired",
+			fmt.Sprintf("%s was baked on %s and has passed its %d-day shelf life. The next plan replaces it with fresh bread.", data.Id.ValueString(), data.BakedOn.ValueString(), data.ShelfLifeDays.ValueInt64()),
+		)
+	}
+	return diags
+}
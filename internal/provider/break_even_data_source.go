@@ -0,0 +1,296 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BreakEvenDataSource{}
+
+func NewBreakEvenDataSource() datasource.DataSource {
+	return &BreakEvenDataSource{}
+}
+
+// BreakEvenDataSource defines the data source implementation.
+type BreakEvenDataSource struct {
+	client *ProviderConfig
+}
+
+// BreakEvenDataSourceModel describes the data source data model.
+type BreakEvenDataSourceModel struct {
+	StoreId                  types.String `tfsdk:"store_id"`
+	EquipmentCost            MoneyValue   `tfsdk:"equipment_cost"`
+	DailyCost                MoneyValue   `tfsdk:"daily_cost"`
+	AverageTicket            MoneyValue   `tfsdk:"average_ticket"`
+	CustomersPerDay          types.Number `tfsdk:"customers_per_day"`
+	BreakEvenCustomersPerDay types.Number `tfsdk:"break_even_customers_per_day"`
+	DaysToRecoup             types.Number `tfsdk:"days_to_recoup"`
+	Id                       types.String `tfsdk:"id"`
+}
+
+func (d *BreakEvenDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_break_even"
+}
+
+func (d *BreakEvenDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `A break-even analysis for a sandwich shop: how many customers a day cover the running costs, and how many days of trading pay back the equipment. Demonstrates a data source that reuses the provider's pricing and capacity engines, with optional inputs that fall back to computed values.
+
+**Example Usage:**
+
+` + "```hcl" + `
+# Analyze an existing store
+data "hw_break_even" "main" {
+  store_id = hw_store.main.id
+}
+
+# What if the store only served 150 customers a day?
+data "hw_break_even" "slow_day" {
+  store_id          = hw_store.main.id
+  customers_per_day = 150
+}
+
+# Analyze a store that doesn't exist yet
+data "hw_break_even" "plan" {
+  equipment_cost    = 5000
+  daily_cost        = 320
+  customers_per_day = 120
+  # average_ticket defaults to the menu's average ticket
+}
+
+output "payback" {
+  value = {
+    customers_needed = data.hw_break_even.main.break_even_customers_per_day
+    days_to_recoup   = data.hw_break_even.main.days_to_recoup
+  }
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates **Optional + Computed** inputs: values come from the store unless set explicitly
+- Equipment cost is the store's ` + "`cost`" + ` minus the cooks' daily wages, which are the daily cost
+- Customers per day come from the store's ` + "`estimated_weekly_revenue`" + ` spread over the week
+- ` + "`days_to_recoup`" + ` is null when daily revenue doesn't cover the daily cost
+- A store the provider has no record of, such as one managed in another configuration without a shared ` + "`backend_path`" + `, gives a warning and supplies no figures; the analysis is null unless they are all set explicitly
+
+*Coins fill the drawer,*
+*Day by day the ovens pay,*
+*Balance tips to gain.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of an hw_store to analyze. Without it, `equipment_cost`, `daily_cost`, and `customers_per_day` are required",
+				Optional:            true,
+			},
+			"equipment_cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				MarkdownDescription: "One-time equipment cost in dollars to recoup (defaults to the store's cost minus its cooks)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"daily_cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				MarkdownDescription: "Running cost per day in dollars (defaults to the store's cooks' daily wages)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"average_ticket": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				MarkdownDescription: "Dollars each customer spends (defaults to the menu's average ticket)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"customers_per_day": schema.NumberAttribute{
+				MarkdownDescription: "Customers served per day (defaults to the store's estimated weekly revenue / 7 / average ticket)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"break_even_customers_per_day": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whole customers per day needed to cover `daily_cost`",
+			},
+			"days_to_recoup": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whole days of trading needed to recoup `equipment_cost` from daily profit (null when there is no daily profit)",
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *BreakEvenDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	d.client = config
+}
+
+func (d *BreakEvenDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BreakEvenDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	averageTicket := d.client.AverageTicket()
+	if isSet(data.AverageTicket.NumberValue) {
+		averageTicket = data.AverageTicket.ValueBigFloat()
+	}
+	if averageTicket.Sign() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("average_ticket"),
+			"Invalid Average Ticket",
+			fmt.Sprintf("average_ticket must be greater than zero, got %s.", averageTicket.String()),
+		)
+		return
+	}
+
+	// Start from the store's figures, if any, then apply explicit inputs
+	var equipmentCost, dailyCost, customersPerDay *big.Float
+	storeRead := true
+	if !data.StoreId.IsNull() && !data.StoreId.IsUnknown() {
+		store, known, diags := lookupReference[StoreResourceModel](d.client, path.Root("store_id"), data.StoreId.ValueString(), "store")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if known {
+			dailyCost = new(big.Float)
+			if cooks, ok := store.CostBreakdown.Attributes()["cooks"].(MoneyValue); ok && isSet(cooks.NumberValue) {
+				dailyCost = cooks.ValueBigFloat()
+			}
+			equipmentCost = new(big.Float).Sub(store.Cost.ValueBigFloat(), dailyCost)
+
+			customersPerDay = new(big.Float).Quo(store.EstimatedWeeklyRevenue.ValueBigFloat(), big.NewFloat(7))
+			customersPerDay.Quo(customersPerDay, averageTicket)
+		} else {
+			storeRead = false
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("store_id"),
+				"Store Not Read",
+				fmt.Sprintf("The provider has no record of %s in this run, so the analysis only uses the figures set explicitly and is null without all of them. Set the provider's backend_path for data sources to see every store.", data.StoreId.ValueString()),
+			)
+		}
+	}
+
+	inputs := []struct {
+		name  string
+		value types.Number
+		dest  **big.Float
+	}{
+		{"equipment_cost", data.EquipmentCost.NumberValue, &equipmentCost},
+		{"daily_cost", data.DailyCost.NumberValue, &dailyCost},
+		{"customers_per_day", data.CustomersPerDay, &customersPerDay},
+	}
+	for _, input := range inputs {
+		if isSet(input.value) {
+			*input.dest = input.value.ValueBigFloat()
+		}
+		if *input.dest == nil {
+			if !storeRead {
+				continue
+			}
+			resp.Diagnostics.AddAttributeError(
+				path.Root(input.name),
+				"Missing Break-Even Input",
+				fmt.Sprintf("Set %s, or set store_id to take it from a store.", input.name),
+			)
+			continue
+		}
+		if (*input.dest).Sign() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(input.name),
+				"Invalid Break-Even Input",
+				fmt.Sprintf("%s must not be negative, got %s.", input.name, (*input.dest).String()),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Id = types.StringValue("break-even")
+	if !data.StoreId.IsNull() {
+		data.Id = types.StringValue("break-even-" + data.StoreId.ValueString())
+	}
+
+	// Without the store's record only the explicit figures are known, and
+	// they stay as configured
+	if equipmentCost == nil || dailyCost == nil || customersPerDay == nil {
+		data.AverageTicket = NewMoneyValue(averageTicket)
+		data.BreakEvenCustomersPerDay = types.NumberNull()
+		data.DaysToRecoup = types.NumberNull()
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// Customers needed to cover the daily cost, rounded up to whole customers
+	breakEven := new(big.Float).Quo(dailyCost, averageTicket)
+	data.BreakEvenCustomersPerDay = types.NumberValue(ceilFloat(breakEven))
+
+	// Days of daily profit needed to cover the equipment, rounded up
+	dailyProfit := new(big.Float).Mul(customersPerDay, averageTicket)
+	dailyProfit.Sub(dailyProfit, dailyCost)
+	if dailyProfit.Sign() > 0 {
+		days := new(big.Float).Quo(equipmentCost, dailyProfit)
+		data.DaysToRecoup = types.NumberValue(ceilFloat(days))
+	} else {
+		data.DaysToRecoup = types.NumberNull()
+		resp.Diagnostics.AddWarning(
+			"Equipment Never Recouped",
+			fmt.Sprintf("%s customers a day at $%s bring in no more than the daily cost of $%s, so the equipment is never paid back. At least %s customers a day are needed to break even.",
+				customersPerDay.Text('f', 2), averageTicket.Text('f', 2), dailyCost.Text('f', 2), ceilFloat(breakEven).String()),
+		)
+	}
+
+	data.EquipmentCost = NewMoneyValue(equipmentCost)
+	data.DailyCost = NewMoneyValue(dailyCost)
+	data.AverageTicket = NewMoneyValue(averageTicket)
+	data.CustomersPerDay = types.NumberValue(customersPerDay)
+
+	tflog.Trace(ctx, "read break even data source", map[string]any{
+		"break_even_customers_per_day": data.BreakEvenCustomersPerDay.ValueBigFloat().String(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// isSet reports whether a configured number has a known, non-null value.
+func isSet(value types.Number) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
+
+// ceilFloat rounds x up to the nearest whole number.
+func ceilFloat(x *big.Float) *big.Float {
+	whole, accuracy := x.Int(nil)
+	if accuracy == big.Below {
+		whole.Add(whole, big.NewInt(1))
+	}
+	return new(big.Float).SetInt(whole)
+}
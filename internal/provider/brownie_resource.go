@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -29,10 +28,15 @@ type BrownieResource struct {
 
 // BrownieResourceModel describes the resource data model.
 type BrownieResourceModel struct {
-	Description types.String `tfsdk:"description"`
-	Kind        types.String `tfsdk:"kind"`
-	Price       types.Number `tfsdk:"price"`
-	Id          types.String `tfsdk:"id"`
+	Description     types.String `tfsdk:"description"`
+	Kind            types.String `tfsdk:"kind"`
+	Price           MoneyValue   `tfsdk:"price"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	HappyHourActive types.Bool   `tfsdk:"happy_hour_active"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
 }
 
 func (r *BrownieResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -97,9 +101,21 @@ resource "hw_brownie" "menu" {
 				Required:            true,
 			},
 			"price": schema.NumberAttribute{
+				CustomType:          MoneyType{},
 				Computed:            true,
 				MarkdownDescription: "The price of the brownie in dollars (hardcoded to $2.00)",
 			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"happy_hour_active": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the provider's `happy_hour` discount was applied to `price`",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Brownie identifier",
@@ -141,13 +157,15 @@ func (r *BrownieResource) Create(ctx context.Context, req resource.CreateRequest
 
 	// Simulate API delay
 
-	// Set base price: $2.00, then apply upcharge
-	basePrice := big.NewFloat(2.00)
-	finalPrice := ApplyUpcharge(basePrice, r.client.Upcharge)
-	data.Price = types.NumberValue(finalPrice)
+	// Set base price: $2.00, then apply upcharge and any happy hour discount
+	basePrice := r.client.BasePrice("brownie")
+	finalPrice, happyHour := r.client.HappyHourPrice(ApplyUpcharge(basePrice, r.client.Upcharge))
+	data.Price = r.client.Price(finalPrice)
+	data.HappyHourActive = types.BoolValue(happyHour)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
 
 	// Mock resource creation - generate a fake ID based on the kind
-	id := fmt.Sprintf("brownie-%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString()))
+	id := r.client.NewId("brownie", fmt.Sprintf("%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString())))
 	data.Id = types.StringValue(id)
 
 	tflog.Trace(ctx, "created a brownie resource", map[string]any{
@@ -155,6 +173,8 @@ func (r *BrownieResource) Create(ctx context.Context, req resource.CreateRequest
 		"kind": data.Kind.ValueString(),
 	})
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -172,13 +192,17 @@ func (r *BrownieResource) Read(ctx context.Context, req resource.ReadRequest, re
 	// Simulate API delay
 
 	// Ensure price is set (in case it wasn't in state)
-	basePrice := big.NewFloat(2.00)
-	finalPrice := ApplyUpcharge(basePrice, r.client.Upcharge)
-	data.Price = types.NumberValue(finalPrice)
+	basePrice := r.client.BasePrice("brownie")
+	finalPrice, happyHour := r.client.HappyHourPrice(ApplyUpcharge(basePrice, r.client.Upcharge))
+	data.Price = r.client.Price(finalPrice)
+	data.HappyHourActive = types.BoolValue(happyHour)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
 
 	// Mock resource read - just return the existing state
 	// In a real implementation, this would fetch from an API
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -196,9 +220,11 @@ func (r *BrownieResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Simulate API delay
 
 	// Ensure price is always set to $2.00 + upcharge
-	basePrice := big.NewFloat(2.00)
-	finalPrice := ApplyUpcharge(basePrice, r.client.Upcharge)
-	data.Price = types.NumberValue(finalPrice)
+	basePrice := r.client.BasePrice("brownie")
+	finalPrice, happyHour := r.client.HappyHourPrice(ApplyUpcharge(basePrice, r.client.Upcharge))
+	data.Price = r.client.Price(finalPrice)
+	data.HappyHourActive = types.BoolValue(happyHour)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
 
 	// Mock resource update - regenerate ID if kind changed
 	var state BrownieResourceModel
@@ -209,13 +235,15 @@ func (r *BrownieResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	// If kind changed, regenerate ID
 	if !data.Kind.Equal(state.Kind) {
-		id := fmt.Sprintf("brownie-%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString()))
+		id := r.client.NewId("brownie", fmt.Sprintf("%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString())))
 		data.Id = types.StringValue(id)
 	} else {
 		// Keep existing ID
 		data.Id = state.Id
 	}
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// CatalogCache holds the list, map and object values catalog data sources
+// build from static data, so a configuration with dozens of data blocks
+// converts each catalog once per provider instance. Prices are fixed for the
+// life of a provider instance, so priced catalogs are cached too.
+type CatalogCache struct {
+	mu     sync.Mutex
+	values map[string]attr.Value
+}
+
+// NewCatalogCache returns an empty catalog cache.
+func NewCatalogCache() *CatalogCache {
+	return &CatalogCache{
+		values: map[string]attr.Value{},
+	}
+}
+
+// cachedValue returns the value cached under key, calling build to construct
+// it on first use. Keys must include any data source arguments the value
+// depends on. Values that fail to build are not cached, and a nil cache
+// builds every time.
+func cachedValue[T attr.Value](cache *CatalogCache, key string, build func() (T, diag.Diagnostics)) (T, diag.Diagnostics) {
+	if cache == nil {
+		return build()
+	}
+
+	cache.mu.Lock()
+	cached, ok := cache.values[key]
+	cache.mu.Unlock()
+	if ok {
+		return cached.(T), nil
+	}
+
+	// Build outside the lock; two concurrent reads may both build, but they
+	// build the same value
+	value, diags := build()
+	if diags.HasError() {
+		return value, diags
+	}
+
+	cache.mu.Lock()
+	cache.values[key] = value
+	cache.mu.Unlock()
+	return value, diags
+}
+
+// catalogsOf returns the catalog cache of the provider data handed to a data
+// source, or nil when the provider isn't configured.
+func catalogsOf(providerData any) *CatalogCache {
+	if config, ok := providerData.(*ProviderConfig); ok && config != nil {
+		return config.Catalogs
+	}
+	return nil
+}
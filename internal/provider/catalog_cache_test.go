@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// benchmarkRead reads the data source over and over with an empty
+// configuration, the way a configuration with many data blocks would.
+func benchmarkRead(b *testing.B, newDataSource func() datasource.DataSource, config *ProviderConfig) {
+	ctx := context.Background()
+	d := newDataSource()
+	d.(datasource.DataSourceWithConfigure).Configure(ctx, datasource.ConfigureRequest{ProviderData: config}, &datasource.ConfigureResponse{})
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, typ := range objectType.AttributeTypes {
+		attrs[name] = tftypes.NewValue(typ, nil)
+	}
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attrs)},
+	}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		resp := datasource.ReadResponse{
+			State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+		}
+		d.Read(ctx, req, &resp)
+		if resp.Diagnostics.HasError() {
+			b.Fatalf("read: %v", resp.Diagnostics)
+		}
+	}
+}
+
+func BenchmarkCatalogRead(b *testing.B) {
+	dataSources := []struct {
+		name string
+		new  func() datasource.DataSource
+	}{
+		{"condiments", NewCondimentsDataSource},
+		{"desserts", NewDessertsDataSource},
+		{"menu", NewMenuDataSource},
+		{"soups", NewSoupsDataSource},
+	}
+	for _, ds := range dataSources {
+		b.Run(ds.name+"/uncached", func(b *testing.B) {
+			benchmarkRead(b, ds.new, benchmarkConfig())
+		})
+		b.Run(ds.name+"/cached", func(b *testing.B) {
+			config := benchmarkConfig()
+			config.Catalogs = NewCatalogCache()
+			benchmarkRead(b, ds.new, config)
+		})
+	}
+}
@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CatalogDataSource{}
+
+func NewCatalogDataSource() datasource.DataSource {
+	return &CatalogDataSource{}
+}
+
+// CatalogDataSource defines the data source implementation.
+type CatalogDataSource struct {
+	client *ProviderConfig
+}
+
+// CatalogDataSourceModel describes the data source data model.
+type CatalogDataSourceModel struct {
+	Type  types.String `tfsdk:"type"`
+	Items types.Map    `tfsdk:"items"`
+	Id    types.String `tfsdk:"id"`
+}
+
+// catalogItem is an element of the items map.
+type catalogItem struct {
+	Name       string            `tfsdk:"name"`
+	Price      MoneyValue        `tfsdk:"price"`
+	Attributes map[string]string `tfsdk:"attributes"`
+}
+
+// catalogItemAttrTypes are the attribute types of an items map element.
+var catalogItemAttrTypes = map[string]attr.Type{
+	"name":       types.StringType,
+	"price":      MoneyType{},
+	"attributes": types.MapType{ElemType: types.StringType},
+}
+
+// catalogTypes are the catalogs hw_catalog can list.
+var catalogTypes = []string{"meats", "condiments", "breads", "drinks", "desserts"}
+
+// breadCatalog is the bread catalog: the common hw_bread kinds.
+var breadCatalog = []string{
+	"baguette",
+	"brioche",
+	"ciabatta",
+	"corn tortilla",
+	"gluten-free",
+	"lettuce wrap",
+	"multigrain",
+	"rice cake",
+	"rye",
+	"sourdough",
+	"wheat",
+	"white",
+	"whole wheat",
+}
+
+// drinkCatalog is the drink catalog: the common hw_drink kinds.
+var drinkCatalog = []string{"cola", "iced tea", "juice", "lemonade", "soda", "water"}
+
+func (d *CatalogDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog"
+}
+
+func (d *CatalogDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `One data source for every catalog the shop keeps. Whatever the ` + "`type`" + `, the catalog comes back in the same shape, a map keyed by slug, so a single pattern drives ` + "`for_each`" + ` over meats, condiments, breads, drinks, or desserts.
+
+**Example Usage:**
+
+` + "```hcl" + `
+data "hw_catalog" "meats" {
+  type = "meats"
+}
+
+# One meat per catalog entry, addressed by slug: hw_meat.all["roast-beef"]
+resource "hw_meat" "all" {
+  for_each = data.hw_catalog.meats.items
+
+  kind        = each.value.name
+  description = "${each.value.name} at $${each.value.price}/${each.value.attributes.price_unit}"
+}
+
+data "hw_catalog" "breads" {
+  type = "breads"
+}
+
+output "gluten_free_breads" {
+  value = [
+    for slug, bread in data.hw_catalog.breads.items : bread.name
+    if bread.attributes.gluten_free == "true"
+  ]
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates a **uniform data source shape**: every catalog is a map of ` + "`{name, price, attributes}`" + `, so the same ` + "`for_each`" + ` works for all of them
+- Map keys are **slugs** of the names (` + "`\"roast beef\"`" + ` is ` + "`roast-beef`" + `), stable ` + "`for_each`" + ` keys for resource addresses
+- ` + "`attributes`" + ` is a string map: every item has ` + "`allergens`" + ` (comma-separated, possibly empty) and ` + "`price_unit`" + `; breads add ` + "`gluten_free`" + ` and desserts add ` + "`category`" + `
+- Prices come from the pricing engine, so they follow ` + "`price_overrides`" + ` and, for items priced ` + "`each`" + `, the upcharge. Condiments and breads come with the sandwich and are priced at 0
+
+*Five lists, one shape now,*
+*Keyed by slugs that never shift,*
+*Loop once, stock them all.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Catalog to list: meats, condiments, breads, drinks, or desserts",
+				Required:            true,
+			},
+			"items": schema.MapNestedAttribute{
+				MarkdownDescription: "The catalog's items, keyed by the slug of their name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the item, as accepted by its resource's `kind`",
+							Computed:            true,
+						},
+						"price": schema.NumberAttribute{
+							CustomType:          MoneyType{},
+							MarkdownDescription: "Price in dollars per `price_unit`, including any upcharge on items priced `each`",
+							Computed:            true,
+						},
+						"attributes": schema.MapAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Further details of the item, as strings: `allergens` and `price_unit` always, plus `gluten_free` for breads and `category` for desserts",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CatalogDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	d.client = config
+}
+
+func (d *CatalogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CatalogDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalogType := data.Type.ValueString()
+	if !slices.Contains(catalogTypes, catalogType) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid Catalog Type",
+			fmt.Sprintf("type must be one of %s, got %q.%s", strings.Join(catalogTypes, ", "), catalogType, didYouMean(catalogType, catalogTypes)),
+		)
+		return
+	}
+
+	items, diags := cachedValue(catalogsOf(d.client), "catalog/"+catalogType, func() (types.Map, diag.Diagnostics) {
+		return types.MapValueFrom(ctx, types.ObjectType{AttrTypes: catalogItemAttrTypes}, d.catalogItems(catalogType))
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Items = items
+	data.Id = types.StringValue("catalog-" + catalogType)
+
+	tflog.Trace(ctx, "read catalog data source", map[string]any{
+		"type":  catalogType,
+		"count": len(items.Elements()),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// catalogItems returns the items of the catalog of type catalogType, keyed by
+// slug.
+func (d *CatalogDataSource) catalogItems(catalogType string) map[string]catalogItem {
+	var upcharge *big.Float
+	if d.client != nil {
+		upcharge = d.client.Upcharge
+	}
+	free := NewMoneyCents(0)
+	item := func(name string, price MoneyValue, unit string, allergens []string) catalogItem {
+		return catalogItem{
+			Name:  name,
+			Price: price,
+			Attributes: map[string]string{
+				"allergens":  strings.Join(allergens, ","),
+				"price_unit": unit,
+			},
+		}
+	}
+
+	items := map[string]catalogItem{}
+	switch catalogType {
+	case "meats":
+		// The upcharge is added to whole items, such as an hw_meat's cost,
+		// not to every ounce
+		price := NewMoneyValue(d.client.BasePrice("meat_ounce"))
+		for _, meat := range deliMeats {
+			items[slugify(meat)] = item(meat, price, "ounce", allergensOf(meat))
+		}
+	case "condiments":
+		for _, condiment := range condimentCatalog {
+			items[slugify(condiment)] = item(condiment, free, "each", allergensOf(condiment))
+		}
+	case "breads":
+		for _, bread := range breadCatalog {
+			entry := item(bread, free, "each", allergensOf(bread))
+			entry.Attributes["gluten_free"] = strconv.FormatBool(breadGlutenFree(bread))
+			items[slugify(bread)] = entry
+		}
+	case "drinks":
+		price := d.client.Price(ApplyUpcharge(d.client.BasePrice("drink"), upcharge))
+		for _, drink := range drinkCatalog {
+			items[slugify(drink)] = item(drink, price, "each", nil)
+		}
+	case "desserts":
+		for _, category := range dessertItems {
+			price := d.client.Price(ApplyUpcharge(d.client.BasePrice(category), upcharge))
+			for _, kind := range DessertKinds(category) {
+				entry := item(kind, price, "each", dessertCatalog[category][kind])
+				entry.Attributes["category"] = category
+				items[slugify(kind)] = entry
+			}
+		}
+	}
+	return items
+}
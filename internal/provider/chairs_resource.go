@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -11,12 +12,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/numberplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &ChairsResource{}
 var _ resource.ResourceWithImportState = &ChairsResource{}
+var _ resource.ResourceWithUpgradeState = &ChairsResource{}
 
 func NewChairsResource() resource.Resource {
 	return &ChairsResource{}
@@ -27,11 +30,15 @@ type ChairsResource struct {
 }
 
 type ChairsResourceModel struct {
-	Quantity    types.Number `tfsdk:"quantity"`
-	Style       types.String `tfsdk:"style"`
-	Description types.String `tfsdk:"description"`
-	Cost        types.Number `tfsdk:"cost"`
-	Id          types.String `tfsdk:"id"`
+	Quantity        types.Int64  `tfsdk:"quantity"`
+	Style           types.String `tfsdk:"style"`
+	Description     types.String `tfsdk:"description"`
+	Cost            MoneyValue   `tfsdk:"cost"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
 }
 
 func (r *ChairsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -40,6 +47,8 @@ func (r *ChairsResource) Metadata(ctx context.Context, req resource.MetadataRequ
 
 func (r *ChairsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		// Version 1 made quantity a whole number
+		Version: 1,
 		MarkdownDescription: `Comfortable seating that complements your tables, demonstrating style-based pricing and quantity management. Learn how different resource attributes affect cost while ensuring your customers have a pleasant dining experience.
 
 **Example Usage:**
@@ -99,9 +108,12 @@ resource "hw_chairs" "variable" {
 *Rest for weary feet.*`,
 
 		Attributes: map[string]schema.Attribute{
-			"quantity": schema.NumberAttribute{
+			"quantity": schema.Int64Attribute{
 				MarkdownDescription: "Number of chairs",
 				Required:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Quantity", min: 0, max: math.MaxInt64},
+				},
 			},
 			"style": schema.StringAttribute{
 				MarkdownDescription: "Style of chairs (basic=$20/chair, comfortable=$35/chair, premium=$50/chair)",
@@ -112,12 +124,20 @@ resource "hw_chairs" "variable" {
 				Optional:            true,
 			},
 			"cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
 				Computed:            true,
 				MarkdownDescription: "Total cost in dollars",
 				PlanModifiers: []planmodifier.Number{
 					numberplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Chairs identifier",
@@ -156,36 +176,30 @@ func (r *ChairsResource) Create(ctx context.Context, req resource.CreateRequest,
 
 
 	// Calculate cost per chair based on style
-	var costPerChair *big.Float
 	style := data.Style.ValueString()
-	switch style {
-	case "basic":
-		costPerChair = big.NewFloat(20.00)
-	case "comfortable":
-		costPerChair = big.NewFloat(35.00)
-	case "premium":
-		costPerChair = big.NewFloat(50.00)
-	default:
-		costPerChair = big.NewFloat(20.00) // default to basic
-	}
+	costPerChair := r.client.VariantPrice("chairs", style, "basic")
 
 	// Calculate total cost
-	quantity := data.Quantity.ValueBigFloat()
+	quantity := data.Quantity.ValueInt64()
 	var totalCost big.Float
-	totalCost.Mul(quantity, costPerChair)
+	totalCost.Mul(big.NewFloat(float64(quantity)), costPerChair)
 	finalCost := ApplyUpcharge(&totalCost, r.client.Upcharge)
-	data.Cost = types.NumberValue(finalCost)
+	data.Cost = r.client.Price(finalCost)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
 
-	id := fmt.Sprintf("chairs-%s-%d", style, len(style))
+	id := r.client.NewId("chairs", fmt.Sprintf("%s-%d", style, len(style)))
 	data.Id = types.StringValue(id)
 
 	tflog.Trace(ctx, "created a chairs resource", map[string]any{
 		"id":    data.Id.ValueString(),
-		"quantity": quantity.String(),
+		"quantity": quantity,
 		"style": style,
 		"cost":  data.Cost.ValueBigFloat().String(),
 	})
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -199,24 +213,18 @@ func (r *ChairsResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 
 	// Recalculate cost
-	var costPerChair *big.Float
 	style := data.Style.ValueString()
-	switch style {
-	case "basic":
-		costPerChair = big.NewFloat(20.00)
-	case "comfortable":
-		costPerChair = big.NewFloat(35.00)
-	case "premium":
-		costPerChair = big.NewFloat(50.00)
-	default:
-		costPerChair = big.NewFloat(20.00)
-	}
+	costPerChair := r.client.VariantPrice("chairs", style, "basic")
 
-	quantity := data.Quantity.ValueBigFloat()
+	quantity := data.Quantity.ValueInt64()
 	var totalCost big.Float
-	totalCost.Mul(quantity, costPerChair)
+	totalCost.Mul(big.NewFloat(float64(quantity)), costPerChair)
 	finalCost := ApplyUpcharge(&totalCost, r.client.Upcharge)
-	data.Cost = types.NumberValue(finalCost)
+	data.Cost = r.client.Price(finalCost)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -231,24 +239,15 @@ func (r *ChairsResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 
 	// Recalculate cost
-	var costPerChair *big.Float
 	style := data.Style.ValueString()
-	switch style {
-	case "basic":
-		costPerChair = big.NewFloat(20.00)
-	case "comfortable":
-		costPerChair = big.NewFloat(35.00)
-	case "premium":
-		costPerChair = big.NewFloat(50.00)
-	default:
-		costPerChair = big.NewFloat(20.00)
-	}
+	costPerChair := r.client.VariantPrice("chairs", style, "basic")
 
-	quantity := data.Quantity.ValueBigFloat()
+	quantity := data.Quantity.ValueInt64()
 	var totalCost big.Float
-	totalCost.Mul(quantity, costPerChair)
+	totalCost.Mul(big.NewFloat(float64(quantity)), costPerChair)
 	finalCost := ApplyUpcharge(&totalCost, r.client.Upcharge)
-	data.Cost = types.NumberValue(finalCost)
+	data.Cost = r.client.Price(finalCost)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
 
 	var state ChairsResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -257,12 +256,18 @@ func (r *ChairsResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	if !data.Style.Equal(state.Style) {
-		id := fmt.Sprintf("chairs-%s-%d", style, len(style))
+		id := r.client.NewId("chairs", fmt.Sprintf("%s-%d", style, len(style)))
 		data.Id = types.StringValue(id)
 	} else {
 		data.Id = state.Id
 	}
 
+	if !data.Id.Equal(state.Id) {
+		r.client.Registry.Delete(state.Id.ValueString())
+	}
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -275,11 +280,19 @@ func (r *ChairsResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 
+	r.client.Registry.Delete(data.Id.ValueString())
+
 	tflog.Trace(ctx, "deleted a chairs resource", map[string]any{
 		"id": data.Id.ValueString(),
 	})
 }
 
+func (r *ChairsResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: numberToInt64Upgrader("quantity"),
+	}
+}
+
 func (r *ChairsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &CompostBinResource{}
+var _ resource.ResourceWithImportState = &CompostBinResource{}
+
+func NewCompostBinResource() resource.Resource {
+	return &CompostBinResource{}
+}
+
+type CompostBinResource struct {
+	client *ProviderConfig
+}
+
+type CompostBinResourceModel struct {
+	Size            types.String `tfsdk:"size"`
+	Description     types.String `tfsdk:"description"`
+	Cost            MoneyValue   `tfsdk:"cost"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
+}
+
+// compostBinSizes are the accepted values of the compost bin size attribute.
+var compostBinSizes = []string{"small", "large"}
+
+func (r *CompostBinResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compost_bin"
+}
+
+func (r *CompostBinResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Lettuce ends, coffee grounds, and crusts, turned back into soil. Attach compost bins to a store with ` + "`bin_ids`" + ` to raise its ` + "`sustainability_score`" + `.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_compost_bin" "kitchen" {
+  size = "large"
+  # cost computed as $110
+}
+
+resource "hw_store" "main" {
+  # ...
+  bin_ids = [hw_compost_bin.kitchen.id, hw_recycling_bin.front.id]
+}
+` + "```" + `
+
+**Key Concepts:**
+- Sizes: small (32 gallons, $60), large (64 gallons, $110)
+- Any compost bin adds 30 points to a store's ` + "`sustainability_score`" + `, and a large one 10 more
+
+*Scraps in a green bin,*
+*Worms at work beneath the lid,*
+*Next spring, tomatoes.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"size": schema.StringAttribute{
+				MarkdownDescription: "Size of the compost bin: small (32 gallons) or large (64 gallons)",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the compost bin",
+				Optional:            true,
+			},
+			"cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Cost in dollars (small=$60, large=$110)",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Compost bin identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CompostBinResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *CompostBinResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CompostBinResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	size := data.Size.ValueString()
+	if !slices.Contains(compostBinSizes, size) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("size"),
+			"Invalid Compost Bin Size",
+			fmt.Sprintf("Size %q is not supported. Supported sizes: small, large.", size),
+		)
+		return
+	}
+
+	finalPrice := ApplyUpcharge(r.client.VariantPrice("compost_bin", size, "small"), r.client.Upcharge)
+	data.Cost = r.client.Price(finalPrice)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+
+	id := r.client.NewId("compost", fmt.Sprintf("%s-%d", size, len(size)))
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created a compost bin resource", map[string]any{
+		"id":   data.Id.ValueString(),
+		"size": size,
+		"cost": data.Cost.ValueBigFloat().String(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CompostBinResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CompostBinResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost
+	finalPrice := ApplyUpcharge(r.client.VariantPrice("compost_bin", data.Size.ValueString(), "small"), r.client.Upcharge)
+	data.Cost = r.client.Price(finalPrice)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CompostBinResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CompostBinResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	size := data.Size.ValueString()
+	if !slices.Contains(compostBinSizes, size) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("size"),
+			"Invalid Compost Bin Size",
+			fmt.Sprintf("Size %q is not supported. Supported sizes: small, large.", size),
+		)
+		return
+	}
+
+	// Recalculate cost
+	finalPrice := ApplyUpcharge(r.client.VariantPrice("compost_bin", size, "small"), r.client.Upcharge)
+	data.Cost = r.client.Price(finalPrice)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+
+	var state CompostBinResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Size.Equal(state.Size) {
+		id := r.client.NewId("compost", fmt.Sprintf("%s-%d", size, len(size)))
+		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CompostBinResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CompostBinResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted a compost bin resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *CompostBinResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// compostSizeOf resolves a compost bin ID to its size, from the registry when
+// the bin's record is known and from the ID otherwise. It reports false for
+// IDs that aren't compost bins.
+func compostSizeOf(client *ProviderConfig, id string) (string, bool) {
+	size := client.KindFromId(id, "compost")
+	if bin, ok := LookupRecord[CompostBinResourceModel](client.Registry, id); ok {
+		size = bin.Size.ValueString()
+	}
+	return size, slices.Contains(compostBinSizes, size)
+}
@@ -0,0 +1,323 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CondimentPairingsDataSource{}
+
+func NewCondimentPairingsDataSource() datasource.DataSource {
+	return &CondimentPairingsDataSource{}
+}
+
+// CondimentPairingsDataSource defines the data source implementation.
+type CondimentPairingsDataSource struct {
+	client any
+}
+
+// CondimentPairingsDataSourceModel describes the data source data model.
+type CondimentPairingsDataSourceModel struct {
+	Meat          types.String  `tfsdk:"meat"`
+	MinConfidence types.Float64 `tfsdk:"min_confidence"`
+	Profile       types.String  `tfsdk:"profile"`
+	Condiments    types.List    `tfsdk:"condiments"`
+	Breads        types.List    `tfsdk:"breads"`
+	Id            types.String  `tfsdk:"id"`
+}
+
+// pairing is a condiment or bread recommended with a deli meat.
+type pairing struct {
+	// Name is the condiment, from the condiment catalog, or the bread, from
+	// the bread catalog
+	Name string `tfsdk:"name"`
+	// Confidence is how sure the kitchen is of the pairing, from 0 to 1
+	Confidence float64 `tfsdk:"confidence"`
+}
+
+// pairingAttrTypes are the attribute types of a condiments or breads element.
+var pairingAttrTypes = map[string]attr.Type{
+	"name":       types.StringType,
+	"confidence": types.Float64Type,
+}
+
+// flavorProfile is what goes with a family of deli meats, best pairing
+// first.
+type flavorProfile struct {
+	Condiments []pairing
+	Breads     []pairing
+}
+
+// flavorProfiles are the flavor profiles deli meats are paired by.
+var flavorProfiles = map[string]flavorProfile{
+	"poultry": {
+		Condiments: []pairing{{"mayonnaise", 0.92}, {"cranberry sauce", 0.85}, {"honey mustard", 0.78}, {"lettuce", 0.74}, {"tomato", 0.7}, {"ranch", 0.62}, {"pesto", 0.55}},
+		Breads:     []pairing{{"multigrain", 0.88}, {"sourdough", 0.8}, {"wheat", 0.72}, {"lettuce wrap", 0.6}},
+	},
+	"cured": {
+		Condiments: []pairing{{"dijon mustard", 0.94}, {"pickles", 0.8}, {"giardiniera", 0.72}, {"oil and vinegar", 0.7}, {"fig jam", 0.58}},
+		Breads:     []pairing{{"baguette", 0.9}, {"ciabatta", 0.86}, {"rye", 0.7}},
+	},
+	"beef": {
+		Condiments: []pairing{{"horseradish", 0.93}, {"spicy brown mustard", 0.86}, {"russian dressing", 0.8}, {"onions", 0.72}, {"aioli", 0.62}},
+		Breads:     []pairing{{"rye", 0.92}, {"sourdough", 0.78}, {"baguette", 0.66}},
+	},
+	"pork": {
+		Condiments: []pairing{{"barbecue sauce", 0.91}, {"coleslaw", 0.86}, {"pickles", 0.76}, {"chimichurri", 0.64}, {"mustard", 0.6}},
+		Breads:     []pairing{{"brioche", 0.9}, {"ciabatta", 0.8}, {"white", 0.7}},
+	},
+	"sausage": {
+		Condiments: []pairing{{"sauerkraut", 0.9}, {"spicy brown mustard", 0.88}, {"onions", 0.78}, {"roasted red peppers", 0.66}, {"harissa", 0.52}},
+		Breads:     []pairing{{"baguette", 0.84}, {"white", 0.7}, {"ciabatta", 0.68}},
+	},
+	"lamb": {
+		Condiments: []pairing{{"tzatziki", 0.95}, {"onions", 0.8}, {"tomato", 0.76}, {"harissa", 0.64}, {"hummus", 0.6}},
+		Breads:     []pairing{{"ciabatta", 0.74}, {"lettuce wrap", 0.68}, {"corn tortilla", 0.55}},
+	},
+	"seafood": {
+		Condiments: []pairing{{"onions", 0.88}, {"cucumbers", 0.82}, {"tomato", 0.8}, {"remoulade", 0.7}, {"sprouts", 0.66}},
+		Breads:     []pairing{{"rye", 0.86}, {"sourdough", 0.78}, {"rice cake", 0.5}},
+	},
+	"deli salad": {
+		Condiments: []pairing{{"lettuce", 0.93}, {"tomato", 0.84}, {"pickles", 0.72}, {"sprouts", 0.66}, {"avocado", 0.6}},
+		Breads:     []pairing{{"white", 0.9}, {"whole wheat", 0.84}, {"lettuce wrap", 0.7}},
+	},
+	"spread": {
+		Condiments: []pairing{{"mustard", 0.9}, {"pickles", 0.82}, {"onions", 0.76}, {"mango chutney", 0.58}},
+		Breads:     []pairing{{"rye", 0.9}, {"baguette", 0.82}},
+	},
+}
+
+// meatProfiles maps each meat in the deli meat catalog to its flavor profile.
+var meatProfiles = map[string]string{
+	"anchovies":            "seafood",
+	"bacon":                "pork",
+	"beef tongue":          "spread",
+	"black forest ham":     "cured",
+	"bologna":              "cured",
+	"bratwurst":            "sausage",
+	"braunschweiger":       "spread",
+	"bresaola":             "cured",
+	"brisket":              "beef",
+	"buffalo chicken":      "poultry",
+	"cajun turkey":         "poultry",
+	"capicola":             "cured",
+	"chicken":              "poultry",
+	"chicken cutlet":       "poultry",
+	"chicken salad":        "deli salad",
+	"chorizo":              "sausage",
+	"corned beef":          "beef",
+	"egg salad":            "deli salad",
+	"genoa salami":         "cured",
+	"grilled chicken":      "poultry",
+	"gyro meat":            "lamb",
+	"ham":                  "cured",
+	"head cheese":          "spread",
+	"honey ham":            "cured",
+	"kielbasa":             "sausage",
+	"liverwurst":           "spread",
+	"lox":                  "seafood",
+	"meatballs":            "sausage",
+	"montreal smoked meat": "beef",
+	"mortadella":           "cured",
+	"pancetta":             "cured",
+	"pastrami":             "beef",
+	"pâté":                 "spread",
+	"peppered turkey":      "poultry",
+	"pepperoni":            "cured",
+	"porchetta":            "pork",
+	"prosciutto":           "cured",
+	"pulled pork":          "pork",
+	"roast beef":           "beef",
+	"roast lamb":           "lamb",
+	"roast pork":           "pork",
+	"salami":               "cured",
+	"sardines":             "seafood",
+	"smoked salmon":        "seafood",
+	"smoked turkey":        "poultry",
+	"soppressata":          "cured",
+	"speck":                "cured",
+	"tuna salad":           "deli salad",
+	"turkey":               "poultry",
+	"turkey breast":        "poultry",
+	"virginia ham":         "cured",
+}
+
+// abovePairings returns the pairings with at least the given confidence, in
+// the same order.
+func abovePairings(pairings []pairing, confidence float64) []pairing {
+	kept := []pairing{}
+	for _, p := range pairings {
+		if p.Confidence >= confidence {
+			kept = append(kept, p)
+		}
+	}
+	return kept
+}
+
+func (d *CondimentPairingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_condiment_pairings"
+}
+
+func (d *CondimentPairingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The kitchen's advice on what to put with a deli meat: condiments and breads that pair with it, each with a confidence score, best first. Build a sandwich from the top picks, or keep only the sure things.
+
+**Example Usage:**
+
+` + "```hcl" + `
+data "hw_condiment_pairings" "pastrami" {
+  meat = "pastrami"
+  # profile computed as "beef"
+  # breads[0] computed as { name = "rye", confidence = 0.92 }
+}
+
+resource "hw_bread" "house" {
+  kind = data.hw_condiment_pairings.pastrami.breads[0].name
+}
+
+# Only the pairings the kitchen is sure of
+data "hw_condiment_pairings" "turkey" {
+  meat           = "turkey"
+  min_confidence = 0.8
+}
+
+output "turkey_toppings" {
+  value = [for condiment in data.hw_condiment_pairings.turkey.condiments : condiment.name]
+}
+` + "```" + `
+
+**Key Concepts:**
+- A second example of an **argument-driven data source**: the ` + "`meat`" + ` argument decides what comes back, as ` + "`ingredient`" + ` does for ` + "`hw_ingredient_substitutions`" + `
+- Returns **lists of nested objects**, read with ` + "`[0].name`" + ` or a ` + "`for`" + ` expression
+- Meats are paired by flavor ` + "`profile`" + `, so every meat in ` + "`hw_deli_meats`" + ` has pairings, and meats with the same profile share them
+- ` + "`min_confidence`" + ` drops pairings scored below it
+
+*Pastrami on rye,*
+*Horseradish close behind,*
+*Some things just belong.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"meat": schema.StringAttribute{
+				MarkdownDescription: "Deli meat to find pairings for, from the `hw_deli_meats` catalog (e.g., `turkey`, `pastrami`)",
+				Required:            true,
+			},
+			"min_confidence": schema.Float64Attribute{
+				MarkdownDescription: "Lowest confidence, from 0 to 1, of the pairings to return (defaults to 0, every pairing)",
+				Optional:            true,
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "Flavor profile the meat is paired by: poultry, cured, beef, pork, sausage, lamb, seafood, deli salad, or spread",
+				Computed:            true,
+			},
+			"condiments": schema.ListNestedAttribute{
+				MarkdownDescription: "Condiments that pair with the meat, highest confidence first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Condiment, from the `hw_condiments` catalog",
+							Computed:            true,
+						},
+						"confidence": schema.Float64Attribute{
+							MarkdownDescription: "How good a match the condiment is, from 0 to 1",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"breads": schema.ListNestedAttribute{
+				MarkdownDescription: "Breads that pair with the meat, highest confidence first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Bread, an `hw_bread` kind",
+							Computed:            true,
+						},
+						"confidence": schema.Float64Attribute{
+							MarkdownDescription: "How good a match the bread is, from 0 to 1",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CondimentPairingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData
+}
+
+func (d *CondimentPairingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CondimentPairingsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	meat := data.Meat.ValueString()
+	profile, ok := meatProfiles[meat]
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("meat"),
+			"Unknown Meat",
+			fmt.Sprintf("%q is not in the hw_deli_meats catalog.%s", meat, didYouMean(meat, deliMeats)),
+		)
+	}
+
+	confidence := data.MinConfidence.ValueFloat64()
+	if confidence < 0 || confidence > 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("min_confidence"),
+			"Invalid Min Confidence",
+			fmt.Sprintf("min_confidence must be from 0 to 1, got %g.", confidence),
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pairingType := types.ObjectType{AttrTypes: pairingAttrTypes}
+	condiments, diags := types.ListValueFrom(ctx, pairingType, abovePairings(flavorProfiles[profile].Condiments, confidence))
+	resp.Diagnostics.Append(diags...)
+	breads, diags := types.ListValueFrom(ctx, pairingType, abovePairings(flavorProfiles[profile].Breads, confidence))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Profile = types.StringValue(profile)
+	data.Condiments = condiments
+	data.Breads = breads
+	data.Id = types.StringValue("condiment-pairings-" + slugify(meat))
+
+	tflog.Trace(ctx, "read condiment pairings data source", map[string]any{
+		"meat":    meat,
+		"profile": profile,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
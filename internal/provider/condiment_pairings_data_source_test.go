@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFlavorProfiles(t *testing.T) {
+	for _, meat := range deliMeats {
+		if _, ok := flavorProfiles[meatProfiles[meat]]; !ok {
+			t.Errorf("meat %q has no flavor profile", meat)
+		}
+	}
+	for meat := range meatProfiles {
+		if !slices.Contains(deliMeats, meat) {
+			t.Errorf("meatProfiles has %q, which is not in the deli meat catalog", meat)
+		}
+	}
+
+	for name, profile := range flavorProfiles {
+		check := func(kind string, pairings []pairing, catalog []string) {
+			for i, p := range pairings {
+				if !slices.Contains(catalog, p.Name) {
+					t.Errorf("%s %s %q is not in the catalog", name, kind, p.Name)
+				}
+				if p.Confidence <= 0 || p.Confidence > 1 {
+					t.Errorf("%s %s %q has confidence %g, want (0, 1]", name, kind, p.Name, p.Confidence)
+				}
+				if i > 0 && p.Confidence > pairings[i-1].Confidence {
+					t.Errorf("%s %s are not in order of confidence at %q", name, kind, p.Name)
+				}
+			}
+		}
+		check("condiment", profile.Condiments, condimentCatalog)
+		check("bread", profile.Breads, breadCatalog)
+	}
+}
+
+func TestAbovePairings(t *testing.T) {
+	pairings := flavorProfiles["beef"].Breads
+	got := abovePairings(pairings, 0.78)
+	if want := pairings[:2]; !slices.Equal(got, want) {
+		t.Errorf("abovePairings(0.78) = %v, want %v", got, want)
+	}
+	if got := abovePairings(pairings, 1); len(got) != 0 {
+		t.Errorf("abovePairings(1) = %v, want none", got)
+	}
+}
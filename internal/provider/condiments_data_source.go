@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +25,70 @@ type CondimentsDataSource struct {
 
 // CondimentsDataSourceModel describes the data source data model.
 type CondimentsDataSourceModel struct {
-	Condiments types.List   `tfsdk:"condiments"`
-	Id         types.String `tfsdk:"id"`
+	Condiments    types.List   `tfsdk:"condiments"`
+	PageSize      types.Int64  `tfsdk:"page_size"`
+	PageToken     types.String `tfsdk:"page_token"`
+	AllPages      types.Bool   `tfsdk:"all_pages"`
+	NextPageToken types.String `tfsdk:"next_page_token"`
+	Id            types.String `tfsdk:"id"`
+}
+
+// condimentCatalog is the condiment catalog, in menu order.
+var condimentCatalog = []string{
+	"mayonnaise",
+	"mustard",
+	"ketchup",
+	"relish",
+	"pickles",
+	"onions",
+	"lettuce",
+	"tomato",
+	"hot sauce",
+	"ranch",
+	"thousand island",
+	"italian dressing",
+	"oil and vinegar",
+	"horseradish",
+	"pesto",
+	"hummus",
+	"guacamole",
+	"salsa",
+	"chipotle mayo",
+	"aioli",
+	"tzatziki",
+	"barbecue sauce",
+	"dijon mustard",
+	"honey mustard",
+	"spicy brown mustard",
+	"sriracha",
+	"sriracha mayo",
+	"sweet chili sauce",
+	"buffalo sauce",
+	"blue cheese dressing",
+	"caesar dressing",
+	"russian dressing",
+	"remoulade",
+	"tartar sauce",
+	"cocktail sauce",
+	"cranberry sauce",
+	"apple butter",
+	"fig jam",
+	"mango chutney",
+	"giardiniera",
+	"sauerkraut",
+	"coleslaw",
+	"jalapeños",
+	"banana peppers",
+	"roasted red peppers",
+	"sun-dried tomatoes",
+	"olive tapenade",
+	"chimichurri",
+	"harissa",
+	"gochujang",
+	"avocado",
+	"cucumbers",
+	"sprouts",
+	"spinach",
 }
 
 func (d *CondimentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -73,13 +136,28 @@ output "condiment_list" {
     for condiment in data.hw_condiments.all.condiments : upper(condiment)
   ]
 }
+
+# Page through the condiments, twenty at a time
+data "hw_condiments" "page_one" {
+  page_size = 20
+}
+
+data "hw_condiments" "page_two" {
+  page_size  = 20
+  page_token = data.hw_condiments.page_one.next_page_token
+}
+
+output "more_condiments" {
+  value = data.hw_condiments.page_two.next_page_token != null
+}
 ` + "```" + `
 
 **Key Concepts:**
 - Demonstrates **read-only data sources**
 - Returns a list of available condiment strings
-- No input parameters required
+- No input parameters required; without them the whole catalog comes back at once
 - Use ` + "`data.hw_condiments.all.condiments`" + ` to access the list
+- Demonstrates **token pagination**: ` + "`page_size`" + ` limits a read to one page, and ` + "`next_page_token`" + ` feeds the next read's ` + "`page_token`" + ` until it comes back null. Set ` + "`all_pages`" + ` to have the data source follow the tokens for you
 
 *Sauces and spreads wait,*
 *Flavor enhancers ready,*
@@ -88,9 +166,13 @@ output "condiment_list" {
 		Attributes: map[string]schema.Attribute{
 			"condiments": schema.ListAttribute{
 				ElementType:         types.StringType,
-				MarkdownDescription: "List of available condiments",
+				MarkdownDescription: "List of available condiments, or the requested page of them",
 				Computed:            true,
 			},
+			"page_size":       pageSizeAttribute(),
+			"page_token":      pageTokenAttribute(),
+			"all_pages":       allPagesAttribute(),
+			"next_page_token": nextPageTokenAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Data source identifier",
 				Computed:            true,
@@ -118,45 +200,32 @@ func (d *CondimentsDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	// Mock data - list of condiments
-	condimentsList := []string{
-		"mayonnaise",
-		"mustard",
-		"ketchup",
-		"relish",
-		"pickles",
-		"onions",
-		"lettuce",
-		"tomato",
-		"hot sauce",
-		"ranch",
-		"thousand island",
-		"italian dressing",
-		"oil and vinegar",
-		"horseradish",
-		"pesto",
-		"hummus",
-		"guacamole",
-		"salsa",
-		"chipotle mayo",
-		"aioli",
-		"tzatziki",
-		"barbecue sauce",
-	}
-
-	// Convert to Terraform types
-	condimentsValues := make([]attr.Value, len(condimentsList))
-	for i, condiment := range condimentsList {
-		condimentsValues[i] = types.StringValue(condiment)
+	page, next, diags := pageOf("condiments", condimentCatalog, data.PageSize, data.PageToken, data.AllPages)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	condiments, diags := types.ListValue(types.StringType, condimentsValues)
+	// Convert to Terraform types, once per provider instance
+	condiments, diags := cachedValue(catalogsOf(d.client), "condiments/condiments", func() (types.List, diag.Diagnostics) {
+		condimentsValues := make([]attr.Value, len(condimentCatalog))
+		for i, condiment := range condimentCatalog {
+			condimentsValues[i] = types.StringValue(condiment)
+		}
+		return types.ListValue(types.StringType, condimentsValues)
+	})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if len(page) < len(condimentCatalog) {
+		condiments, diags = types.ListValueFrom(ctx, types.StringType, page)
+		resp.Diagnostics.Append(diags...)
+	}
+
 	data.Condiments = condiments
+	data.NextPageToken = next
 	data.Id = types.StringValue("condiments")
 
 	tflog.Trace(ctx, "read condiments data source")
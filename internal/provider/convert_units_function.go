@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ConvertUnitsFunction{}
+
+func NewConvertUnitsFunction() function.Function {
+	return &ConvertUnitsFunction{}
+}
+
+// ConvertUnitsFunction defines the function implementation.
+type ConvertUnitsFunction struct{}
+
+func (f *ConvertUnitsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "convert_units"
+}
+
+func (f *ConvertUnitsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts a quantity between units of mass or volume",
+		MarkdownDescription: `Converts a quantity between kitchen units: ` + "`g`" + `, ` + "`kg`" + `, ` + "`oz`" + ` and ` + "`lb`" + ` for mass, and ` + "`ml`" + ` and ` + "`l`" + ` for volume. It is the same conversion ` + "`hw_pantry`" + ` applies to ingredients stocked in a ` + "`unit`" + ` other than pounds.
+
+**Example Usage:**
+
+` + "```hcl" + `
+locals {
+  delivery_kg = { flour = 25, sugar = 10 }
+}
+
+# Stock a pantry from a delivery note in kilograms
+resource "hw_pantry" "back_room" {
+  size = "medium"
+
+  ingredients = {
+    for name, kg in local.delivery_kg : name => provider::hw::convert_units(kg, "kg", "lb")
+  }
+}
+
+output "oil_liters" {
+  value = provider::hw::convert_units(1500, "ml", "l") # 1.5
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates a **reusable utility function** the provider also calls internally
+- Conversions are exact: 16 oz is 1 lb, not 0.9999999
+- Converting between mass and volume, or to a unit it doesn't know, fails with an argument error naming the problem
+
+*Grams to pounds and back,*
+*Liters poured into small cups,*
+*The flour stays the same.*`,
+		Parameters: []function.Parameter{
+			function.NumberParameter{
+				Name:                "value",
+				MarkdownDescription: "Quantity to convert",
+			},
+			function.StringParameter{
+				Name:                "from",
+				MarkdownDescription: "Unit the quantity is in: g, kg, oz, lb, ml, or l",
+			},
+			function.StringParameter{
+				Name:                "to",
+				MarkdownDescription: "Unit to convert to, of the same dimension as `from`",
+			},
+		},
+		Return: function.NumberReturn{},
+	}
+}
+
+func (f *ConvertUnitsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value *big.Float
+	var from, to string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value, &from, &to))
+	if resp.Error != nil {
+		return
+	}
+
+	converted, err := convertUnits(value, from, to)
+	if err != nil {
+		// Blame the argument that doesn't fit: an unknown from, or else to
+		position := int64(2)
+		if _, ok := unitSizes[from]; !ok {
+			position = 1
+		}
+		resp.Error = function.NewArgumentFuncError(position, "Unable to convert units: "+err.Error()+".")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, converted))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -26,11 +27,19 @@ type CookResource struct {
 }
 
 type CookResourceModel struct {
-	Name        types.String `tfsdk:"name"`
-	Experience  types.String `tfsdk:"experience"`
-	Description types.String `tfsdk:"description"`
-	Cost        types.Number `tfsdk:"cost"`
-	Id          types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Experience      types.String `tfsdk:"experience"`
+	Description     types.String `tfsdk:"description"`
+	Cost            MoneyValue   `tfsdk:"cost"`
+	HoursPerWeek    types.Number `tfsdk:"hours_per_week"`
+	OvertimeAllowed types.Bool   `tfsdk:"overtime_allowed"`
+	WeeklyCost      MoneyValue   `tfsdk:"weekly_cost"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Version         types.Int64  `tfsdk:"version"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
 }
 
 func (r *CookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -49,7 +58,7 @@ resource "hw_cook" "junior" {
   name        = "Alex"
   experience  = "junior"
   description = "Junior cook starting out"
-  # cost computed as $120/day
+  # weekly_cost computed as $600 (40 hours × $15/hour)
 }
 
 # Experienced cook
@@ -57,7 +66,7 @@ resource "hw_cook" "experienced" {
   name        = "Sam"
   experience  = "experienced"
   description = "Experienced cook"
-  # cost computed as $160/day
+  # weekly_cost computed as $800 (40 hours × $20/hour)
 }
 
 # Expert cook
@@ -65,7 +74,23 @@ resource "hw_cook" "expert" {
   name        = "Jordan"
   experience  = "expert"
   description = "Expert chef"
-  # cost computed as $200/day
+  # weekly_cost computed as $1000 (40 hours × $25/hour)
+}
+
+# Part-time and overtime schedules
+resource "hw_cook" "part_time" {
+  name           = "Riley"
+  experience     = "junior"
+  hours_per_week = 20
+  # weekly_cost computed as $300 (20 hours × $15/hour)
+}
+
+resource "hw_cook" "double_shift" {
+  name             = "Morgan"
+  experience       = "experienced"
+  hours_per_week   = 50
+  overtime_allowed = true
+  # weekly_cost computed as $1100 (40 × $20 + 10 × $30 overtime)
 }
 
 # Multiple cooks for a store
@@ -85,8 +110,9 @@ resource "hw_cook" "team" {
 **Key Concepts:**
 - Demonstrates **conditional cost calculation** based on experience
 - Required for ` + "`hw_store`" + ` resource (at least one cook)
-- Experience levels: junior ($120/day), experienced ($160/day), expert ($200/day)
-- Cost is automatically computed
+- Experience levels set the daily rate: junior ($120/day), experienced ($160/day), expert ($200/day)
+- ` + "`weekly_cost`" + ` combines the hourly rate (daily rate / 8), ` + "`hours_per_week`" + `, and time-and-a-half **overtime** past 40 hours
+- ` + "`cost`" + ` is deprecated: it is now ` + "`weekly_cost`" + ` spread over a 5-day week, so it follows the schedule too
 
 *Hands that craft with care,*
 *Experience shapes each sandwich,*
@@ -106,9 +132,32 @@ resource "hw_cook" "team" {
 				Optional:            true,
 			},
 			"cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Average daily cost in dollars: `weekly_cost` over a 5-day week ($120, $160, or $200 for a 40-hour junior, experienced, or expert cook)",
+				DeprecationMessage:  "Use weekly_cost instead. cost is weekly_cost divided by 5 and will be removed in a future version.",
+			},
+			"hours_per_week": schema.NumberAttribute{
+				MarkdownDescription: "Hours the cook is scheduled per week (defaults to 40). Hours past 40 are overtime",
+				Optional:            true,
+			},
+			"overtime_allowed": schema.BoolAttribute{
+				MarkdownDescription: "Whether the cook may be scheduled past 40 hours a week (defaults to false)",
+				Optional:            true,
+			},
+			"weekly_cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Weekly labor cost in dollars: the daily rate spread over an 8-hour day, paid time-and-a-half for overtime hours",
+			},
+			"price_multiplier": schema.NumberAttribute{
 				Computed:            true,
-				MarkdownDescription: "Daily cost in dollars (junior=$120/day, experienced=$160/day, expert=$200/day)",
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
 			},
+			"version":  versionAttribute(),
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Cook identifier",
@@ -146,24 +195,17 @@ func (r *CookResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 
-	// Calculate cost based on experience
-	var basePrice *big.Float
+	// Calculate cost from the experience and schedule
 	experience := data.Experience.ValueString()
-	switch experience {
-	case "junior":
-		basePrice = big.NewFloat(120.00)
-	case "experienced":
-		basePrice = big.NewFloat(160.00)
-	case "expert":
-		basePrice = big.NewFloat(200.00)
-	default:
-		basePrice = big.NewFloat(120.00) // default to junior
-	}
+	basePrice := r.client.VariantPrice("cook", experience, "junior")
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
 
-	finalPrice := ApplyUpcharge(basePrice, r.client.Upcharge)
-	data.Cost = types.NumberValue(finalPrice)
+	resp.Diagnostics.Append(r.setWeeklyCost(&data, basePrice)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	id := fmt.Sprintf("cook-%s-%d", data.Name.ValueString(), len(data.Name.ValueString()))
+	id := r.client.NewId("cook", fmt.Sprintf("%s-%d", data.Name.ValueString(), len(data.Name.ValueString())))
 	data.Id = types.StringValue(id)
 
 	tflog.Trace(ctx, "created a cook resource", map[string]any{
@@ -173,6 +215,11 @@ func (r *CookResource) Create(ctx context.Context, req resource.CreateRequest, r
 		"cost":       data.Cost.ValueBigFloat().String(),
 	})
 
+	data.Version = types.Int64Value(1)
+	r.client.Registry.SetVersion(data.Id.ValueString(), 1)
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -186,21 +233,18 @@ func (r *CookResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 
 	// Recalculate cost
-	var basePrice *big.Float
 	experience := data.Experience.ValueString()
-	switch experience {
-	case "junior":
-		basePrice = big.NewFloat(120.00)
-	case "experienced":
-		basePrice = big.NewFloat(160.00)
-	case "expert":
-		basePrice = big.NewFloat(200.00)
-	default:
-		basePrice = big.NewFloat(120.00)
+	basePrice := r.client.VariantPrice("cook", experience, "junior")
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+
+	resp.Diagnostics.Append(r.setWeeklyCost(&data, basePrice)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	finalPrice := ApplyUpcharge(basePrice, r.client.Upcharge)
-	data.Cost = types.NumberValue(finalPrice)
+	data.Version = refreshVersion(r.client.Registry, data.Id.ValueString(), data.Version)
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -215,21 +259,14 @@ func (r *CookResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 
 	// Recalculate cost
-	var basePrice *big.Float
 	experience := data.Experience.ValueString()
-	switch experience {
-	case "junior":
-		basePrice = big.NewFloat(120.00)
-	case "experienced":
-		basePrice = big.NewFloat(160.00)
-	case "expert":
-		basePrice = big.NewFloat(200.00)
-	default:
-		basePrice = big.NewFloat(120.00)
-	}
+	basePrice := r.client.VariantPrice("cook", experience, "junior")
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
 
-	finalPrice := ApplyUpcharge(basePrice, r.client.Upcharge)
-	data.Cost = types.NumberValue(finalPrice)
+	resp.Diagnostics.Append(r.setWeeklyCost(&data, basePrice)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	var state CookResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -237,13 +274,26 @@ func (r *CookResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	resp.Diagnostics.Append(checkVersion(r.client.Registry, state.Id.ValueString(), state.Version)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if !data.Name.Equal(state.Name) || !data.Experience.Equal(state.Experience) {
-		id := fmt.Sprintf("cook-%s-%d", data.Name.ValueString(), len(data.Name.ValueString()))
+		id := r.client.NewId("cook", fmt.Sprintf("%s-%d", data.Name.ValueString(), len(data.Name.ValueString())))
 		data.Id = types.StringValue(id)
 	} else {
 		data.Id = state.Id
 	}
 
+	if !data.Id.Equal(state.Id) {
+		r.client.Registry.Delete(state.Id.ValueString())
+	}
+	data.Version = nextVersion(state.Version)
+	r.client.Registry.SetVersion(data.Id.ValueString(), data.Version.ValueInt64())
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -256,6 +306,8 @@ func (r *CookResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 
 
+	r.client.Registry.Delete(data.Id.ValueString())
+
 	tflog.Trace(ctx, "deleted a cook resource", map[string]any{
 		"id": data.Id.ValueString(),
 	})
@@ -264,3 +316,76 @@ func (r *CookResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 func (r *CookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// cookThroughput returns how many customers per hour a cook of the given
+// experience level can serve. Unknown levels are treated as junior, matching
+// how cost is priced.
+func cookThroughput(experience string) float64 {
+	switch experience {
+	case "experienced":
+		return 12.0
+	case "expert":
+		return 15.0
+	default:
+		return 8.0
+	}
+}
+
+// Labor rules for weekly_cost: the daily rate covers an 8-hour day, a
+// standard week is 40 hours over 5 days, and overtime is paid at 150%.
+const (
+	cookHoursPerDay     = 8
+	cookWorkDays        = 5
+	cookStandardWeek    = 40
+	cookOvertimePercent = 150
+	cookMaxHoursPerWeek = 168
+)
+
+// setWeeklyCost computes weekly_cost from the cook's daily base price and
+// schedule, then adds the provider upcharge once, and derives the deprecated
+// daily cost from it. Scheduling past the standard week without
+// overtime_allowed is an error.
+func (r *CookResource) setWeeklyCost(data *CookResourceModel, dailyPrice *big.Float) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	hours := big.NewFloat(cookStandardWeek)
+	if !data.HoursPerWeek.IsNull() && !data.HoursPerWeek.IsUnknown() {
+		hours = data.HoursPerWeek.ValueBigFloat()
+	}
+	if hours.Sign() < 0 || hours.Cmp(big.NewFloat(cookMaxHoursPerWeek)) > 0 {
+		diags.AddAttributeError(
+			path.Root("hours_per_week"),
+			"Invalid Hours Per Week",
+			fmt.Sprintf("hours_per_week must be between 0 and %d, got %s.", cookMaxHoursPerWeek, hours.String()),
+		)
+		return diags
+	}
+
+	regular := hours
+	overtime := new(big.Float)
+	if hours.Cmp(big.NewFloat(cookStandardWeek)) > 0 {
+		regular = big.NewFloat(cookStandardWeek)
+		overtime.Sub(hours, regular)
+		if !data.OvertimeAllowed.ValueBool() {
+			diags.AddAttributeError(
+				path.Root("hours_per_week"),
+				"Overtime Not Allowed",
+				fmt.Sprintf("%s hours per week includes %s hours of overtime. Set overtime_allowed = true or schedule at most %d hours.",
+					hours.String(), overtime.String(), cookStandardWeek),
+			)
+			return diags
+		}
+	}
+
+	hourlyRate := new(big.Float).Quo(dailyPrice, big.NewFloat(cookHoursPerDay))
+	overtimeRate := new(big.Float).Mul(hourlyRate, big.NewFloat(cookOvertimePercent))
+	overtimeRate.Quo(overtimeRate, big.NewFloat(100))
+
+	var weekly big.Float
+	weekly.Mul(regular, hourlyRate)
+	weekly.Add(&weekly, new(big.Float).Mul(overtime, overtimeRate))
+
+	data.WeeklyCost = r.client.Price(ApplyUpcharge(&weekly, r.client.Upcharge))
+	data.Cost = r.client.Price(new(big.Float).Quo(data.WeeklyCost.ValueBigFloat(), big.NewFloat(cookWorkDays)))
+	return diags
+}
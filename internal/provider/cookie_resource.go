@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -29,10 +28,15 @@ type CookieResource struct {
 
 // CookieResourceModel describes the resource data model.
 type CookieResourceModel struct {
-	Description types.String `tfsdk:"description"`
-	Kind        types.String `tfsdk:"kind"`
-	Price       types.Number `tfsdk:"price"`
-	Id          types.String `tfsdk:"id"`
+	Description     types.String `tfsdk:"description"`
+	Kind            types.String `tfsdk:"kind"`
+	Price           MoneyValue   `tfsdk:"price"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	HappyHourActive types.Bool   `tfsdk:"happy_hour_active"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
 }
 
 func (r *CookieResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -92,9 +96,21 @@ resource "hw_cookie" "menu" {
 				Required:            true,
 			},
 			"price": schema.NumberAttribute{
+				CustomType:          MoneyType{},
 				Computed:            true,
 				MarkdownDescription: "The price of the cookie in dollars (hardcoded to $1.50)",
 			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"happy_hour_active": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the provider's `happy_hour` discount was applied to `price`",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Cookie identifier",
@@ -136,13 +152,15 @@ func (r *CookieResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Simulate API delay
 
-	// Set base price: $1.50, then apply upcharge
-	basePrice := big.NewFloat(1.50)
-	finalPrice := ApplyUpcharge(basePrice, r.client.Upcharge)
-	data.Price = types.NumberValue(finalPrice)
+	// Set base price: $1.50, then apply upcharge and any happy hour discount
+	basePrice := r.client.BasePrice("cookie")
+	finalPrice, happyHour := r.client.HappyHourPrice(ApplyUpcharge(basePrice, r.client.Upcharge))
+	data.Price = r.client.Price(finalPrice)
+	data.HappyHourActive = types.BoolValue(happyHour)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
 
 	// Mock resource creation - generate a fake ID based on the kind
-	id := fmt.Sprintf("cookie-%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString()))
+	id := r.client.NewId("cookie", fmt.Sprintf("%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString())))
 	data.Id = types.StringValue(id)
 
 	tflog.Trace(ctx, "created a cookie resource", map[string]any{
@@ -150,6 +168,8 @@ func (r *CookieResource) Create(ctx context.Context, req resource.CreateRequest,
 		"kind": data.Kind.ValueString(),
 	})
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -167,13 +187,17 @@ func (r *CookieResource) Read(ctx context.Context, req resource.ReadRequest, res
 	// Simulate API delay
 
 	// Ensure price is set (in case it wasn't in state)
-	basePrice := big.NewFloat(1.50)
-	finalPrice := ApplyUpcharge(basePrice, r.client.Upcharge)
-	data.Price = types.NumberValue(finalPrice)
+	basePrice := r.client.BasePrice("cookie")
+	finalPrice, happyHour := r.client.HappyHourPrice(ApplyUpcharge(basePrice, r.client.Upcharge))
+	data.Price = r.client.Price(finalPrice)
+	data.HappyHourActive = types.BoolValue(happyHour)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
 
 	// Mock resource read - just return the existing state
 	// In a real implementation, this would fetch from an API
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -191,9 +215,11 @@ func (r *CookieResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Simulate API delay
 
 	// Ensure price is always set to $1.50 + upcharge
-	basePrice := big.NewFloat(1.50)
-	finalPrice := ApplyUpcharge(basePrice, r.client.Upcharge)
-	data.Price = types.NumberValue(finalPrice)
+	basePrice := r.client.BasePrice("cookie")
+	finalPrice, happyHour := r.client.HappyHourPrice(ApplyUpcharge(basePrice, r.client.Upcharge))
+	data.Price = r.client.Price(finalPrice)
+	data.HappyHourActive = types.BoolValue(happyHour)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
 
 	// Mock resource update - regenerate ID if kind changed
 	var state CookieResourceModel
@@ -204,13 +230,15 @@ func (r *CookieResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	// If kind changed, regenerate ID
 	if !data.Kind.Equal(state.Kind) {
-		id := fmt.Sprintf("cookie-%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString()))
+		id := r.client.NewId("cookie", fmt.Sprintf("%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString())))
 		data.Id = types.StringValue(id)
 	} else {
 		// Keep existing ID
 		data.Id = state.Id
 	}
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -3,13 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
-	"math/big"
+	"math"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -17,6 +18,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &CrackerResource{}
 var _ resource.ResourceWithImportState = &CrackerResource{}
+var _ resource.ResourceWithUpgradeState = &CrackerResource{}
 
 func NewCrackerResource() resource.Resource {
 	return &CrackerResource{}
@@ -29,11 +31,19 @@ type CrackerResource struct {
 
 // CrackerResourceModel describes the resource data model.
 type CrackerResourceModel struct {
-	Description types.String `tfsdk:"description"`
-	Kind        types.String `tfsdk:"kind"`
-	Quantity    types.Number `tfsdk:"quantity"`
-	Price       types.Number `tfsdk:"price"`
-	Id          types.String `tfsdk:"id"`
+	Description     types.String `tfsdk:"description"`
+	Kind            types.String `tfsdk:"kind"`
+	Quantity        types.Int64  `tfsdk:"quantity"`
+	Price           MoneyValue   `tfsdk:"price"`
+	UnitPrice       MoneyValue   `tfsdk:"unit_price"`
+	Subtotal        MoneyValue   `tfsdk:"subtotal"`
+	Discount        MoneyValue   `tfsdk:"discount"`
+	Total           MoneyValue   `tfsdk:"total"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
 }
 
 func (r *CrackerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -42,6 +52,8 @@ func (r *CrackerResource) Metadata(ctx context.Context, req resource.MetadataReq
 
 func (r *CrackerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		// Version 1 made quantity a whole number
+		Version: 1,
 		MarkdownDescription: `A crunchy companion resource perfect for soups and spreads. Demonstrates how simple resources with quantity-based pricing can be managed efficiently in Terraform configurations.
 
 **Example Usage:**
@@ -84,7 +96,8 @@ resource "hw_cracker" "menu" {
 - Demonstrates **string and number attributes** together
 - Shows **quantity-based pricing** ($0.50 per pack)
 - Useful for learning resource attributes
-- Price = quantity × $0.50
+- Itemizes ` + "`unit_price`" + `, ` + "`subtotal`" + `, ` + "`discount`" + ` and ` + "`total`" + `
+- Bulk discount: 5% from 25 packs, 10% from 50, 15% from 100
 
 *Golden and crisp,*
 *Snapping with each bite,*
@@ -99,14 +112,45 @@ resource "hw_cracker" "menu" {
 				MarkdownDescription: "The kind of crackers (e.g., saltine, oyster, graham)",
 				Required:            true,
 			},
-			"quantity": schema.NumberAttribute{
+			"quantity": schema.Int64Attribute{
 				MarkdownDescription: "The number of cracker packs",
 				Required:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Quantity", min: 1, max: math.MaxInt64},
+				},
 			},
 			"price": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The total price of the crackers in dollars (same as `total`)",
+			},
+			"unit_price": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The base price per pack in dollars ($0.50 unless overridden)",
+			},
+			"subtotal": schema.NumberAttribute{
+				CustomType:          MoneyType{},
 				Computed:            true,
-				MarkdownDescription: "The price of the crackers in dollars (hardcoded to $0.50 per pack)",
+				MarkdownDescription: "The undiscounted price in dollars (quantity × unit_price)",
 			},
+			"discount": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The bulk discount in dollars (5% from 25 units, 10% from 50, 15% from 100)",
+			},
+			"total": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The final price in dollars (subtotal - discount + upcharge)",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Cracker identifier",
@@ -148,24 +192,21 @@ func (r *CrackerResource) Create(ctx context.Context, req resource.CreateRequest
 
 	// Simulate API delay
 
-	// Calculate base price: $0.50 per pack, then apply upcharge
-	quantity := data.Quantity.ValueBigFloat()
-	pricePerPack := big.NewFloat(0.50)
-	var basePrice big.Float
-	basePrice.Mul(quantity, pricePerPack)
-	finalPrice := ApplyUpcharge(&basePrice, r.client.Upcharge)
-	data.Price = types.NumberValue(finalPrice)
+	// Itemize price: $0.50 per pack, less any bulk discount, then apply upcharge
+	r.setPrices(&data)
 
 	// Mock resource creation - generate a fake ID based on the kind
-	id := fmt.Sprintf("cracker-%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString()))
+	id := r.client.NewId("cracker", fmt.Sprintf("%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString())))
 	data.Id = types.StringValue(id)
 
 	tflog.Trace(ctx, "created a cracker resource", map[string]any{
 		"id":       data.Id.ValueString(),
 		"kind":     data.Kind.ValueString(),
-		"quantity": data.Quantity.ValueBigFloat().String(),
+		"quantity": data.Quantity.ValueInt64(),
 	})
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -183,15 +224,13 @@ func (r *CrackerResource) Read(ctx context.Context, req resource.ReadRequest, re
 	// Simulate API delay
 
 	// Recalculate price based on quantity
-	quantity := data.Quantity.ValueBigFloat()
-	pricePerPack := big.NewFloat(0.50)
-	var totalPrice big.Float
-	totalPrice.Mul(quantity, pricePerPack)
-	data.Price = types.NumberValue(&totalPrice)
+	r.setPrices(&data)
 
 	// Mock resource read - just return the existing state
 	// In a real implementation, this would fetch from an API
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -209,11 +248,7 @@ func (r *CrackerResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Simulate API delay
 
 	// Recalculate price based on quantity
-	quantity := data.Quantity.ValueBigFloat()
-	pricePerPack := big.NewFloat(0.50)
-	var totalPrice big.Float
-	totalPrice.Mul(quantity, pricePerPack)
-	data.Price = types.NumberValue(&totalPrice)
+	r.setPrices(&data)
 
 	// Mock resource update - regenerate ID if kind or quantity changed
 	var state CrackerResourceModel
@@ -224,13 +259,15 @@ func (r *CrackerResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	// If kind changed, regenerate ID
 	if !data.Kind.Equal(state.Kind) {
-		id := fmt.Sprintf("cracker-%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString()))
+		id := r.client.NewId("cracker", fmt.Sprintf("%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString())))
 		data.Id = types.StringValue(id)
 	} else {
 		// Keep existing ID
 		data.Id = state.Id
 	}
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -253,6 +290,25 @@ func (r *CrackerResource) Delete(ctx context.Context, req resource.DeleteRequest
 	})
 }
 
+func (r *CrackerResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: numberToInt64Upgrader("quantity"),
+	}
+}
+
 func (r *CrackerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// setPrices itemizes the cracker price for the configured quantity: the
+// per-pack price from the pricing engine ($0.50 by default), the subtotal,
+// the bulk discount and the total including upcharge. Price mirrors the total.
+func (r *CrackerResource) setPrices(data *CrackerResourceModel) {
+	bulk := r.client.BulkPriceFor("cracker", data.Quantity.ValueInt64())
+	data.UnitPrice = NewMoneyValue(bulk.UnitPrice)
+	data.Subtotal = NewMoneyValue(bulk.Subtotal)
+	data.Discount = NewMoneyValue(bulk.Discount)
+	data.Total = r.client.Price(bulk.Total)
+	data.Price = r.client.Price(bulk.Total)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+}
@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CupResource{}
+var _ resource.ResourceWithImportState = &CupResource{}
+
+func NewCupResource() resource.Resource {
+	return &CupResource{}
+}
+
+// CupResource defines the resource implementation.
+type CupResource struct {
+	client *ProviderConfig
+}
+
+// CupResourceModel describes the resource data model.
+type CupResourceModel struct {
+	Description     types.String `tfsdk:"description"`
+	Quantity        types.Int64  `tfsdk:"quantity"`
+	Price           MoneyValue   `tfsdk:"price"`
+	UnitPrice       MoneyValue   `tfsdk:"unit_price"`
+	Subtotal        MoneyValue   `tfsdk:"subtotal"`
+	Discount        MoneyValue   `tfsdk:"discount"`
+	Total           MoneyValue   `tfsdk:"total"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
+}
+
+func (r *CupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cup"
+}
+
+func (r *CupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Paper cups for drinks to go. A bag that carries cups needs a straw for each one, so list both in the bag's ` + "`packaging_ids`" + ` and the provider checks they match up.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_cup" "medium" {
+  quantity = 100
+  # total computed as $17.00 ($20.00 less the 15% bulk discount)
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates **quantity-based resources** sharing the bulk-discount engine with ` + "`hw_napkin`" + `
+- Shows **computed pricing** ($0.20 per cup)
+- Itemizes ` + "`unit_price`" + `, ` + "`subtotal`" + `, ` + "`discount`" + ` and ` + "`total`" + `
+- Bulk discount: 5% from 25 cups, 10% from 50, 15% from 100
+- A ` + "`hw_bag`" + ` with cups in its ` + "`packaging_ids`" + ` needs at least as many ` + "`hw_straw`" + ` as cups
+
+*Wax-lined paper cup,*
+*Ice settles under the lid,*
+*Sweat rings on the tray.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description of the cup resource",
+				Optional:            true,
+			},
+			"quantity": schema.Int64Attribute{
+				MarkdownDescription: "The number of cups",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Quantity", min: 1, max: math.MaxInt64},
+				},
+			},
+			"price": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The total price of the cups in dollars (same as `total`)",
+			},
+			"unit_price": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The base price per cup in dollars ($0.20 unless overridden)",
+			},
+			"subtotal": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The undiscounted price in dollars (quantity × unit_price)",
+			},
+			"discount": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The bulk discount in dollars (5% from 25 units, 10% from 50, 15% from 100)",
+			},
+			"total": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The final price in dollars (subtotal - discount + upcharge)",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Cup identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *CupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Itemize price: $0.20 per cup, less any bulk discount, then apply upcharge
+	quantity := data.Quantity.ValueInt64()
+	r.setPrices(&data)
+
+	id := r.client.NewId("cup", fmt.Sprintf("qty-%d", quantity))
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created a cup resource", map[string]any{
+		"id":       data.Id.ValueString(),
+		"quantity": data.Quantity.ValueInt64(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
+	// Record the cups so hw_bag can check its packaging
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate price based on quantity
+	r.setPrices(&data)
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate price based on quantity
+	quantity := data.Quantity.ValueInt64()
+	r.setPrices(&data)
+
+	var state CupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep existing ID unless quantity changed
+	if !data.Quantity.Equal(state.Quantity) {
+		id := r.client.NewId("cup", fmt.Sprintf("qty-%d", quantity))
+		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted a cup resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *CupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setPrices itemizes the cup price for the configured quantity: the
+// per-cup price from the pricing engine ($0.20 by default), the subtotal,
+// the bulk discount and the total including upcharge. Price mirrors the total.
+func (r *CupResource) setPrices(data *CupResourceModel) {
+	bulk := r.client.BulkPriceFor("cup", data.Quantity.ValueInt64())
+	data.UnitPrice = NewMoneyValue(bulk.UnitPrice)
+	data.Subtotal = NewMoneyValue(bulk.Subtotal)
+	data.Discount = NewMoneyValue(bulk.Discount)
+	data.Total = r.client.Price(bulk.Total)
+	data.Price = r.client.Price(bulk.Total)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+}
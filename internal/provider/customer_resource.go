@@ -0,0 +1,327 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &CustomerResource{}
+var _ resource.ResourceWithImportState = &CustomerResource{}
+
+func NewCustomerResource() resource.Resource {
+	return &CustomerResource{}
+}
+
+type CustomerResource struct {
+	client *ProviderConfig
+}
+
+type CustomerResourceModel struct {
+	Name                types.String `tfsdk:"name"`
+	FavoriteItem        types.String `tfsdk:"favorite_item"`
+	DietaryRestrictions types.Set    `tfsdk:"dietary_restrictions"`
+	SuggestedOrder      types.List   `tfsdk:"suggested_order"`
+	Tags                types.Map    `tfsdk:"tags"`
+	TagsAll             types.Map    `tfsdk:"tags_all"`
+	Haiku               types.String `tfsdk:"haiku"`
+	Id                  types.String `tfsdk:"id"`
+}
+
+// dietaryRestrictions are the accepted values of dietary_restrictions.
+var dietaryRestrictions = []string{"vegetarian", "vegan", "gluten_free", "dairy_free", "nut_free"}
+
+// menuItemDiets lists, for each ticket item, the dietary restrictions it is
+// safe for.
+var menuItemDiets = map[string][]string{
+	"sandwich":    {"nut_free"},
+	"drink":       {"vegetarian", "vegan", "gluten_free", "dairy_free", "nut_free"},
+	"soup":        {"vegetarian", "gluten_free", "nut_free"},
+	"salad":       {"vegetarian", "vegan", "gluten_free", "dairy_free", "nut_free"},
+	"cookie":      {"vegetarian", "nut_free"},
+	"brownie":     {"vegetarian"},
+	"stroopwafel": {"vegetarian", "nut_free"},
+}
+
+// menuCourses are the courses of a suggested order, each listing its ticket
+// items in order of preference.
+var menuCourses = [][]string{
+	{"sandwich", "salad", "soup"},
+	{"drink"},
+	{"cookie", "brownie", "stroopwafel"},
+}
+
+func (r *CustomerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_customer"
+}
+
+func (r *CustomerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The regular who keeps coming back. This resource models a customer's tastes and dietary needs and suggests an order from the menu that fits them, a people-centric building block for loyalty cards and orders.
+
+**Example Usage:**
+
+` + "```hcl" + `
+# A customer with no restrictions
+resource "hw_customer" "pat" {
+  name          = "Pat"
+  favorite_item = "brownie"
+  # suggested_order computed as ["sandwich", "drink", "brownie"]
+}
+
+# A vegan customer
+resource "hw_customer" "robin" {
+  name                 = "Robin"
+  favorite_item        = "salad"
+  dietary_restrictions = ["vegan"]
+  # suggested_order computed as ["salad", "drink"]
+}
+
+output "robin_orders" {
+  value = hw_customer.robin.suggested_order
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates **set attributes** (` + "`dietary_restrictions`" + `) where order doesn't matter
+- Computes a **list attribute** by filtering the menu
+- Restrictions: vegetarian, vegan, gluten_free, dairy_free, nut_free
+- The suggested order is one main, one drink, and one dessert, skipping any course with nothing safe to eat
+- The favorite item is chosen for its course whenever it fits the restrictions
+
+*A familiar face,*
+*Knows the menu by its heart,*
+*Orders just the same.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the customer",
+				Required:            true,
+			},
+			"favorite_item": schema.StringAttribute{
+				MarkdownDescription: "The customer's favorite menu item (sandwich, drink, soup, salad, cookie, brownie, or stroopwafel)",
+				Optional:            true,
+			},
+			"dietary_restrictions": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Set of dietary restrictions (vegetarian, vegan, gluten_free, dairy_free, nut_free)",
+				Optional:            true,
+			},
+			"suggested_order": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Menu items suggested for the customer: a main, a drink, and a dessert that fit the dietary restrictions, preferring the favorite item",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Customer identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CustomerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *CustomerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CustomerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setSuggestedOrder(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := r.client.NewId("customer", fmt.Sprintf("%s-%d", data.Name.ValueString(), len(data.Name.ValueString())))
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created a customer resource", map[string]any{
+		"id":   data.Id.ValueString(),
+		"name": data.Name.ValueString(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CustomerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate the suggested order
+	resp.Diagnostics.Append(setSuggestedOrder(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CustomerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate the suggested order
+	resp.Diagnostics.Append(setSuggestedOrder(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state CustomerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Name.Equal(state.Name) {
+		id := r.client.NewId("customer", fmt.Sprintf("%s-%d", data.Name.ValueString(), len(data.Name.ValueString())))
+		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CustomerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted a customer resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *CustomerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setSuggestedOrder validates the customer's favorite item and restrictions
+// and computes suggested_order: for each course, the favorite item if it is in
+// that course and fits the restrictions, otherwise the first item that does.
+func setSuggestedOrder(ctx context.Context, data *CustomerResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var restrictions []string
+	if !data.DietaryRestrictions.IsNull() && !data.DietaryRestrictions.IsUnknown() {
+		diags.Append(data.DietaryRestrictions.ElementsAs(ctx, &restrictions, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	for _, restriction := range restrictions {
+		if !slices.Contains(dietaryRestrictions, restriction) {
+			diags.AddAttributeError(
+				path.Root("dietary_restrictions").AtSetValue(types.StringValue(restriction)),
+				"Invalid Dietary Restriction",
+				fmt.Sprintf("Dietary restriction %q is not supported. Supported restrictions: %s.", restriction, strings.Join(dietaryRestrictions, ", ")),
+			)
+		}
+	}
+
+	favorite := data.FavoriteItem.ValueString()
+	if favorite != "" {
+		if _, ok := menuItemDiets[favorite]; !ok {
+			diags.AddAttributeError(
+				path.Root("favorite_item"),
+				"Invalid Favorite Item",
+				fmt.Sprintf("%q is not on the menu. Menu items: %s.", favorite, strings.Join(ticketItems, ", ")),
+			)
+		} else if !fitsDiet(favorite, restrictions) {
+			diags.AddAttributeWarning(
+				path.Root("favorite_item"),
+				"Favorite Item Doesn't Fit Restrictions",
+				fmt.Sprintf("%q is not safe for %s, so it is left out of the suggested order.", favorite, strings.Join(restrictions, ", ")),
+			)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	order := []string{}
+	for _, course := range menuCourses {
+		if slices.Contains(course, favorite) && fitsDiet(favorite, restrictions) {
+			order = append(order, favorite)
+			continue
+		}
+		for _, item := range course {
+			if fitsDiet(item, restrictions) {
+				order = append(order, item)
+				break
+			}
+		}
+	}
+
+	suggested, listDiags := types.ListValueFrom(ctx, types.StringType, order)
+	diags.Append(listDiags...)
+	data.SuggestedOrder = suggested
+	return diags
+}
+
+// fitsDiet reports whether the menu item is safe for every restriction.
+func fitsDiet(item string, restrictions []string) bool {
+	for _, restriction := range restrictions {
+		if !slices.Contains(menuItemDiets[item], restriction) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,312 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &DailySalesReportAction{}
+var _ action.ActionWithConfigure = &DailySalesReportAction{}
+
+// salesReportFormats are the formats hw_daily_sales_report writes.
+var salesReportFormats = []string{"json", "markdown"}
+
+// salesReportTopItems is how many of the best-selling menu items a daily
+// sales report lists.
+const salesReportTopItems = 5
+
+func NewDailySalesReportAction() action.Action {
+	return &DailySalesReportAction{}
+}
+
+// DailySalesReportAction defines the action implementation.
+type DailySalesReportAction struct {
+	client *ProviderConfig
+}
+
+// DailySalesReportActionModel describes the action data model.
+type DailySalesReportActionModel struct {
+	StoreId types.String `tfsdk:"store_id"`
+	Path    types.String `tfsdk:"path"`
+	Format  types.String `tfsdk:"format"`
+	Date    types.String `tfsdk:"date"`
+}
+
+// salesReport is a store's sales for one day, as written by
+// hw_daily_sales_report.
+type salesReport struct {
+	StoreId      string          `json:"store_id"`
+	Date         string          `json:"date"`
+	Orders       int             `json:"orders"`
+	Delivered    int             `json:"delivered"`
+	Revenue      string          `json:"revenue"`
+	AverageOrder string          `json:"average_order"`
+	TopItems     []salesItemRank `json:"top_items"`
+}
+
+// salesItemRank is how many of a menu item a day's orders included.
+type salesItemRank struct {
+	Item     string `json:"item"`
+	Quantity int    `json:"quantity"`
+}
+
+func (a *DailySalesReportAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_daily_sales_report"
+}
+
+func (a *DailySalesReportAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Totals a store's orders for one day, counting orders, revenue and the best-selling menu items, and writes the report to a file for the morning meeting.
+
+**Example Usage:**
+
+` + "```hcl" + `
+action "hw_daily_sales_report" "today" {
+  config {
+    store_id = hw_store.main.id
+    path     = "${path.module}/reports/sales.md"
+  }
+}
+
+action "hw_daily_sales_report" "archive" {
+  config {
+    store_id = hw_store.main.id
+    path     = "${path.module}/reports/2026-10-16.json"
+    date     = "2026-10-16"
+  }
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates an **action that produces a file artifact** rather than changing infrastructure
+- Counts the store's ` + "`hw_order`" + ` resources placed on ` + "`date`" + ` (UTC) and not refunded, with revenue from their totals and the top ` + fmt.Sprint(salesReportTopItems) + ` menu items by quantity
+- Writes JSON or a markdown table; without ` + "`format`" + `, a path ending in ` + "`.md`" + ` gets markdown and anything else JSON
+- Replaces the file if it exists, and creates its directory if it doesn't
+- Counts the orders the provider has records of: every order with the provider's ` + "`backend_path`" + ` set, otherwise only those read or changed in the same run, with a warning when the store itself wasn't
+
+*The register is closed,*
+*Receipts tallied into rows,*
+*Soup outsold the salad.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_store to report on",
+				Required:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "File to write the report to",
+				Required:            true,
+			},
+			"format": schema.StringAttribute{
+				MarkdownDescription: "Report format: json or markdown (defaults from the path's extension)",
+				Optional:            true,
+			},
+			"date": schema.StringAttribute{
+				MarkdownDescription: "Day to report on, as YYYY-MM-DD in UTC (defaults to the provider's `as_of` date, or today)",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *DailySalesReportAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	a.client = config
+}
+
+func (a *DailySalesReportAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data DailySalesReportActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storeId := data.StoreId.ValueString()
+	_, storeKnown, diags := lookupReference[StoreResourceModel](a.client, path.Root("store_id"), storeId, "store")
+	resp.Diagnostics.Append(diags...)
+
+	reportPath := data.Path.ValueString()
+	format := data.Format.ValueString()
+	if data.Format.IsNull() {
+		format = "json"
+		if strings.EqualFold(filepath.Ext(reportPath), ".md") {
+			format = "markdown"
+		}
+	} else if !slices.Contains(salesReportFormats, format) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("format"),
+			"Invalid Report Format",
+			fmt.Sprintf("format must be one of %s, got %q.%s", strings.Join(salesReportFormats, ", "), format, didYouMean(format, salesReportFormats)),
+		)
+	}
+
+	date := a.client.Today().Format(dateLayout)
+	if !data.Date.IsNull() {
+		date = data.Date.ValueString()
+		if _, err := time.Parse(dateLayout, date); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("date"),
+				"Invalid Report Date",
+				fmt.Sprintf("date must be a day in the form YYYY-MM-DD, got %q.", date),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	report := dailySales(a.client, storeId, date)
+
+	var out []byte
+	if format == "markdown" {
+		out = []byte(report.Markdown())
+	} else {
+		var err error
+		out, err = json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Encode Report", err.Error())
+			return
+		}
+		out = append(out, '\n')
+	}
+
+	err := os.MkdirAll(filepath.Dir(reportPath), 0o755)
+	if err == nil {
+		err = os.WriteFile(reportPath, out, 0o644)
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("path"),
+			"Unable to Write Report",
+			fmt.Sprintf("Could not write the sales report to %s: %s.", reportPath, err),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Wrote %s sales for %s to %s: %d orders, $%s.", date, storeId, reportPath, report.Orders, report.Revenue),
+	})
+
+	// Without the store's record, its orders most likely weren't read
+	// either
+	if !storeKnown {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("store_id"),
+			"Store Not Read",
+			fmt.Sprintf("The provider has no record of %s in this run, so the report only counts the orders it has read. Set the provider's backend_path for actions to see every order.", storeId),
+		)
+	}
+
+	tflog.Trace(ctx, "invoked daily sales report action", map[string]any{
+		"store_id": storeId,
+		"date":     date,
+		"format":   format,
+		"orders":   report.Orders,
+	})
+}
+
+// dailySales totals the orders placed at a store on date, a YYYY-MM-DD day
+// in UTC, leaving out refunded orders. Menu items are counted by the item
+// type encoded in their IDs, and items tied on quantity are ranked by name.
+// It is safe to call on a nil config.
+func dailySales(c *ProviderConfig, storeId, date string) salesReport {
+	var registry *Registry
+	if c != nil {
+		registry = c.Registry
+	}
+
+	report := salesReport{StoreId: storeId, Date: date, TopItems: []salesItemRank{}}
+
+	var revenue int64
+	quantities := map[string]int{}
+	for _, order := range ListRecords[OrderResourceModel](registry) {
+		// placed_at is RFC 3339 in UTC, so it starts with its day
+		if order.StoreId.ValueString() != storeId || !strings.HasPrefix(order.PlacedAt.ValueString(), date+"T") {
+			continue
+		}
+		// A refund gives the money back
+		if order.Status.ValueString() == "refunded" {
+			continue
+		}
+		report.Orders++
+		if order.Status.ValueString() == "delivered" {
+			report.Delivered++
+		}
+		revenue += order.Total.Cents()
+		for _, element := range order.ItemIds.Elements() {
+			if itemId, ok := element.(types.String); ok {
+				if item, ok := c.TypeOfId(itemId.ValueString(), ticketItems...); ok {
+					quantities[item]++
+				}
+			}
+		}
+	}
+
+	for item, quantity := range quantities {
+		report.TopItems = append(report.TopItems, salesItemRank{Item: item, Quantity: quantity})
+	}
+	slices.SortFunc(report.TopItems, func(a, b salesItemRank) int {
+		if a.Quantity != b.Quantity {
+			return b.Quantity - a.Quantity
+		}
+		return strings.Compare(a.Item, b.Item)
+	})
+	report.TopItems = report.TopItems[:min(len(report.TopItems), salesReportTopItems)]
+
+	report.Revenue = formatCents(revenue)
+	var average int64
+	if report.Orders > 0 {
+		average = revenue / int64(report.Orders)
+	}
+	report.AverageOrder = formatCents(average)
+	return report
+}
+
+// Markdown renders the report as a markdown heading, summary and table of
+// top items.
+func (r salesReport) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Daily Sales: %s\n\n", r.Date)
+	fmt.Fprintf(&b, "Store: `%s`\n\n", r.StoreId)
+	fmt.Fprintf(&b, "- Orders: %d (%d delivered)\n", r.Orders, r.Delivered)
+	fmt.Fprintf(&b, "- Revenue: $%s\n", r.Revenue)
+	fmt.Fprintf(&b, "- Average order: $%s\n", r.AverageOrder)
+
+	b.WriteString("\n## Top Items\n\n")
+	if len(r.TopItems) == 0 {
+		b.WriteString("No items sold.\n")
+		return b.String()
+	}
+	b.WriteString("| Item | Quantity |\n|------|----------|\n")
+	for _, item := range r.TopItems {
+		fmt.Fprintf(&b, "| %s | %d |\n", item.Item, item.Quantity)
+	}
+	return b.String()
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDailySales(t *testing.T) {
+	c := &ProviderConfig{Registry: NewRegistry()}
+	registry := c.Registry
+	order := func(id, storeId, status, placedAt string, totalCents int64, itemIds ...string) {
+		items := make([]attr.Value, len(itemIds))
+		for i, itemId := range itemIds {
+			items[i] = types.StringValue(itemId)
+		}
+		registry.Put(id, OrderResourceModel{
+			Id:       types.StringValue(id),
+			StoreId:  types.StringValue(storeId),
+			ItemIds:  types.ListValueMust(types.StringType, items),
+			Total:    NewMoneyCents(totalCents),
+			Status:   types.StringValue(status),
+			PlacedAt: types.StringValue(placedAt),
+		})
+	}
+	order("order-a-2", "store-main", "delivered", "2026-10-16T11:30:00Z", 750, "sandwich-blt-3", "drink-cola-4")
+	order("order-b-3", "store-main", "placed", "2026-10-16T12:05:00Z", 1001, "sandwich-club-4", "soup-tomato-6", "drink-tea-3")
+	order("order-c-1", "store-main", "delivered", "2026-10-15T18:00:00Z", 500, "sandwich-blt-3")
+	order("order-d-1", "store-other", "placed", "2026-10-16T09:00:00Z", 300, "cookie-choc-4")
+	order("order-e-1", "store-main", "refunded", "2026-10-16T13:15:00Z", 900, "soup-tomato-6")
+
+	report := dailySales(c, "store-main", "2026-10-16")
+	if report.Orders != 2 || report.Delivered != 1 {
+		t.Errorf("orders = %d (%d delivered), want 2 (1 delivered)", report.Orders, report.Delivered)
+	}
+	if report.Revenue != "17.51" || report.AverageOrder != "8.75" {
+		t.Errorf("revenue = %s, average = %s, want 17.51, 8.75", report.Revenue, report.AverageOrder)
+	}
+	want := []salesItemRank{{"drink", 2}, {"sandwich", 2}, {"soup", 1}}
+	if !slices.Equal(report.TopItems, want) {
+		t.Errorf("top items = %v, want %v", report.TopItems, want)
+	}
+
+	empty := dailySales(c, "store-main", "2026-10-17")
+	if empty.Orders != 0 || empty.Revenue != "0.00" || len(empty.TopItems) != 0 {
+		t.Errorf("report with no orders = %+v", empty)
+	}
+}
@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &DecorResource{}
+var _ resource.ResourceWithImportState = &DecorResource{}
+
+func NewDecorResource() resource.Resource {
+	return &DecorResource{}
+}
+
+type DecorResource struct {
+	client *ProviderConfig
+}
+
+type DecorResourceModel struct {
+	Theme           types.String `tfsdk:"theme"`
+	Budget          MoneyValue   `tfsdk:"budget"`
+	TablesId        types.String `tfsdk:"tables_id"`
+	Cost            MoneyValue   `tfsdk:"cost"`
+	CostPerTable    MoneyValue   `tfsdk:"cost_per_table"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
+}
+
+// decorThemes are the accepted values of the decor theme attribute. Each
+// theme's minimum spend per table is the "decor_<theme>" base price.
+var decorThemes = []string{"retro", "modern", "nautical"}
+
+func (r *DecorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_decor"
+}
+
+func (r *DecorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The look of the dining room, from checkered floors to ship's wheels. A decor budget is spread across the referenced tables, and each theme needs a minimum spend per table to pull off.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_tables" "dining" {
+  quantity = 8
+  size     = "medium"
+}
+
+resource "hw_decor" "dining_room" {
+  theme     = "nautical"
+  budget    = 400
+  tables_id = hw_tables.dining.id
+  # cost_per_table computed as $50 (400 / 8 tables)
+  # nautical needs at least $30 per table, so $240 for 8 tables
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates **cross-resource numbers**: the budget is checked against the referenced table count
+- Minimum spend per table: retro $25, modern $40, nautical $30
+- ` + "`cost_per_table`" + ` is the budget spread evenly over the tables, in cents
+- Tables not known to the provider are assumed to be 5
+
+*Portholes on the wall,*
+*Rope coiled by the napkin stand,*
+*Lunch sets sail at noon.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"theme": schema.StringAttribute{
+				MarkdownDescription: "Decor theme: retro, modern, or nautical",
+				Required:            true,
+			},
+			"budget": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				MarkdownDescription: "Decor budget in dollars. Must cover the theme's minimum spend for every referenced table",
+				Required:            true,
+			},
+			"tables_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_tables resource to decorate",
+				Required:            true,
+			},
+			"cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Total decor cost in dollars (the budget, plus any provider upcharge)",
+			},
+			"cost_per_table": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Budget allocated to each table, rounded to cents",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Decor identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DecorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *DecorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DecorResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.allocate(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	theme := data.Theme.ValueString()
+	id := r.client.NewId("decor", fmt.Sprintf("%s-%d", theme, len(theme)))
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created a decor resource", map[string]any{
+		"id":             data.Id.ValueString(),
+		"theme":          theme,
+		"cost_per_table": data.CostPerTable.ValueBigFloat().String(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DecorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DecorResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate the allocation
+	resp.Diagnostics.Append(r.allocate(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DecorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DecorResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate the allocation
+	resp.Diagnostics.Append(r.allocate(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state DecorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Theme.Equal(state.Theme) {
+		theme := data.Theme.ValueString()
+		id := r.client.NewId("decor", fmt.Sprintf("%s-%d", theme, len(theme)))
+		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DecorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DecorResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted a decor resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *DecorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// allocate validates the theme and checks the budget covers the theme's
+// minimum spend for every referenced table, then spreads it over the tables.
+func (r *DecorResource) allocate(data *DecorResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	theme := data.Theme.ValueString()
+	if !slices.Contains(decorThemes, theme) {
+		diags.AddAttributeError(
+			path.Root("theme"),
+			"Invalid Decor Theme",
+			fmt.Sprintf("Theme %q is not supported. Supported themes: %s.", theme, strings.Join(decorThemes, ", ")),
+		)
+		return diags
+	}
+
+	// The table count, from the hw_tables record when known
+	tableCount := big.NewFloat(defaultStoreTables)
+	if tables, ok := LookupRecord[TablesResourceModel](r.client.Registry, data.TablesId.ValueString()); ok {
+		tableCount = big.NewFloat(float64(tables.Quantity.ValueInt64()))
+	}
+	if tableCount.Sign() <= 0 {
+		diags.AddAttributeError(
+			path.Root("tables_id"),
+			"No Tables To Decorate",
+			fmt.Sprintf("%s has no tables, so there is nothing to spend the decor budget on.", data.TablesId.ValueString()),
+		)
+		return diags
+	}
+
+	budget := data.Budget.ValueBigFloat()
+	minimum := new(big.Float).Mul(tableCount, r.client.BasePrice("decor_"+theme))
+	if budget.Cmp(minimum) < 0 {
+		diags.AddAttributeError(
+			path.Root("budget"),
+			"Decor Budget Too Small",
+			fmt.Sprintf("A %s theme needs at least $%s per table, so $%s for the %s tables of %s, but the budget is $%s.",
+				theme, r.client.BasePrice("decor_"+theme).Text('f', 2), minimum.Text('f', 2), tableCount.String(), data.TablesId.ValueString(), budget.Text('f', 2)),
+		)
+		return diags
+	}
+
+	data.CostPerTable = NewMoneyValue(new(big.Float).Quo(budget, tableCount))
+	data.Cost = r.client.Price(ApplyUpcharge(budget, r.client.Upcharge))
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+	return diags
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,8 +25,68 @@ type DeliMeatsDataSource struct {
 
 // DeliMeatsDataSourceModel describes the data source data model.
 type DeliMeatsDataSourceModel struct {
-	Meats types.List   `tfsdk:"meats"`
-	Id    types.String `tfsdk:"id"`
+	Meats         types.List   `tfsdk:"meats"`
+	PageSize      types.Int64  `tfsdk:"page_size"`
+	PageToken     types.String `tfsdk:"page_token"`
+	AllPages      types.Bool   `tfsdk:"all_pages"`
+	NextPageToken types.String `tfsdk:"next_page_token"`
+	Id            types.String `tfsdk:"id"`
+}
+
+// deliMeats is the deli meat catalog listed by hw_deli_meats. With the
+// provider's strict_catalog set, hw_meat kinds must come from it.
+var deliMeats = []string{
+	"turkey",
+	"ham",
+	"roast beef",
+	"chicken",
+	"pastrami",
+	"corned beef",
+	"salami",
+	"bologna",
+	"mortadella",
+	"prosciutto",
+	"pepperoni",
+	"capicola",
+	"tuna salad",
+	"chicken salad",
+	"egg salad",
+	"turkey breast",
+	"roast pork",
+	"liverwurst",
+	"braunschweiger",
+	"pâté",
+	"smoked salmon",
+	"smoked turkey",
+	"honey ham",
+	"black forest ham",
+	"virginia ham",
+	"genoa salami",
+	"soppressata",
+	"bresaola",
+	"speck",
+	"chorizo",
+	"kielbasa",
+	"bratwurst",
+	"meatballs",
+	"brisket",
+	"pulled pork",
+	"porchetta",
+	"roast lamb",
+	"gyro meat",
+	"grilled chicken",
+	"buffalo chicken",
+	"chicken cutlet",
+	"bacon",
+	"pancetta",
+	"beef tongue",
+	"head cheese",
+	"peppered turkey",
+	"cajun turkey",
+	"montreal smoked meat",
+	"lox",
+	"sardines",
+	"anchovies",
 }
 
 func (d *DeliMeatsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -81,6 +142,22 @@ resource "hw_sandwich" "poultry" {
 output "meat_count" {
   value = length(data.hw_deli_meats.available.meats)
 }
+
+# Read the catalog ten meats at a time
+data "hw_deli_meats" "first_page" {
+  page_size = 10
+}
+
+data "hw_deli_meats" "second_page" {
+  page_size  = 10
+  page_token = data.hw_deli_meats.first_page.next_page_token
+}
+
+# Or let the data source follow the pages
+data "hw_deli_meats" "every_page" {
+  page_size = 10
+  all_pages = true
+}
 ` + "```" + `
 
 **Key Concepts:**
@@ -88,6 +165,8 @@ output "meat_count" {
 - Returns a list of available meat types
 - Perfect for dynamic resource creation with ` + "`for_each`" + `
 - Use ` + "`data.hw_deli_meats.available.meats`" + ` to access the list
+- Demonstrates **token pagination**: with ` + "`page_size`" + ` set, each read returns one page and a ` + "`next_page_token`" + ` for the next, null on the last page. Tokens are opaque; pass them back unchanged
+- ` + "`all_pages`" + ` follows the tokens itself and returns the whole catalog, as a client library's auto-pager would
 
 *Sliced thin and ready,*
 *Meats arrayed in perfect rows,*
@@ -96,9 +175,13 @@ output "meat_count" {
 		Attributes: map[string]schema.Attribute{
 			"meats": schema.ListAttribute{
 				ElementType:         types.StringType,
-				MarkdownDescription: "List of available deli meats",
+				MarkdownDescription: "List of available deli meats, or the requested page of them",
 				Computed:            true,
 			},
+			"page_size":       pageSizeAttribute(),
+			"page_token":      pageTokenAttribute(),
+			"all_pages":       allPagesAttribute(),
+			"next_page_token": nextPageTokenAttribute(),
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Data source identifier",
 				Computed:            true,
@@ -126,44 +209,32 @@ func (d *DeliMeatsDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	// Mock data - list of deli meats
-	meatsList := []string{
-		"turkey",
-		"ham",
-		"roast beef",
-		"chicken",
-		"pastrami",
-		"corned beef",
-		"salami",
-		"bologna",
-		"mortadella",
-		"prosciutto",
-		"pepperoni",
-		"capicola",
-		"tuna salad",
-		"chicken salad",
-		"egg salad",
-		"turkey breast",
-		"roast pork",
-		"liverwurst",
-		"braunschweiger",
-		"pâté",
-		"smoked salmon",
-	}
-
-	// Convert to Terraform types
-	meatsValues := make([]attr.Value, len(meatsList))
-	for i, meat := range meatsList {
-		meatsValues[i] = types.StringValue(meat)
+	page, next, diags := pageOf("deli_meats", deliMeats, data.PageSize, data.PageToken, data.AllPages)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	meats, diags := types.ListValue(types.StringType, meatsValues)
+	// Convert to Terraform types, once per provider instance
+	meats, diags := cachedValue(catalogsOf(d.client), "deli_meats/meats", func() (types.List, diag.Diagnostics) {
+		meatsValues := make([]attr.Value, len(deliMeats))
+		for i, meat := range deliMeats {
+			meatsValues[i] = types.StringValue(meat)
+		}
+		return types.ListValue(types.StringType, meatsValues)
+	})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if len(page) < len(deliMeats) {
+		meats, diags = types.ListValueFrom(ctx, types.StringType, page)
+		resp.Diagnostics.Append(diags...)
+	}
+
 	data.Meats = meats
+	data.NextPageToken = next
 	data.Id = types.StringValue("deli-meats")
 
 	tflog.Trace(ctx, "read deli_meats data source")
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dateLayout is the YYYY-MM-DD format of date attributes.
+const dateLayout = "2006-01-02"
+
+// Today returns the date time-based attributes are computed for: the
+// provider's as_of date, or the current date when it isn't set.
+func (c *ProviderConfig) Today() time.Time {
+	if c != nil && !c.AsOf.IsZero() {
+		return c.AsOf
+	}
+	return time.Now().UTC().Truncate(24 * time.Hour)
+}
+
+// BookValue depreciates equipment that cost cost in a straight line from its
+// purchase_date to zero at the end of its useful_life_years (defaultLife when
+// unset), as of Today. Equipment without a purchase_date is valued at cost,
+// as is equipment purchased after Today.
+func (c *ProviderConfig) BookValue(cost *big.Float, purchaseDate types.String, usefulLifeYears types.Number, defaultLife int64) (MoneyValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	life := big.NewFloat(float64(defaultLife))
+	if !usefulLifeYears.IsNull() && !usefulLifeYears.IsUnknown() {
+		life = usefulLifeYears.ValueBigFloat()
+		if life.Sign() <= 0 {
+			diags.AddAttributeError(
+				path.Root("useful_life_years"),
+				"Invalid Useful Life",
+				fmt.Sprintf("useful_life_years must be greater than zero, got %s.", life.String()),
+			)
+			return NewMoneyUnknown(), diags
+		}
+	}
+
+	if purchaseDate.IsNull() || purchaseDate.IsUnknown() {
+		return NewMoneyValue(cost), diags
+	}
+
+	purchased, err := time.Parse(dateLayout, purchaseDate.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("purchase_date"),
+			"Invalid Purchase Date",
+			fmt.Sprintf("purchase_date must be a date in YYYY-MM-DD format, got %q.", purchaseDate.ValueString()),
+		)
+		return NewMoneyUnknown(), diags
+	}
+
+	age := c.Today().Sub(purchased)
+	if age <= 0 {
+		return NewMoneyValue(cost), diags
+	}
+
+	// Remaining fraction of the useful life, floored at zero
+	years := big.NewFloat(age.Hours() / 24 / 365.25)
+	remaining := new(big.Float).Quo(years, life)
+	remaining.Sub(big.NewFloat(1), remaining)
+	if remaining.Sign() < 0 {
+		remaining.SetFloat64(0)
+	}
+
+	return NewMoneyValue(new(big.Float).Mul(cost, remaining)), diags
+}
@@ -0,0 +1,305 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &DessertCaseResource{}
+var _ resource.ResourceWithImportState = &DessertCaseResource{}
+
+func NewDessertCaseResource() resource.Resource {
+	return &DessertCaseResource{}
+}
+
+type DessertCaseResource struct {
+	client *ProviderConfig
+}
+
+type DessertCaseResourceModel struct {
+	Capacity        types.Int64  `tfsdk:"capacity"`
+	Refrigerated    types.Bool   `tfsdk:"refrigerated"`
+	Description     types.String `tfsdk:"description"`
+	Cost            MoneyValue   `tfsdk:"cost"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
+}
+
+// dessertItems are the menu items a store can only list in menu_item_ids
+// when it has a dessert case to display them in.
+var dessertItems = []string{"cookie", "brownie", "stroopwafel"}
+
+func (r *DessertCaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dessert_case"
+}
+
+func (r *DessertCaseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `A glass display case by the register. A store can't sell cookies, brownies, or stroopwafels without one: listing any of them in the store's ` + "`menu_item_ids`" + ` requires a ` + "`dessert_case_id`" + ` with a tray for each.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_dessert_case" "front" {
+  capacity     = 3
+  refrigerated = true
+  # cost computed as $450 (3 trays × $50, plus $300 for refrigeration)
+}
+
+resource "hw_store" "main" {
+  # ...
+  menu_item_ids   = [hw_sandwich.blt.id, hw_cookie.choc.id, hw_brownie.fudge.id]
+  dessert_case_id = hw_dessert_case.front.id
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates a **prerequisite resource**: the store needs a case before it accepts desserts on its menu
+- The store counts the case's trays when the provider has read the case; an apply that leaves the case untouched only checks that ` + "`dessert_case_id`" + ` is a dessert case ID
+- ` + "`capacity`" + ` is the number of trays, one per dessert the store lists
+- Costs $50 a tray, plus $300 if refrigerated
+
+*Glass fogged at the rim,*
+*Three brownies left on the tray,*
+*Someone taps and points.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"capacity": schema.Int64Attribute{
+				MarkdownDescription: "Number of trays in the case (at least 1). Each dessert a store lists takes a tray",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Dessert Case Capacity", min: 1, max: math.MaxInt64},
+				},
+			},
+			"refrigerated": schema.BoolAttribute{
+				MarkdownDescription: "Whether the case is refrigerated",
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the dessert case",
+				Optional:            true,
+			},
+			"cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Cost in dollars (capacity × $50, plus $300 if refrigerated)",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Dessert case identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DessertCaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *DessertCaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DessertCaseResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setCost(&data)
+
+	trays := strconv.FormatInt(data.Capacity.ValueInt64(), 10)
+	id := r.client.NewId("dessert-case", fmt.Sprintf("%s-%d", trays, len(trays)))
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created a dessert case resource", map[string]any{
+		"id":   data.Id.ValueString(),
+		"cost": data.Cost.ValueBigFloat().String(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DessertCaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DessertCaseResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost
+	r.setCost(&data)
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DessertCaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DessertCaseResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost
+	r.setCost(&data)
+
+	var state DessertCaseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Capacity.Equal(state.Capacity) {
+		trays := strconv.FormatInt(data.Capacity.ValueInt64(), 10)
+		id := r.client.NewId("dessert-case", fmt.Sprintf("%s-%d", trays, len(trays)))
+		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DessertCaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DessertCaseResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted a dessert case resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *DessertCaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setCost computes the cost of the trays and any refrigeration.
+func (r *DessertCaseResource) setCost(data *DessertCaseResourceModel) {
+	totalCost := new(big.Float).Mul(big.NewFloat(float64(data.Capacity.ValueInt64())), r.client.BasePrice("dessert_case_tray"))
+	if data.Refrigerated.ValueBool() {
+		totalCost.Add(totalCost, r.client.BasePrice("dessert_case_cold"))
+	}
+
+	data.Cost = r.client.Price(ApplyUpcharge(totalCost, r.client.Upcharge))
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+}
+
+// checkDessertCase validates a store's menu_item_ids and requires a dessert
+// case, with a tray for each, before the store lists any dessert items. The
+// trays are only counted when the case's record is known.
+func checkDessertCase(ctx context.Context, c *ProviderConfig, data *StoreResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if data.MenuItemIds.IsNull() || data.MenuItemIds.IsUnknown() {
+		return diags
+	}
+
+	var itemIds []string
+	diags.Append(data.MenuItemIds.ElementsAs(ctx, &itemIds, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var desserts []string
+	for _, itemId := range itemIds {
+		item, ok := c.TypeOfId(itemId, ticketItems...)
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("menu_item_ids").AtSetValue(types.StringValue(itemId)),
+				"Unknown Menu Item",
+				fmt.Sprintf("%q is not the ID of a menu item. Menu items are: %s.", itemId, strings.Join(ticketItems, ", ")),
+			)
+			continue
+		}
+		if slices.Contains(dessertItems, item) {
+			desserts = append(desserts, itemId)
+		}
+	}
+	if diags.HasError() || len(desserts) == 0 {
+		return diags
+	}
+	slices.Sort(desserts)
+
+	caseId := data.DessertCaseId.ValueString()
+	if caseId == "" {
+		diags.AddAttributeError(
+			path.Root("menu_item_ids").AtSetValue(types.StringValue(desserts[0])),
+			"Missing Dessert Case",
+			fmt.Sprintf("The store lists %s, but desserts need a display case. Add an hw_dessert_case and set dessert_case_id.", strings.Join(desserts, ", ")),
+		)
+		return diags
+	}
+
+	dessertCase, ok, caseDiags := lookupReference[DessertCaseResourceModel](c, path.Root("dessert_case_id"), caseId, "dessert-case")
+	diags.Append(caseDiags...)
+	if !ok {
+		return diags
+	}
+
+	capacity := dessertCase.Capacity.ValueInt64()
+	if int64(len(desserts)) > capacity {
+		diags.AddAttributeError(
+			path.Root("dessert_case_id"),
+			"Dessert Case Full",
+			fmt.Sprintf("The store lists %d desserts (%s), but %s only has room for %d. Raise the case's capacity or list fewer desserts.",
+				len(desserts), strings.Join(desserts, ", "), caseId, capacity),
+		)
+	}
+	return diags
+}
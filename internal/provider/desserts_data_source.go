@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DessertsDataSource{}
+
+func NewDessertsDataSource() datasource.DataSource {
+	return &DessertsDataSource{}
+}
+
+// DessertsDataSource defines the data source implementation.
+type DessertsDataSource struct {
+	client *ProviderConfig
+}
+
+// DessertsDataSourceModel describes the data source data model.
+type DessertsDataSourceModel struct {
+	Category types.String `tfsdk:"category"`
+	Kinds    types.List   `tfsdk:"kinds"`
+	Desserts types.List   `tfsdk:"desserts"`
+	Id       types.String `tfsdk:"id"`
+}
+
+// dessertEntry is an element of the desserts list.
+type dessertEntry struct {
+	Category  string     `tfsdk:"category"`
+	Kind      string     `tfsdk:"kind"`
+	Price     MoneyValue `tfsdk:"price"`
+	Allergens []string   `tfsdk:"allergens"`
+}
+
+// dessertEntryAttrTypes are the attribute types of a desserts list element.
+var dessertEntryAttrTypes = map[string]attr.Type{
+	"category":  types.StringType,
+	"kind":      types.StringType,
+	"price":     MoneyType{},
+	"allergens": types.ListType{ElemType: types.StringType},
+}
+
+// dessertCatalog is the dessert catalog: the kinds of each dessert category
+// (the dessertItems), with the allergens each contains in alphabetical order.
+// Every kind sells at its category's menu price.
+var dessertCatalog = map[string]map[string][]string{
+	"cookie": {
+		"chocolate chip": {"egg", "milk", "soy", "wheat"},
+		"oatmeal":        {"egg", "milk", "wheat"},
+		"peanut butter":  {"egg", "milk", "peanut", "wheat"},
+		"snickerdoodle":  {"egg", "milk", "wheat"},
+		"sugar":          {"egg", "milk", "wheat"},
+	},
+	"brownie": {
+		"blondie":          {"egg", "milk", "wheat"},
+		"double chocolate": {"egg", "milk", "soy", "wheat"},
+		"fudge":            {"egg", "milk", "soy", "wheat"},
+		"walnut":           {"egg", "milk", "soy", "tree nut", "wheat"},
+	},
+	"stroopwafel": {
+		"caramel":   {"egg", "milk", "wheat"},
+		"chocolate": {"egg", "milk", "soy", "wheat"},
+		"classic":   {"egg", "milk", "wheat"},
+		"honey":     {"egg", "milk", "wheat"},
+	},
+}
+
+// DessertKinds returns the sorted kinds of a dessert category in the dessert
+// catalog, or nil for an unknown category.
+func DessertKinds(category string) []string {
+	return slices.Sorted(maps.Keys(dessertCatalog[category]))
+}
+
+func (d *DessertsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_desserts"
+}
+
+func (d *DessertsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Every cookie, brownie, and stroopwafel the bakery makes, with its price and the allergens it contains. Filter by ` + "`category`" + ` to create one dessert resource per kind.
+
+**Example Usage:**
+
+` + "```hcl" + `
+data "hw_desserts" "cookies" {
+  category = "cookie"
+}
+
+# Bake one of every cookie
+resource "hw_cookie" "all" {
+  for_each = toset(data.hw_desserts.cookies.kinds)
+
+  kind = each.value
+}
+
+# Cookies safe for a peanut allergy
+output "peanut_free_cookies" {
+  value = [
+    for d in data.hw_desserts.cookies.desserts : d.kind
+    if !contains(d.allergens, "peanut")
+  ]
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates a **catalog data source** with a **list nested attribute** and an optional filter
+- Categories: cookie, brownie, stroopwafel (in that order, kinds alphabetical within each)
+- Prices come from the pricing engine, so they follow ` + "`price_overrides`" + ` and the upcharge
+
+*Milk, egg, and wheat flour,*
+*Walnuts hiding in the fudge,*
+*Read the card, then bite.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"category": schema.StringAttribute{
+				MarkdownDescription: "Only list desserts of this category: cookie, brownie, or stroopwafel",
+				Optional:            true,
+			},
+			"kinds": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "List of the dessert kinds, in the same order as `desserts`",
+				Computed:            true,
+			},
+			"desserts": schema.ListNestedAttribute{
+				MarkdownDescription: "List of desserts, by category and then alphabetically by kind",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"category": schema.StringAttribute{
+							MarkdownDescription: "Dessert category: cookie, brownie, or stroopwafel",
+							Computed:            true,
+						},
+						"kind": schema.StringAttribute{
+							MarkdownDescription: "Kind of dessert, as accepted by the category's resource",
+							Computed:            true,
+						},
+						"price": schema.NumberAttribute{
+							CustomType:          MoneyType{},
+							MarkdownDescription: "Price in dollars, including any upcharge",
+							Computed:            true,
+						},
+						"allergens": schema.ListAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Allergens the dessert contains, in alphabetical order",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DessertsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	d.client = config
+}
+
+func (d *DessertsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DessertsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	category := data.Category.ValueString()
+	if category != "" && !slices.Contains(dessertItems, category) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("category"),
+			"Invalid Dessert Category",
+			fmt.Sprintf("Category must be one of %s, got %q.", strings.Join(dessertItems, ", "), category),
+		)
+		return
+	}
+
+	var upcharge *big.Float
+	if d.client != nil {
+		upcharge = d.client.Upcharge
+	}
+
+	kinds := []string{}
+	desserts := []dessertEntry{}
+	for _, item := range dessertItems {
+		if category != "" && item != category {
+			continue
+		}
+		price := ApplyUpcharge(d.client.BasePrice(item), upcharge)
+		for _, kind := range DessertKinds(item) {
+			kinds = append(kinds, kind)
+			desserts = append(desserts, dessertEntry{
+				Category:  item,
+				Kind:      kind,
+				Price:     d.client.Price(price),
+				Allergens: dessertCatalog[item][kind],
+			})
+		}
+	}
+
+	catalogs := catalogsOf(d.client)
+	kindsValue, diags := cachedValue(catalogs, "desserts/kinds/"+category, func() (types.List, diag.Diagnostics) {
+		return types.ListValueFrom(ctx, types.StringType, kinds)
+	})
+	resp.Diagnostics.Append(diags...)
+	dessertsValue, diags := cachedValue(catalogs, "desserts/desserts/"+category, func() (types.List, diag.Diagnostics) {
+		return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: dessertEntryAttrTypes}, desserts)
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Kinds = kindsValue
+	data.Desserts = dessertsValue
+	data.Id = types.StringValue("desserts")
+	if category != "" {
+		data.Id = types.StringValue("desserts-" + category)
+	}
+
+	tflog.Trace(ctx, "read desserts data source", map[string]any{
+		"category": category,
+		"count":    len(desserts),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
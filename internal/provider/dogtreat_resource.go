@@ -29,11 +29,15 @@ type DogtreatResource struct {
 
 // DogtreatResourceModel describes the resource data model.
 type DogtreatResourceModel struct {
-	Description types.String `tfsdk:"description"`
-	IsGoodDog   types.Bool   `tfsdk:"is_good_dog"`
-	Size        types.String  `tfsdk:"size"`
-	Price       types.Number `tfsdk:"price"`
-	Id          types.String `tfsdk:"id"`
+	Description     types.String `tfsdk:"description"`
+	IsGoodDog       types.Bool   `tfsdk:"is_good_dog"`
+	Size            types.String `tfsdk:"size"`
+	Price           MoneyValue   `tfsdk:"price"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
 }
 
 func (r *DogtreatResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -105,9 +109,17 @@ resource "hw_dogtreat" "pack" {
 				MarkdownDescription: "The size of the treat (large or small), determined by is_good_dog",
 			},
 			"price": schema.NumberAttribute{
+				CustomType:          MoneyType{},
 				Computed:            true,
 				MarkdownDescription: "The price of the dog treat in dollars (large: $2.00, small: $1.00)",
 			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Dog treat identifier",
@@ -150,20 +162,11 @@ func (r *DogtreatResource) Create(ctx context.Context, req resource.CreateReques
 	// Simulate API delay
 
 	// Determine size and base price based on is_good_dog, then apply upcharge
-	var basePrice *big.Float
-	if data.IsGoodDog.ValueBool() {
-		data.Size = types.StringValue("large")
-		basePrice = big.NewFloat(2.00)
-	} else {
-		data.Size = types.StringValue("small")
-		basePrice = big.NewFloat(1.00)
-	}
-	finalPrice := ApplyUpcharge(basePrice, r.client.Upcharge)
-	data.Price = types.NumberValue(finalPrice)
+	r.setSizeAndPrice(&data)
 
 	// Mock resource creation - generate a fake ID
 	sizeStr := data.Size.ValueString()
-	id := fmt.Sprintf("dogtreat-%s-%d", sizeStr, len(sizeStr))
+	id := r.client.NewId("dogtreat", fmt.Sprintf("%s-%d", sizeStr, len(sizeStr)))
 	data.Id = types.StringValue(id)
 
 	tflog.Trace(ctx, "created a dog treat resource", map[string]any{
@@ -172,6 +175,8 @@ func (r *DogtreatResource) Create(ctx context.Context, req resource.CreateReques
 		"size":       data.Size.ValueString(),
 	})
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -189,17 +194,13 @@ func (r *DogtreatResource) Read(ctx context.Context, req resource.ReadRequest, r
 	// Simulate API delay
 
 	// Recalculate size and price based on is_good_dog
-	if data.IsGoodDog.ValueBool() {
-		data.Size = types.StringValue("large")
-		data.Price = types.NumberValue(big.NewFloat(2.00))
-	} else {
-		data.Size = types.StringValue("small")
-		data.Price = types.NumberValue(big.NewFloat(1.00))
-	}
+	r.setSizeAndPrice(&data)
 
 	// Mock resource read - just return the existing state
 	// In a real implementation, this would fetch from an API
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -217,13 +218,7 @@ func (r *DogtreatResource) Update(ctx context.Context, req resource.UpdateReques
 	// Simulate API delay
 
 	// Recalculate size and price based on is_good_dog
-	if data.IsGoodDog.ValueBool() {
-		data.Size = types.StringValue("large")
-		data.Price = types.NumberValue(big.NewFloat(2.00))
-	} else {
-		data.Size = types.StringValue("small")
-		data.Price = types.NumberValue(big.NewFloat(1.00))
-	}
+	r.setSizeAndPrice(&data)
 
 	// Mock resource update - regenerate ID if is_good_dog changed (which changes size)
 	var state DogtreatResourceModel
@@ -235,13 +230,15 @@ func (r *DogtreatResource) Update(ctx context.Context, req resource.UpdateReques
 	// If is_good_dog changed, regenerate ID
 	if !data.IsGoodDog.Equal(state.IsGoodDog) {
 		sizeStr := data.Size.ValueString()
-		id := fmt.Sprintf("dogtreat-%s-%d", sizeStr, len(sizeStr))
+		id := r.client.NewId("dogtreat", fmt.Sprintf("%s-%d", sizeStr, len(sizeStr)))
 		data.Id = types.StringValue(id)
 	} else {
 		// Keep existing ID
 		data.Id = state.Id
 	}
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -267,3 +264,18 @@ func (r *DogtreatResource) Delete(ctx context.Context, req resource.DeleteReques
 func (r *DogtreatResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// setSizeAndPrice derives the treat size from is_good_dog and sets the
+// matching price (large: $2.00, small: $1.00) plus the provider upcharge.
+func (r *DogtreatResource) setSizeAndPrice(data *DogtreatResourceModel) {
+	var basePrice *big.Float
+	if data.IsGoodDog.ValueBool() {
+		data.Size = types.StringValue("large")
+		basePrice = r.client.BasePrice("dogtreat_large")
+	} else {
+		data.Size = types.StringValue("small")
+		basePrice = r.client.BasePrice("dogtreat_small")
+	}
+	data.Price = r.client.Price(ApplyUpcharge(basePrice, r.client.Upcharge))
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+}
@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// driftingResources are the resource types with noise attributes, such as
+// hw_store's last_synced_at, that the provider's drift setting can turn on.
+var driftingResources = []string{"hw_store"}
+
+// Drifts reports whether the provider's drift setting lists typeName, so its
+// noise attributes change on every refresh.
+func (c *ProviderConfig) Drifts(typeName string) bool {
+	return c != nil && slices.Contains(c.Drift, typeName)
+}
+
+// syncedNow returns the current time as a last_synced_at value. It has
+// nanosecond precision, so back-to-back refreshes still differ.
+func syncedNow() types.String {
+	return types.StringValue(time.Now().UTC().Format(time.RFC3339Nano))
+}
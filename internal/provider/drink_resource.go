@@ -36,11 +36,16 @@ type IceModel struct {
 
 // DrinkResourceModel describes the resource data model.
 type DrinkResourceModel struct {
-	Description types.String `tfsdk:"description"`
-	Kind        types.String `tfsdk:"kind"`
-	Ice         types.List   `tfsdk:"ice"`
-	Price       types.Number `tfsdk:"price"`
-	Id          types.String `tfsdk:"id"`
+	Description     types.String `tfsdk:"description"`
+	Kind            types.String `tfsdk:"kind"`
+	Ice             types.List   `tfsdk:"ice"`
+	Price           MoneyValue   `tfsdk:"price"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	HappyHourActive types.Bool   `tfsdk:"happy_hour_active"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
 }
 
 func (r *DrinkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -139,6 +144,7 @@ kind = "water"
 				Required: true,
 			},
 			"price": schema.NumberAttribute{
+				CustomType: MoneyType{},
 				Computed:            true,
 				MarkdownDescription: `The price of the drink in dollars. This is a computed value that includes the base price plus any provider-level upcharge.
 
@@ -160,6 +166,17 @@ kind = "water"
 - The price is the same for all drinks regardless of kind or ice configuration
 - Use this in outputs or calculations for total order costs`,
 			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"happy_hour_active": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the provider's `happy_hour` discount was applied to `price`",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: `Automatically generated unique identifier for this drink resource.
@@ -350,13 +367,14 @@ func (r *DrinkResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Simulate API delay
 
-	// Set base price: $1.00, then apply upcharge
-	basePrice := big.NewFloat(1.00)
-	finalPrice := ApplyUpcharge(basePrice, r.client.Upcharge)
-	data.Price = types.NumberValue(finalPrice)
+	// Set base price: $1.00, then apply upcharge and any happy hour discount
+	price, happyHour := r.calculatePrice()
+	data.Price = r.client.Price(price)
+	data.HappyHourActive = types.BoolValue(happyHour)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
 
 	// Mock resource creation - generate a fake ID based on the kind
-	id := fmt.Sprintf("drink-%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString()))
+	id := r.client.NewId("drink", fmt.Sprintf("%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString())))
 	data.Id = types.StringValue(id)
 
 	tflog.Trace(ctx, "created a drink resource", map[string]any{
@@ -364,6 +382,11 @@ func (r *DrinkResource) Create(ctx context.Context, req resource.CreateRequest,
 		"kind": data.Kind.ValueString(),
 	})
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
+	// Record the drink so hw_ice_machine can total its ice demand
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -381,10 +404,16 @@ func (r *DrinkResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// Simulate API delay
 
 	// Ensure price is set (in case it wasn't in state)
-	data.Price = types.NumberValue(big.NewFloat(1.00))
+	price, happyHour := r.calculatePrice()
+	data.Price = r.client.Price(price)
+	data.HappyHourActive = types.BoolValue(happyHour)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
 
 	// Mock resource read - just return the existing state
 	// In a real implementation, this would fetch from an API
+	r.client.Registry.Put(data.Id.ValueString(), data)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -450,15 +479,22 @@ func (r *DrinkResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	// If kind changed, regenerate ID
 	if !data.Kind.Equal(state.Kind) {
-		id := fmt.Sprintf("drink-%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString()))
+		id := r.client.NewId("drink", fmt.Sprintf("%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString())))
 		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
 	} else {
 		// Keep existing ID
 		data.Id = state.Id
 	}
 
-	// Ensure price is always set to $1.00
-	data.Price = types.NumberValue(big.NewFloat(1.00))
+	// Ensure price is always set to $1.00 + upcharge
+	price, happyHour := r.calculatePrice()
+	data.Price = r.client.Price(price)
+	data.HappyHourActive = types.BoolValue(happyHour)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -476,7 +512,9 @@ func (r *DrinkResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	// Simulate API delay
 
-	// Mock resource deletion - nothing to do
+	// Mock resource deletion - forget the drink's record
+	r.client.Registry.Delete(data.Id.ValueString())
+
 	tflog.Trace(ctx, "deleted a drink resource", map[string]any{
 		"id": data.Id.ValueString(),
 	})
@@ -485,3 +523,31 @@ func (r *DrinkResource) Delete(ctx context.Context, req resource.DeleteRequest,
 func (r *DrinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// drinkIceLevel returns the ice level set in the drink's ice block: "some",
+// "lots", or "max", or "" for a drink without ice.
+func drinkIceLevel(ctx context.Context, data DrinkResourceModel) string {
+	if data.Ice.IsNull() || data.Ice.IsUnknown() {
+		return ""
+	}
+	var iceList []IceModel
+	if diags := data.Ice.ElementsAs(ctx, &iceList, false); diags.HasError() || len(iceList) == 0 {
+		return ""
+	}
+	switch ice := iceList[0]; {
+	case ice.Max.ValueBool():
+		return "max"
+	case ice.Lots.ValueBool():
+		return "lots"
+	case ice.Some.ValueBool():
+		return "some"
+	}
+	return ""
+}
+
+// calculatePrice returns the drink price from the pricing engine plus the
+// provider upcharge, less any happy hour discount, and whether the discount
+// applied.
+func (r *DrinkResource) calculatePrice() (*big.Float, bool) {
+	return r.client.HappyHourPrice(ApplyUpcharge(r.client.BasePrice("drink"), r.client.Upcharge))
+}
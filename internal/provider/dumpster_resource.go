@@ -0,0 +1,339 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &DumpsterResource{}
+var _ resource.ResourceWithImportState = &DumpsterResource{}
+
+func NewDumpsterResource() resource.Resource {
+	return &DumpsterResource{}
+}
+
+type DumpsterResource struct {
+	client *ProviderConfig
+}
+
+type DumpsterResourceModel struct {
+	Size            types.String `tfsdk:"size"`
+	PickupDays      types.Set    `tfsdk:"pickup_days"`
+	StoreId         types.String `tfsdk:"store_id"`
+	Cost            MoneyValue   `tfsdk:"cost"`
+	OverflowRisk    types.String `tfsdk:"overflow_risk"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
+}
+
+// dumpsterCapacity is how many cubic yards a dumpster of each size holds.
+// The keys are also the accepted sizes.
+var dumpsterCapacity = map[string]float64{
+	"small":  2,
+	"medium": 4,
+	"large":  8,
+}
+
+// Waste projection: every customer leaves 0.01 cubic yards of wrappers and
+// cups, over an 8-hour service day at the store's customers_per_hour.
+const (
+	wastePerCustomer   = 0.01
+	serviceHoursPerDay = 8
+)
+
+func (r *DumpsterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dumpster"
+}
+
+func (r *DumpsterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Where the wrappers, cups, and crusts end up. A dumpster is sized and emptied on a weekly pickup schedule; linked to a store, it projects how much waste piles up between pickups and how likely it is to overflow.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_dumpster" "back_alley" {
+  size        = "large"
+  pickup_days = ["monday", "thursday"]
+  store_id    = hw_store.main.id
+  # cost computed as $400
+  # overflow_risk computed from the store's customers_per_hour
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates a **set attribute** of weekdays (` + "`pickup_days`" + `) where order doesn't matter
+- Sizes: small (2 cu yd, $150), medium (4 cu yd, $250), large (8 cu yd, $400)
+- Waste is projected at 0.01 cu yd per customer over an 8-hour day at the store's ` + "`customers_per_hour`" + `
+- ` + "`overflow_risk`" + ` compares the waste over the longest gap between pickups with the dumpster's size: low (under 75% full), medium (under 100%), or high
+- A high risk raises a warning diagnostic suggesting more pickups or a bigger dumpster
+
+*Lid propped by a box,*
+*Thursday's truck cannot come soon,*
+*Gulls circle and wait.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"size": schema.StringAttribute{
+				MarkdownDescription: "Size of the dumpster: small (2 cubic yards), medium (4), or large (8)",
+				Required:            true,
+			},
+			"pickup_days": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Days of the week the dumpster is emptied, lowercase (`monday` through `sunday`). At least one",
+				Required:            true,
+			},
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_store the dumpster serves. Needed for `overflow_risk`",
+				Optional:            true,
+			},
+			"cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Cost in dollars (varies by size: small=$150, medium=$250, large=$400)",
+			},
+			"overflow_risk": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Risk the dumpster overflows between pickups: low, medium, or high (null without a linked store)",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Dumpster identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DumpsterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *DumpsterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DumpsterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setCostAndRisk(ctx, &data, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	size := data.Size.ValueString()
+	id := r.client.NewId("dumpster", fmt.Sprintf("%s-%d", size, len(size)))
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created a dumpster resource", map[string]any{
+		"id":            data.Id.ValueString(),
+		"size":          size,
+		"overflow_risk": data.OverflowRisk.ValueString(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DumpsterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DumpsterResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost and overflow risk
+	resp.Diagnostics.Append(r.setCostAndRisk(ctx, &data, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DumpsterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DumpsterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost and overflow risk
+	resp.Diagnostics.Append(r.setCostAndRisk(ctx, &data, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state DumpsterResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Size.Equal(state.Size) {
+		size := data.Size.ValueString()
+		id := r.client.NewId("dumpster", fmt.Sprintf("%s-%d", size, len(size)))
+		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DumpsterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DumpsterResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted a dumpster resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *DumpsterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setCostAndRisk validates the dumpster and computes its cost and, when
+// linked to a known store, its overflow risk. With warn set, a high risk adds
+// a warning diagnostic.
+func (r *DumpsterResource) setCostAndRisk(ctx context.Context, data *DumpsterResourceModel, warn bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	size := data.Size.ValueString()
+	capacity, ok := dumpsterCapacity[size]
+	if !ok {
+		diags.AddAttributeError(
+			path.Root("size"),
+			"Invalid Dumpster Size",
+			fmt.Sprintf("Size %q is not supported. Supported sizes: small, medium, large.", size),
+		)
+	}
+
+	var days []string
+	diags.Append(data.PickupDays.ElementsAs(ctx, &days, false)...)
+	if diags.HasError() {
+		return diags
+	}
+	if len(days) == 0 {
+		diags.AddAttributeError(
+			path.Root("pickup_days"),
+			"Missing Pickup Days",
+			"A dumpster needs at least one pickup day a week.",
+		)
+	}
+	for _, day := range days {
+		if !slices.Contains(weekdays, day) {
+			diags.AddAttributeError(
+				path.Root("pickup_days").AtSetValue(types.StringValue(day)),
+				"Invalid Pickup Day",
+				fmt.Sprintf("Day must be one of %s, got %q.", strings.Join(weekdays, ", "), day),
+			)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Cost = r.client.Price(ApplyUpcharge(r.client.VariantPrice("dumpster", size, "small"), r.client.Upcharge))
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+
+	data.OverflowRisk = types.StringNull()
+	store, ok := LookupRecord[StoreResourceModel](r.client.Registry, data.StoreId.ValueString())
+	if !ok || store.CustomersPerHour.IsNull() || store.CustomersPerHour.IsUnknown() {
+		return diags
+	}
+
+	// Waste piles up over the longest stretch between pickups
+	customersPerHour, _ := store.CustomersPerHour.ValueBigFloat().Float64()
+	gap := longestPickupGap(days)
+	waste := customersPerHour * serviceHoursPerDay * wastePerCustomer * float64(gap)
+	fullness := waste / capacity
+
+	switch {
+	case fullness < 0.75:
+		data.OverflowRisk = types.StringValue("low")
+	case fullness < 1:
+		data.OverflowRisk = types.StringValue("medium")
+	default:
+		data.OverflowRisk = types.StringValue("high")
+		if warn {
+			diags.AddAttributeWarning(
+				path.Root("pickup_days"),
+				"Pickup Frequency Too Low",
+				fmt.Sprintf("At %s customers per hour, %s projects %.1f cubic yards of waste over the %d days between pickups, but a %s dumpster holds %.0f. Add pickup days or choose a larger size.",
+					store.CustomersPerHour.ValueBigFloat().String(), data.StoreId.ValueString(), waste, gap, size, capacity),
+			)
+		}
+	}
+	return diags
+}
+
+// longestPickupGap returns the most days between consecutive pickups in the
+// weekly cycle: 7 for a single pickup day a week.
+func longestPickupGap(days []string) int {
+	var indexes []int
+	for _, day := range days {
+		indexes = append(indexes, slices.Index(weekdays, day))
+	}
+	slices.Sort(indexes)
+
+	gap := 7 - indexes[len(indexes)-1] + indexes[0]
+	for i := 1; i < len(indexes); i++ {
+		gap = max(gap, indexes[i]-indexes[i-1])
+	}
+	return gap
+}
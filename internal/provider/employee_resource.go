@@ -0,0 +1,323 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &EmployeeResource{}
+var _ resource.ResourceWithImportState = &EmployeeResource{}
+
+func NewEmployeeResource() resource.Resource {
+	return &EmployeeResource{}
+}
+
+type EmployeeResource struct {
+	client *ProviderConfig
+}
+
+type EmployeeResourceModel struct {
+	Name            types.String `tfsdk:"name"`
+	Role            types.String `tfsdk:"role"`
+	Experience      types.String `tfsdk:"experience"`
+	Description     types.String `tfsdk:"description"`
+	Cost            MoneyValue   `tfsdk:"cost"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
+}
+
+// employeeRoles are the accepted values of the employee role attribute. Each
+// role's experienced daily rate is the "employee_<role>" base price.
+var employeeRoles = []string{"cook", "cashier", "janitor", "manager"}
+
+// experiencePercents scale an employee's daily rate by experience level, as
+// whole-number percentages of the experienced rate. They match the spread of
+// the hw_cook prices ($120/$160/$200).
+var experiencePercents = map[string]int64{
+	"junior":      75,
+	"experienced": 100,
+	"expert":      125,
+}
+
+func (r *EmployeeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_employee"
+}
+
+func (r *EmployeeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Anyone on the payroll, from the cook at the grill to the manager in the back office. A generalization of ` + "`hw_cook`" + ` with a role; staff a store with ` + "`employee_ids`" + ` instead of ` + "`cook_ids`" + ` to account for the whole team.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_employee" "chef" {
+  name       = "Jordan"
+  role       = "cook"
+  experience = "expert"
+  # cost computed as $200/day
+}
+
+resource "hw_employee" "register" {
+  name       = "Casey"
+  role       = "cashier"
+  experience = "junior"
+  # cost computed as $82.50/day
+}
+
+resource "hw_store" "main" {
+  name         = "Main Street Deli"
+  oven_id      = hw_oven.main.id
+  employee_ids = [hw_employee.chef.id, hw_employee.register.id]
+  tables_id    = hw_tables.dining.id
+  chairs_id    = hw_chairs.seating.id
+  fridge_id    = hw_fridge.storage.id
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates an **enum attribute** (` + "`role`" + `) driving role-specific pricing
+- Experienced daily rates: cook $160, cashier $110, janitor $90, manager $240
+- Experience scales the rate: junior ×0.75, experienced ×1, expert ×1.25
+- Only employees with the cook role add to a store's ` + "`cook_capacity`" + `, weighted by experience like ` + "`hw_cook`" + `
+- A store takes either ` + "`cook_ids`" + ` or ` + "`employee_ids`" + `, not both
+
+*Apron, mop, and till,*
+*Every role keeps the shop whole,*
+*Payroll on Friday.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the employee",
+				Required:            true,
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Job role: cook, cashier, janitor, or manager",
+				Required:            true,
+			},
+			"experience": schema.StringAttribute{
+				MarkdownDescription: "Experience level (junior, experienced, expert). Scales the role's daily rate",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the employee",
+				Optional:            true,
+			},
+			"cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Daily cost in dollars: the role's rate (cook $160, cashier $110, janitor $90, manager $240) scaled by experience (junior ×0.75, expert ×1.25)",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Employee identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *EmployeeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *EmployeeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EmployeeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setCost(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := r.client.NewId("employee", fmt.Sprintf("%s-%d", data.Name.ValueString(), len(data.Name.ValueString())))
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created an employee resource", map[string]any{
+		"id":   data.Id.ValueString(),
+		"role": data.Role.ValueString(),
+		"cost": data.Cost.ValueBigFloat().String(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EmployeeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EmployeeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost
+	resp.Diagnostics.Append(r.setCost(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EmployeeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data EmployeeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost
+	resp.Diagnostics.Append(r.setCost(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state EmployeeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Name.Equal(state.Name) {
+		id := r.client.NewId("employee", fmt.Sprintf("%s-%d", data.Name.ValueString(), len(data.Name.ValueString())))
+		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EmployeeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data EmployeeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted an employee resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *EmployeeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setCost validates the employee's role and experience and computes the
+// daily cost.
+func (r *EmployeeResource) setCost(data *EmployeeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	role := data.Role.ValueString()
+	if !slices.Contains(employeeRoles, role) {
+		diags.AddAttributeError(
+			path.Root("role"),
+			"Invalid Employee Role",
+			fmt.Sprintf("Role %q is not supported. Supported roles: %s.", role, strings.Join(employeeRoles, ", ")),
+		)
+	}
+	experience := data.Experience.ValueString()
+	if _, ok := experiencePercents[experience]; !ok {
+		diags.AddAttributeError(
+			path.Root("experience"),
+			"Invalid Experience",
+			fmt.Sprintf("Experience %q is not supported. Supported levels: junior, experienced, expert.", experience),
+		)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Cost = r.client.Price(ApplyUpcharge(r.client.EmployeePrice(role, experience), r.client.Upcharge))
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+	return diags
+}
+
+// EmployeePrice returns the daily base price of an employee: the role's
+// experienced rate scaled by the experience level. It is safe to call on a
+// nil config.
+func (c *ProviderConfig) EmployeePrice(role, experience string) *big.Float {
+	price := c.BasePrice("employee_" + role)
+	if percent, ok := experiencePercents[experience]; ok && percent != 100 {
+		price.Mul(price, big.NewFloat(float64(percent)))
+		price.Quo(price, big.NewFloat(100))
+	}
+	return price
+}
+
+// employeeStaffing returns the daily labor cost and the cook capacity of the
+// employees with the given IDs, from their registry records. Only cooks add
+// capacity. Employees whose record is not known to the provider are estimated
+// as experienced cooks, like store cooks without a record.
+func (c *ProviderConfig) employeeStaffing(ids []string) (*big.Float, float64) {
+	cost := new(big.Float)
+	capacity := 0.0
+	for _, id := range ids {
+		employee, ok := LookupRecord[EmployeeResourceModel](c.Registry, id)
+		if !ok {
+			cost.Add(cost, c.BasePrice("store_cook"))
+			capacity += cookThroughput("experienced")
+			continue
+		}
+		cost.Add(cost, c.EmployeePrice(employee.Role.ValueString(), employee.Experience.ValueString()))
+		if employee.Role.ValueString() == "cook" {
+			capacity += cookThroughput(employee.Experience.ValueString())
+		}
+	}
+	return cost, capacity
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+)
+
+// LifecycleEvent is one line of the event log: a resource that was created,
+// updated or deleted.
+type LifecycleEvent struct {
+	Time         string `json:"time"`
+	ResourceType string `json:"resource_type"`
+	Action       string `json:"action"`
+	Id           string `json:"id"`
+}
+
+// EventLog appends a JSON line per successful Create, Update and Delete to a
+// file. The file is opened for each event, so events from the separate plan
+// and apply processes land in the same log.
+type EventLog struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewEventLog returns an event log appending to path, creating the file if
+// needed. It fails when the file can't be opened for appending.
+func NewEventLog(path string) (*EventLog, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	if err := file.Close(); err != nil {
+		return nil, err
+	}
+	return &EventLog{path: path}, nil
+}
+
+// Append writes event to the log, stamping it with the current time. It is
+// safe to call on a nil log, which drops the event.
+func (l *EventLog) Append(event LifecycleEvent) error {
+	if l == nil {
+		return nil
+	}
+
+	event.Time = time.Now().UTC().Format(time.RFC3339Nano)
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	file, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ExitSignResource{}
+var _ resource.ResourceWithImportState = &ExitSignResource{}
+
+func NewExitSignResource() resource.Resource {
+	return &ExitSignResource{}
+}
+
+type ExitSignResource struct {
+	client *ProviderConfig
+}
+
+type ExitSignResourceModel struct {
+	Quantity        types.Int64  `tfsdk:"quantity"`
+	StoreId         types.String `tfsdk:"store_id"`
+	Cost            MoneyValue   `tfsdk:"cost"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
+}
+
+func (r *ExitSignResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_exit_sign"
+}
+
+func (r *ExitSignResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Lit signs over the doors, one per exit. Signs belong to a store through ` + "`store_id`" + `, and ` + "`hw_fire_drill`" + ` counts them as the store's exits.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_exit_sign" "doors" {
+  quantity = 2
+  store_id = hw_store.main.id
+  # cost computed as $70 (2 × $35)
+}
+` + "```" + `
+
+**Key Concepts:**
+- Each sign marks one exit, at $35 a sign
+- ` + "`hw_fire_drill`" + ` wants 1 exit for fewer than 50 occupants, 2 up to 500, 3 up to 1000 and 4 beyond
+
+*Green glow in the dark,*
+*After the last guest has left,*
+*Still pointing the way.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"quantity": schema.Int64Attribute{
+				MarkdownDescription: "Number of signs, one per exit (at least 1)",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Exit Sign Quantity", min: 1, max: math.MaxInt64},
+				},
+			},
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_store whose exits the signs mark",
+				Required:            true,
+			},
+			"cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Total cost in dollars (quantity × $35)",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Exit sign identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ExitSignResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *ExitSignResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ExitSignResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setCost(&data)
+
+	signs := strconv.FormatInt(data.Quantity.ValueInt64(), 10)
+	id := r.client.NewId("exit-sign", fmt.Sprintf("%s-%d", signs, len(signs)))
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created an exit sign resource", map[string]any{
+		"id":   data.Id.ValueString(),
+		"cost": data.Cost.ValueBigFloat().String(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExitSignResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ExitSignResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost
+	r.setCost(&data)
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExitSignResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ExitSignResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ExitSignResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost
+	r.setCost(&data)
+
+	if !data.Quantity.Equal(state.Quantity) {
+		signs := strconv.FormatInt(data.Quantity.ValueInt64(), 10)
+		id := r.client.NewId("exit-sign", fmt.Sprintf("%s-%d", signs, len(signs)))
+		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExitSignResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ExitSignResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted an exit sign resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *ExitSignResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setCost computes the signs' cost.
+func (r *ExitSignResource) setCost(data *ExitSignResourceModel) {
+	var totalCost big.Float
+	totalCost.Mul(big.NewFloat(float64(data.Quantity.ValueInt64())), r.client.BasePrice("exit_sign"))
+	data.Cost = r.client.Price(ApplyUpcharge(&totalCost, r.client.Upcharge))
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+}
@@ -0,0 +1,305 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &FireDrillAction{}
+var _ action.ActionWithConfigure = &FireDrillAction{}
+
+// Fire code rules: a dining room holds one occupant per 15 sq ft of floor, and
+// an accessible layout, with aisles a wheelchair fits through, leaves 20 sq ft
+// per seat.
+const (
+	squareFeetPerOccupant = 15
+	squareFeetPerSeat     = 20
+)
+
+func NewFireDrillAction() action.Action {
+	return &FireDrillAction{}
+}
+
+// FireDrillAction defines the action implementation.
+type FireDrillAction struct {
+	client *ProviderConfig
+}
+
+// FireDrillActionModel describes the action data model.
+type FireDrillActionModel struct {
+	StoreId   types.String `tfsdk:"store_id"`
+	Occupants types.Int64  `tfsdk:"occupants"`
+}
+
+// drillFinding is the outcome of one fire drill check.
+type drillFinding struct {
+	Check  string
+	Passed bool
+	Detail string
+}
+
+func (a *FireDrillAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fire_drill"
+}
+
+func (a *FireDrillAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Walks a store through a fire drill: checks its extinguishers, exits, layout and occupancy, and reports each finding as it goes. The drill fails, with every failed check listed, when any check does.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_fire_extinguisher" "dining" {
+  quantity = 1
+  class    = "abc"
+  store_id = hw_store.main.id
+}
+
+resource "hw_fire_extinguisher" "kitchen" {
+  quantity = 1
+  class    = "k"
+  store_id = hw_store.main.id
+}
+
+resource "hw_exit_sign" "doors" {
+  quantity = 2
+  store_id = hw_store.main.id
+}
+
+action "hw_fire_drill" "quarterly" {
+  config {
+    store_id  = hw_store.main.id
+    occupants = 40
+  }
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates an **action that audits configuration** across several resources through the provider's registry
+- extinguishers: the store's abc ` + "`hw_fire_extinguisher`" + ` resources cover its ` + "`square_feet`" + `, and a class k guards the kitchen
+- exits: the store's ` + "`hw_exit_sign`" + ` resources mark enough exits for its occupant load, 1 per ` + fmt.Sprint(squareFeetPerOccupant) + ` sq ft: 1 exit under 50, 2 up to 500, 3 up to 1000 and 4 beyond
+- layout: the dining room is accessible, with at least ` + fmt.Sprint(squareFeetPerSeat) + ` sq ft per seat of the store's ` + "`hw_tables`" + `
+- occupancy: fewer occupants than the occupant load. Without ` + "`occupants`" + `, the drill assumes a full house: every seat taken plus the store's cooks and employees
+- The layout and occupancy checks need the store's ` + "`square_feet`" + `
+- Drills the stores the provider has records of: every store with the provider's ` + "`backend_path`" + ` set, otherwise only those read or changed in the same run. Other stores are skipped with a warning
+
+*The alarm rings at noon,*
+*Trays abandoned, out we file,*
+*Soup cools on the pass.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_store to drill",
+				Required:            true,
+			},
+			"occupants": schema.Int64Attribute{
+				MarkdownDescription: "People in the store during the drill (defaults to every seat taken plus the staff)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Occupants", min: 0, max: math.MaxInt64},
+				},
+			},
+		},
+	}
+}
+
+func (a *FireDrillAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	a.client = config
+}
+
+func (a *FireDrillAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data FireDrillActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var registry *Registry
+	if a.client != nil {
+		registry = a.client.Registry
+	}
+
+	storeId := data.StoreId.ValueString()
+	store, storeKnown, diags := lookupReference[StoreResourceModel](a.client, path.Root("store_id"), storeId, "store")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Every check is against the store's record, so there is nothing to
+	// drill without it
+	if !storeKnown {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("store_id"),
+			"Store Not Read",
+			fmt.Sprintf("The provider has no record of %s in this run, so the fire drill was skipped. Set the provider's backend_path for actions to see every store.", storeId),
+		)
+		return
+	}
+
+	occupants := int64(-1)
+	if !data.Occupants.IsNull() {
+		occupants = data.Occupants.ValueInt64()
+	}
+
+	findings := fireDrill(registry, store, occupants)
+	var failed []string
+	for _, finding := range findings {
+		result := "pass"
+		if !finding.Passed {
+			result = "FAIL"
+			failed = append(failed, fmt.Sprintf("- %s: %s", finding.Check, finding.Detail))
+		}
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("[%s] %s: %s", result, finding.Check, finding.Detail),
+		})
+	}
+
+	if len(failed) > 0 {
+		resp.Diagnostics.AddError(
+			"Fire Drill Failed",
+			fmt.Sprintf("%s failed %d of %d checks:\n%s", storeId, len(failed), len(findings), strings.Join(failed, "\n")),
+		)
+	} else {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("%s passed all %d fire drill checks.", storeId, len(findings)),
+		})
+	}
+
+	tflog.Trace(ctx, "invoked fire drill action", map[string]any{
+		"store_id": storeId,
+		"checks":   len(findings),
+		"failed":   len(failed),
+	})
+}
+
+// fireDrill checks a store's fire safety: its extinguishers, exits, layout and
+// occupancy, in that order. A negative occupants assumes a full house, every
+// seat of the store's tables taken plus its staff.
+func fireDrill(registry *Registry, store StoreResourceModel, occupants int64) []drillFinding {
+	storeId := store.Id.ValueString()
+
+	var abc, k, exits int64
+	for _, extinguisher := range ListRecords[FireExtinguisherResourceModel](registry) {
+		if extinguisher.StoreId.ValueString() != storeId {
+			continue
+		}
+		switch extinguisher.Class.ValueString() {
+		case "abc":
+			abc += extinguisher.Quantity.ValueInt64()
+		case "k":
+			k += extinguisher.Quantity.ValueInt64()
+		}
+	}
+	for _, sign := range ListRecords[ExitSignResourceModel](registry) {
+		if sign.StoreId.ValueString() == storeId {
+			exits += sign.Quantity.ValueInt64()
+		}
+	}
+
+	var seats int64
+	if tables, ok := LookupRecord[TablesResourceModel](registry, store.TablesId.ValueString()); ok {
+		seats = tables.Capacity.ValueInt64()
+	}
+	if occupants < 0 {
+		occupants = seats + int64(len(store.CookIds.Elements())+len(store.EmployeeIds.Elements()))
+	}
+
+	// Without square_feet, the floor-area checks can't be made
+	var squareFeet int64
+	if !store.SquareFeet.IsNull() && !store.SquareFeet.IsUnknown() {
+		feet, _ := store.SquareFeet.ValueBigFloat().Float64()
+		squareFeet = int64(feet)
+	}
+	load := squareFeet / squareFeetPerOccupant
+	noArea := drillFinding{Passed: false, Detail: "The store has no square_feet to check against."}
+
+	var findings []drillFinding
+
+	extinguishers := drillFinding{Check: "extinguishers", Passed: abc > 0 && abc*extinguisherCoverage >= squareFeet && k > 0}
+	switch {
+	case abc == 0:
+		extinguishers.Detail = "No class abc hw_fire_extinguisher is mounted in the store."
+	case abc*extinguisherCoverage < squareFeet:
+		extinguishers.Detail = fmt.Sprintf("%d class abc extinguishers cover %d of %d sq ft; add %d more.",
+			abc, abc*extinguisherCoverage, squareFeet, (squareFeet+extinguisherCoverage-1)/extinguisherCoverage-abc)
+	case k == 0:
+		extinguishers.Detail = "No class k hw_fire_extinguisher guards the kitchen."
+	default:
+		extinguishers.Detail = fmt.Sprintf("%d class abc and %d class k extinguishers are mounted.", abc, k)
+	}
+	findings = append(findings, extinguishers)
+
+	// Size the exits for the occupant load, or the occupants without one
+	required := requiredExits(max(load, occupants))
+	findings = append(findings, drillFinding{
+		Check:  "exits",
+		Passed: exits >= required,
+		Detail: fmt.Sprintf("%d exits are signed, of %d needed for %d occupants.", exits, required, max(load, occupants)),
+	})
+
+	layout := drillFinding{Check: "layout", Passed: seats*squareFeetPerSeat <= squareFeet}
+	switch {
+	case squareFeet == 0:
+		layout = noArea
+		layout.Check = "layout"
+	case layout.Passed:
+		layout.Detail = fmt.Sprintf("%d seats leave %d sq ft each, room for accessible aisles.", seats, squareFeet/max(seats, 1))
+	default:
+		layout.Detail = fmt.Sprintf("%d seats need %d sq ft for accessible aisles, and the store has %d; remove %d seats.",
+			seats, seats*squareFeetPerSeat, squareFeet, seats-squareFeet/squareFeetPerSeat)
+	}
+	findings = append(findings, layout)
+
+	occupancy := drillFinding{Check: "occupancy", Passed: occupants < load}
+	switch {
+	case squareFeet == 0:
+		occupancy = noArea
+		occupancy.Check = "occupancy"
+	case occupancy.Passed:
+		occupancy.Detail = fmt.Sprintf("%d occupants are under the occupant load of %d.", occupants, load)
+	default:
+		occupancy.Detail = fmt.Sprintf("%d occupants reach the occupant load of %d (1 per %d sq ft).", occupants, load, squareFeetPerOccupant)
+	}
+	findings = append(findings, occupancy)
+
+	return findings
+}
+
+// requiredExits returns the number of exits a room for occupants people needs.
+func requiredExits(occupants int64) int64 {
+	switch {
+	case occupants < 50:
+		return 1
+	case occupants <= 500:
+		return 2
+	case occupants <= 1000:
+		return 3
+	default:
+		return 4
+	}
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFireDrill(t *testing.T) {
+	registry := NewRegistry()
+	store := StoreResourceModel{
+		Id:          types.StringValue("store-main-4"),
+		TablesId:    types.StringValue("tables-medium-6"),
+		CookIds:     types.SetValueMust(types.StringType, []attr.Value{types.StringValue("cook-expert-6")}),
+		EmployeeIds: types.SetNull(types.StringType),
+		SquareFeet:  types.NumberValue(big.NewFloat(1200)),
+	}
+	registry.Put("tables-medium-6", TablesResourceModel{Capacity: types.Int64Value(40)})
+
+	passed := func(findings []drillFinding) map[string]bool {
+		results := map[string]bool{}
+		for _, finding := range findings {
+			results[finding.Check] = finding.Passed
+		}
+		return results
+	}
+
+	// Nothing mounted yet: 41 occupants fit in the load of 80, but 40 seats
+	// need 800 sq ft, which 1200 leaves room for
+	got := passed(fireDrill(registry, store, -1))
+	want := map[string]bool{"extinguishers": false, "exits": false, "layout": true, "occupancy": true}
+	for check, ok := range want {
+		if got[check] != ok {
+			t.Errorf("without equipment, %s passed = %t, want %t", check, got[check], ok)
+		}
+	}
+
+	registry.Put("extinguisher-abc-3", FireExtinguisherResourceModel{
+		Quantity: types.Int64Value(1), Class: types.StringValue("abc"), StoreId: store.Id,
+	})
+	registry.Put("extinguisher-k-1", FireExtinguisherResourceModel{
+		Quantity: types.Int64Value(1), Class: types.StringValue("k"), StoreId: store.Id,
+	})
+	registry.Put("exit-sign-2-1", ExitSignResourceModel{
+		Quantity: types.Int64Value(2), StoreId: store.Id,
+	})
+	for _, finding := range fireDrill(registry, store, -1) {
+		if !finding.Passed {
+			t.Errorf("with equipment, %s failed: %s", finding.Check, finding.Detail)
+		}
+	}
+
+	// A crowd at the occupant load, or a floor without square_feet, fails
+	if passed(fireDrill(registry, store, 80))["occupancy"] {
+		t.Errorf("80 occupants passed the occupancy check for a load of 80")
+	}
+	store.SquareFeet = types.NumberNull()
+	got = passed(fireDrill(registry, store, -1))
+	if got["layout"] || got["occupancy"] {
+		t.Errorf("a store without square_feet passed layout or occupancy: %v", got)
+	}
+}
+
+func TestRequiredExits(t *testing.T) {
+	tests := map[int64]int64{0: 1, 49: 1, 50: 2, 500: 2, 501: 3, 1000: 3, 1001: 4}
+	for occupants, want := range tests {
+		if got := requiredExits(occupants); got != want {
+			t.Errorf("requiredExits(%d) = %d, want %d", occupants, got, want)
+		}
+	}
+}
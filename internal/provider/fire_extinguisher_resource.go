@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &FireExtinguisherResource{}
+var _ resource.ResourceWithImportState = &FireExtinguisherResource{}
+
+func NewFireExtinguisherResource() resource.Resource {
+	return &FireExtinguisherResource{}
+}
+
+type FireExtinguisherResource struct {
+	client *ProviderConfig
+}
+
+type FireExtinguisherResourceModel struct {
+	Quantity        types.Int64  `tfsdk:"quantity"`
+	Class           types.String `tfsdk:"class"`
+	StoreId         types.String `tfsdk:"store_id"`
+	Cost            MoneyValue   `tfsdk:"cost"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
+}
+
+// extinguisherClasses are the accepted extinguisher classes: abc for the
+// dining room and k for kitchen grease fires.
+var extinguisherClasses = []string{"abc", "k"}
+
+// extinguisherCoverage is the floor area in square feet one class abc
+// extinguisher protects. Class k extinguishers guard the cooking line and
+// cover no floor area of their own.
+const extinguisherCoverage = 3000
+
+func (r *FireExtinguisherResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fire_extinguisher"
+}
+
+func (r *FireExtinguisherResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Red cylinders on the wall, ready for a bad day. Extinguishers belong to a store through ` + "`store_id`" + `, and ` + "`hw_fire_drill`" + ` checks that the store has enough of the right kinds.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_fire_extinguisher" "dining" {
+  quantity = 2
+  class    = "abc"
+  store_id = hw_store.main.id
+  # cost computed as $120 (2 × $60)
+}
+
+resource "hw_fire_extinguisher" "kitchen" {
+  quantity = 1
+  class    = "k"
+  store_id = hw_store.main.id
+}
+` + "```" + `
+
+**Key Concepts:**
+- Classes: abc ($60) for ordinary fires, each protecting up to ` + fmt.Sprint(extinguisherCoverage) + ` sq ft of floor, and k ($180) for cooking oil and grease
+- ` + "`hw_fire_drill`" + ` wants enough abc extinguishers for the store's ` + "`square_feet`" + ` and at least one k by the ovens
+
+*Pin, hose, squeeze and sweep,*
+*The fryer hisses, then sighs,*
+*Lunch will be a bit late.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"quantity": schema.Int64Attribute{
+				MarkdownDescription: "Number of extinguishers (at least 1)",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Extinguisher Quantity", min: 1, max: math.MaxInt64},
+				},
+			},
+			"class": schema.StringAttribute{
+				MarkdownDescription: "Extinguisher class: abc for the dining room, or k for kitchen grease fires",
+				Required:            true,
+			},
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_store the extinguishers are mounted in",
+				Required:            true,
+			},
+			"cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Total cost in dollars (quantity × the per-extinguisher price: abc=$60, k=$180)",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Fire extinguisher identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *FireExtinguisherResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *FireExtinguisherResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FireExtinguisherResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setCost(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	class := data.Class.ValueString()
+	id := r.client.NewId("extinguisher", fmt.Sprintf("%s-%d", class, len(class)))
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created a fire extinguisher resource", map[string]any{
+		"id":   data.Id.ValueString(),
+		"cost": data.Cost.ValueBigFloat().String(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FireExtinguisherResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FireExtinguisherResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost
+	resp.Diagnostics.Append(r.setCost(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FireExtinguisherResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FireExtinguisherResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost
+	resp.Diagnostics.Append(r.setCost(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state FireExtinguisherResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Class.Equal(state.Class) {
+		class := data.Class.ValueString()
+		id := r.client.NewId("extinguisher", fmt.Sprintf("%s-%d", class, len(class)))
+		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FireExtinguisherResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FireExtinguisherResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted a fire extinguisher resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *FireExtinguisherResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setCost validates the extinguishers' class and computes their cost.
+func (r *FireExtinguisherResource) setCost(data *FireExtinguisherResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	class := data.Class.ValueString()
+	if _, ok := basePrices["extinguisher_"+class]; !ok {
+		diags.AddAttributeError(
+			path.Root("class"),
+			"Invalid Extinguisher Class",
+			fmt.Sprintf("Class %q is not supported. Supported classes: %s.%s", class, strings.Join(extinguisherClasses, ", "), didYouMean(class, extinguisherClasses)),
+		)
+		return diags
+	}
+
+	var totalCost big.Float
+	totalCost.Mul(big.NewFloat(float64(data.Quantity.ValueInt64())), r.client.BasePrice("extinguisher_"+class))
+	data.Cost = r.client.Price(ApplyUpcharge(&totalCost, r.client.Upcharge))
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+	return diags
+}
@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &FranchiseReportDataSource{}
+
+func NewFranchiseReportDataSource() datasource.DataSource {
+	return &FranchiseReportDataSource{}
+}
+
+// FranchiseReportDataSource defines the data source implementation.
+type FranchiseReportDataSource struct {
+	client *ProviderConfig
+}
+
+// FranchiseReportDataSourceModel describes the data source data model.
+type FranchiseReportDataSourceModel struct {
+	StoreIds              types.List   `tfsdk:"store_ids"`
+	TotalCost             MoneyValue   `tfsdk:"total_cost"`
+	TotalCustomersPerHour types.Number `tfsdk:"total_customers_per_hour"`
+	TotalWeeklyRevenue    MoneyValue   `tfsdk:"total_estimated_weekly_revenue"`
+	BestStoreId           types.String `tfsdk:"best_store_id"`
+	WorstStoreId          types.String `tfsdk:"worst_store_id"`
+	RoyaltyTiers          types.List   `tfsdk:"royalty_tier"`
+	WeeklyRoyalty         MoneyValue   `tfsdk:"weekly_royalty"`
+	EffectiveRoyalty      types.Number `tfsdk:"effective_royalty_percent"`
+	Id                    types.String `tfsdk:"id"`
+}
+
+func (d *FranchiseReportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_franchise_report"
+}
+
+func (d *FranchiseReportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `A franchise-wide rollup of several stores. Demonstrates how a data source can aggregate over resources the provider already manages, totalling cost, capacity, and revenue and ranking the stores against each other.
+
+**Example Usage:**
+
+` + "```hcl" + `
+data "hw_franchise_report" "all" {
+  store_ids = [hw_store.main.id, hw_store.uptown.id]
+
+  royalty_tier {
+    threshold = 0
+    percent   = 4
+  }
+
+  royalty_tier {
+    threshold = 10000
+    percent   = 6
+  }
+}
+
+output "franchise" {
+  value = {
+    total_cost     = data.hw_franchise_report.all.total_cost
+    capacity       = data.hw_franchise_report.all.total_customers_per_hour
+    weekly_revenue = data.hw_franchise_report.all.total_estimated_weekly_revenue
+    best_store     = data.hw_franchise_report.all.best_store_id
+    worst_store    = data.hw_franchise_report.all.worst_store_id
+    royalty        = data.hw_franchise_report.all.weekly_royalty
+  }
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates **aggregation** across many resources
+- Reads each store's computed attributes, so it always matches the stores themselves
+- Ranks stores by ` + "`estimated_weekly_revenue`" + ` to find the best and worst performer
+- Every ID in ` + "`store_ids`" + ` must be an ` + "`hw_store`" + ` ID. A store the provider has no record of, such as one managed in another configuration without a shared ` + "`backend_path`" + `, is left out of the totals with a warning
+- Uses **nested block validation**: ` + "`royalty_tier`" + ` thresholds must be strictly increasing, and each tier's percent applies only to the revenue in its band, like tax brackets
+
+*Many shops, one view,*
+*Counting every busy hour,*
+*The whole chain in sum.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"store_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "List of hw_store resource IDs to include in the report. Stores the provider has no record of are left out with a warning",
+				Required:            true,
+			},
+			"total_cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Sum of the stores' `cost`",
+			},
+			"total_customers_per_hour": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Sum of the stores' `customers_per_hour`",
+			},
+			"total_estimated_weekly_revenue": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Sum of the stores' `estimated_weekly_revenue`",
+			},
+			"best_store_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the store with the highest `estimated_weekly_revenue` (the first one listed on ties)",
+			},
+			"worst_store_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the store with the lowest `estimated_weekly_revenue` (the first one listed on ties)",
+			},
+			"weekly_royalty": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Royalty owed on `total_estimated_weekly_revenue` under the `royalty_tier` schedule (0 without tiers)",
+			},
+			"effective_royalty_percent": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "`weekly_royalty` as a percent of `total_estimated_weekly_revenue`, to two decimal places (0 without revenue)",
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"royalty_tier": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"threshold": schema.NumberAttribute{
+							MarkdownDescription: "Weekly revenue in dollars where the tier starts. Must be above the previous tier's",
+							Required:            true,
+						},
+						"percent": schema.NumberAttribute{
+							MarkdownDescription: "Royalty percent, from 0 to 100, on the revenue between this tier's threshold and the next's",
+							Required:            true,
+						},
+					},
+				},
+				MarkdownDescription: "One tier of the franchise's royalty schedule. Repeat the block for each tier, in order of increasing threshold.",
+			},
+		},
+	}
+}
+
+func (d *FranchiseReportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	d.client = config
+}
+
+func (d *FranchiseReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FranchiseReportDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var storeIds []string
+	resp.Diagnostics.Append(data.StoreIds.ElementsAs(ctx, &storeIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tiers, diags := royaltyTiers(ctx, data.RoyaltyTiers)
+	resp.Diagnostics.Append(diags...)
+
+	// Look up every store before aggregating so all unknown IDs are reported.
+	// Stores the provider hasn't read are left out of the report
+	stores := make([]StoreResourceModel, 0, len(storeIds))
+	for i, id := range storeIds {
+		storePath := path.Root("store_ids").AtListIndex(i)
+		store, known, diags := lookupReference[StoreResourceModel](d.client, storePath, id, "store")
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			continue
+		}
+		if !known {
+			resp.Diagnostics.AddAttributeWarning(
+				storePath,
+				"Store Not Read",
+				fmt.Sprintf("The provider has no record of %s in this run, so the report leaves it out. Set the provider's backend_path for data sources to see every store.", id),
+			)
+			continue
+		}
+		stores = append(stores, store)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	totalCost := new(big.Float)
+	totalCapacity := new(big.Float)
+	totalRevenue := new(big.Float)
+	data.BestStoreId = types.StringNull()
+	data.WorstStoreId = types.StringNull()
+
+	var best, worst *big.Float
+	for _, store := range stores {
+		totalCost.Add(totalCost, store.Cost.ValueBigFloat())
+		totalCapacity.Add(totalCapacity, store.CustomersPerHour.ValueBigFloat())
+
+		revenue := store.EstimatedWeeklyRevenue.ValueBigFloat()
+		totalRevenue.Add(totalRevenue, revenue)
+
+		if best == nil || revenue.Cmp(best) > 0 {
+			best = revenue
+			data.BestStoreId = store.Id
+		}
+		if worst == nil || revenue.Cmp(worst) < 0 {
+			worst = revenue
+			data.WorstStoreId = store.Id
+		}
+	}
+
+	data.TotalCost = NewMoneyValue(totalCost)
+	data.TotalCustomersPerHour = types.NumberValue(totalCapacity)
+	data.TotalWeeklyRevenue = NewMoneyValue(totalRevenue)
+
+	royalty := tieredRoyalty(totalRevenue, tiers)
+	data.WeeklyRoyalty = NewMoneyValue(royalty)
+	data.EffectiveRoyalty = types.NumberValue(big.NewFloat(effectiveRoyaltyPercent(royalty, totalRevenue)))
+	data.Id = types.StringValue(fmt.Sprintf("franchise-report-%d", len(stores)))
+
+	tflog.Trace(ctx, "read franchise report data source", map[string]any{
+		"stores":     len(stores),
+		"total_cost": totalCost.String(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
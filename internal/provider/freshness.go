@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// BreadExpired reports whether bread baked on bakedOn (YYYY-MM-DD) has passed
+// its shelf life of shelfLifeDays as of Today: it keeps for shelfLifeDays
+// days and expires on the day after. Bread without a shelf life never
+// expires.
+func (c *ProviderConfig) BreadExpired(bakedOn types.String, shelfLifeDays types.Int64) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if shelfLifeDays.IsNull() || shelfLifeDays.IsUnknown() || bakedOn.IsNull() || bakedOn.IsUnknown() {
+		return false, diags
+	}
+
+	baked, err := time.Parse(dateLayout, bakedOn.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("baked_on"),
+			"Invalid Baked On",
+			fmt.Sprintf("baked_on must be a date in YYYY-MM-DD format, got %q.", bakedOn.ValueString()),
+		)
+		return false, diags
+	}
+	lastGood := baked.AddDate(0, 0, int(shelfLifeDays.ValueInt64()))
+	return c.Today().After(lastGood), diags
+}
+
+// replaceIfExpired is the plan modifier of a computed expired attribute: once
+// a refresh has found the resource expired, the plan replaces it.
+type replaceIfExpired struct{}
+
+var _ planmodifier.Bool = replaceIfExpired{}
+
+func (m replaceIfExpired) Description(ctx context.Context) string {
+	return "Replaces the resource once it has expired."
+}
+
+func (m replaceIfExpired) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m replaceIfExpired) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	// Nothing to replace while creating or destroying
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if req.StateValue.ValueBool() {
+		resp.RequiresReplace = true
+		resp.PlanValue = types.BoolUnknown()
+	}
+}
@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -17,6 +16,9 @@ import (
 var _ resource.Resource = &FridgeResource{}
 var _ resource.ResourceWithImportState = &FridgeResource{}
 
+// fridgeUsefulLifeYears is the default useful_life_years of an fridge.
+const fridgeUsefulLifeYears = 8
+
 func NewFridgeResource() resource.Resource {
 	return &FridgeResource{}
 }
@@ -26,10 +28,17 @@ type FridgeResource struct {
 }
 
 type FridgeResourceModel struct {
-	Size        types.String `tfsdk:"size"`
-	Description types.String `tfsdk:"description"`
-	Cost        types.Number `tfsdk:"cost"`
-	Id          types.String `tfsdk:"id"`
+	Size            types.String `tfsdk:"size"`
+	Description     types.String `tfsdk:"description"`
+	Cost            MoneyValue   `tfsdk:"cost"`
+	PurchaseDate    types.String `tfsdk:"purchase_date"`
+	UsefulLifeYears types.Number `tfsdk:"useful_life_years"`
+	BookValue       MoneyValue   `tfsdk:"book_value"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
 }
 
 func (r *FridgeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -81,6 +90,7 @@ resource "hw_fridge" "variable" {
 - Required for ` + "`hw_store`" + ` resource
 - Sizes: small ($300), medium ($500), large ($800)
 - Cost is automatically computed
+- Set ` + "`purchase_date`" + ` to **depreciate** the fridge: ` + "`book_value`" + ` declines over time
 
 *Cool air preserves,*
 *Fresh ingredients waiting,*
@@ -96,9 +106,30 @@ resource "hw_fridge" "variable" {
 				Optional:            true,
 			},
 			"cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
 				Computed:            true,
 				MarkdownDescription: "Cost of the fridge in dollars",
 			},
+			"purchase_date": schema.StringAttribute{
+				MarkdownDescription: "Date the fridge was bought (`YYYY-MM-DD`). Without it the fridge is treated as new and `book_value` equals `cost`",
+				Optional:            true,
+			},
+			"useful_life_years": schema.NumberAttribute{
+				MarkdownDescription: "Years over which the fridge depreciates to zero (defaults to 8)",
+				Optional:            true,
+			},
+			"book_value": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Straight-line depreciated value of the fridge as of today (or the provider's `as_of` date)",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Fridge identifier",
@@ -137,23 +168,21 @@ func (r *FridgeResource) Create(ctx context.Context, req resource.CreateRequest,
 
 
 	// Calculate cost based on size
-	var basePrice *big.Float
 	size := data.Size.ValueString()
-	switch size {
-	case "small":
-		basePrice = big.NewFloat(300.00)
-	case "medium":
-		basePrice = big.NewFloat(500.00)
-	case "large":
-		basePrice = big.NewFloat(800.00)
-	default:
-		basePrice = big.NewFloat(300.00) // default to small
-	}
+	basePrice := r.client.VariantPrice("fridge", size, "small")
 
 	finalPrice := ApplyUpcharge(basePrice, r.client.Upcharge)
-	data.Cost = types.NumberValue(finalPrice)
+	data.Cost = r.client.Price(finalPrice)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+
+	bookValue, diags := r.client.BookValue(finalPrice, data.PurchaseDate, data.UsefulLifeYears, fridgeUsefulLifeYears)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.BookValue = bookValue
 
-	id := fmt.Sprintf("fridge-%s-%d", size, len(size))
+	id := r.client.NewId("fridge", fmt.Sprintf("%s-%d", size, len(size)))
 	data.Id = types.StringValue(id)
 
 	tflog.Trace(ctx, "created a fridge resource", map[string]any{
@@ -162,6 +191,7 @@ func (r *FridgeResource) Create(ctx context.Context, req resource.CreateRequest,
 		"cost": data.Cost.ValueBigFloat().String(),
 	})
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -175,22 +205,21 @@ func (r *FridgeResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 
 	// Recalculate cost
-	var basePrice *big.Float
 	size := data.Size.ValueString()
-	switch size {
-	case "small":
-		basePrice = big.NewFloat(300.00)
-	case "medium":
-		basePrice = big.NewFloat(500.00)
-	case "large":
-		basePrice = big.NewFloat(800.00)
-	default:
-		basePrice = big.NewFloat(300.00)
-	}
+	basePrice := r.client.VariantPrice("fridge", size, "small")
 
 	finalPrice := ApplyUpcharge(basePrice, r.client.Upcharge)
-	data.Cost = types.NumberValue(finalPrice)
+	data.Cost = r.client.Price(finalPrice)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+
+	bookValue, diags := r.client.BookValue(finalPrice, data.PurchaseDate, data.UsefulLifeYears, fridgeUsefulLifeYears)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.BookValue = bookValue
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -204,21 +233,19 @@ func (r *FridgeResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 
 	// Recalculate cost
-	var basePrice *big.Float
 	size := data.Size.ValueString()
-	switch size {
-	case "small":
-		basePrice = big.NewFloat(300.00)
-	case "medium":
-		basePrice = big.NewFloat(500.00)
-	case "large":
-		basePrice = big.NewFloat(800.00)
-	default:
-		basePrice = big.NewFloat(300.00)
-	}
+	basePrice := r.client.VariantPrice("fridge", size, "small")
 
 	finalPrice := ApplyUpcharge(basePrice, r.client.Upcharge)
-	data.Cost = types.NumberValue(finalPrice)
+	data.Cost = r.client.Price(finalPrice)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+
+	bookValue, diags := r.client.BookValue(finalPrice, data.PurchaseDate, data.UsefulLifeYears, fridgeUsefulLifeYears)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.BookValue = bookValue
 
 	var state FridgeResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -227,12 +254,13 @@ func (r *FridgeResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	if !data.Size.Equal(state.Size) {
-		id := fmt.Sprintf("fridge-%s-%d", size, len(size))
+		id := r.client.NewId("fridge", fmt.Sprintf("%s-%d", size, len(size)))
 		data.Id = types.StringValue(id)
 	} else {
 		data.Id = state.Id
 	}
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &FulfillOrdersAction{}
+var _ action.ActionWithConfigure = &FulfillOrdersAction{}
+
+func NewFulfillOrdersAction() action.Action {
+	return &FulfillOrdersAction{}
+}
+
+// FulfillOrdersAction defines the action implementation.
+type FulfillOrdersAction struct {
+	client *ProviderConfig
+}
+
+// FulfillOrdersActionModel describes the action data model.
+type FulfillOrdersActionModel struct {
+	StoreId   types.String `tfsdk:"store_id"`
+	MaxOrders types.Int64  `tfsdk:"max_orders"`
+}
+
+func (a *FulfillOrdersAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fulfill_orders"
+}
+
+func (a *FulfillOrdersAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Works through a store's order queue (see ` + "`hw_order_queue`" + `), oldest order first, delivering each one and reporting progress as it goes.
+
+**Example Usage:**
+
+` + "```hcl" + `
+action "hw_fulfill_orders" "lunch_rush" {
+  config {
+    store_id   = hw_store.main.id
+    max_orders = 10
+  }
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates a **long-running action** that streams a progress event per order
+- Delivered orders leave the queue and free the store's capacity for new ones; each ` + "`hw_order`" + ` picks up its delivered status on its next refresh
+- Stops early, keeping the orders delivered so far, when Terraform is interrupted
+- Works through the orders the provider has records of: every order with the provider's ` + "`backend_path`" + ` set, otherwise only those read or changed in the same run, with a warning when it found none
+- The last event totals the menu items the delivered orders used, which ` + "`hw_store_stats`" + ` counts as ` + "`inventory_consumed`" + `
+
+*Tickets come off the rail,*
+*One plate, then the next, then more,*
+*Until the pass is clear.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_store whose orders to fulfill",
+				Required:            true,
+			},
+			"max_orders": schema.Int64Attribute{
+				MarkdownDescription: "Most orders to fulfill in one run (defaults to the whole queue)",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Max Orders", min: 1, max: math.MaxInt64},
+				},
+			},
+		},
+	}
+}
+
+func (a *FulfillOrdersAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	a.client = config
+}
+
+func (a *FulfillOrdersAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data FulfillOrdersActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var registry *Registry
+	if a.client != nil {
+		registry = a.client.Registry
+	}
+
+	storeId := data.StoreId.ValueString()
+	_, storeKnown, diags := lookupReference[StoreResourceModel](a.client, path.Root("store_id"), storeId, "store")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queue := pendingOrders(registry, storeId)
+	if !data.MaxOrders.IsNull() && int64(len(queue)) > data.MaxOrders.ValueInt64() {
+		queue = queue[:data.MaxOrders.ValueInt64()]
+	}
+	if len(queue) == 0 {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("No pending orders at %s.", storeId),
+		})
+		// Without the store's record, its orders most likely weren't read
+		// either
+		if !storeKnown {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("store_id"),
+				"Store Not Read",
+				fmt.Sprintf("The provider has no record of %s or its orders in this run, so there was nothing to fulfill. Set the provider's backend_path for actions to see every order.", storeId),
+			)
+		}
+		return
+	}
+
+	var delivered, items int
+	for _, order := range queue {
+		if err := ctx.Err(); err != nil {
+			resp.Diagnostics.AddWarning(
+				"Fulfillment Interrupted",
+				fmt.Sprintf("Stopped after delivering %d of %d orders at %s: %s. Invoke the action again to work through the rest.", delivered, len(queue), storeId, err),
+			)
+			break
+		}
+
+		order.Status = types.StringValue(orderStatuses[len(orderStatuses)-1])
+		registry.Put(order.Id.ValueString(), order)
+		delivered++
+		items += len(order.ItemIds.Elements())
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Delivered %s (%d items, $%s), %d of %d.", order.Id.ValueString(), len(order.ItemIds.Elements()), formatCents(order.Total.Cents()), delivered, len(queue)),
+		})
+	}
+
+	flushActionChanges(registry, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Fulfilled %d orders at %s, using %d menu items.", delivered, storeId, items),
+	})
+
+	tflog.Trace(ctx, "invoked fulfill orders action", map[string]any{
+		"store_id":  storeId,
+		"delivered": delivered,
+		"items":     items,
+	})
+}
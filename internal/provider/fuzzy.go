@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// editDistance returns the Levenshtein distance between a and b: the fewest
+// single-rune insertions, deletions and substitutions turning one into the
+// other.
+func editDistance(a, b string) int {
+	source, target := []rune(a), []rune(b)
+
+	// previous holds the distances from the prefix of source handled so far
+	// to each prefix of target
+	previous := make([]int, len(target)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	current := make([]int, len(target)+1)
+	for i := range source {
+		current[0] = i + 1
+		for j := range target {
+			cost := 1
+			if source[i] == target[j] {
+				cost = 0
+			}
+			current[j+1] = min(previous[j+1]+1, current[j]+1, previous[j]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(target)]
+}
+
+// closestMatch returns the candidate nearest to value, ignoring case, when it
+// is a near miss: at most a third of value's length away, and at least one
+// edit for short values. Ties go to the candidate listed first.
+func closestMatch(value string, candidates []string) (string, bool) {
+	value = strings.ToLower(value)
+	limit := max(1, len([]rune(value))/3)
+
+	best, bestDistance := "", limit+1
+	for _, candidate := range candidates {
+		if distance := editDistance(value, strings.ToLower(candidate)); distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+	return best, best != ""
+}
+
+// didYouMean returns a " Did you mean ...?" sentence suggesting the closest
+// candidate to value, or "" without a near miss, for appending to the detail
+// of a catalog validation error.
+func didYouMean(value string, candidates []string) string {
+	match, ok := closestMatch(value, candidates)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf(" Did you mean %q?", match)
+}
@@ -0,0 +1,45 @@
+package provider
+
+import "testing"
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"ham", "", 3},
+		{"", "ham", 3},
+		{"ham", "ham", 0},
+		{"turky", "turkey", 1},
+		{"salmi", "salami", 1},
+		{"pate", "pâté", 2},
+		{"kitten", "sitting", 3},
+	}
+
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b); got != tt.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestDidYouMean(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"turky", ` Did you mean "turkey"?`},
+		{"Pastrami", ` Did you mean "pastrami"?`},
+		{"rost beef", ` Did you mean "roast beef"?`},
+		{"hm", ` Did you mean "ham"?`},
+		{"tofu", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := didYouMean(tt.value, deliMeats); got != tt.want {
+			t.Errorf("didYouMean(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
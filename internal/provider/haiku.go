@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// haikuAttribute is the haiku attribute of every resource.
+func haikuAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "A haiku about the resource, written from its attributes when the provider's `emit_haikus` is set (null otherwise). The same attributes always give the same haiku",
+	}
+}
+
+// haikuOpenings, haikuMiddles and haikuClosings are the lines resource haikus
+// are put together from: five syllables, seven, then five.
+var (
+	haikuOpenings = []string{
+		"Dawn at the deli",
+		"Crumbs on the counter",
+		"The slicer hums low",
+		"Steam from the soup pot",
+		"Mustard, rye, and salt",
+		"A plan with no diff",
+		"Terraform apply",
+		"State file, freshly saved",
+		"The lunch rush at noon",
+		"Rain on the window",
+		"Pickles in a jar",
+		"Just one more resource",
+	}
+	haikuMiddles = []string{
+		"The provider counts the cost",
+		"An ID no one will read",
+		"Tags that nobody will check",
+		"The registry remembers",
+		"Lettuce crisp beneath the knife",
+		"A cook hums an old love song",
+		"Refresh finds nothing has changed",
+		"The sandwich waits to be built",
+		"Known only after apply",
+		"A regular orders soup",
+		"The fridge door sighs and closes",
+		"Twelve tables and one more chair",
+	}
+	haikuClosings = []string{
+		"Then the plan is clean",
+		"Nothing left to change",
+		"Lunch is served at last",
+		"Crumbs, then quiet state",
+		"The bell rings again",
+		"Saved until destroyed",
+		"Zero to destroy",
+		"Still warm in the bag",
+		"The doors close at nine",
+		"Apply complete, friend",
+		"Converged, as it should",
+		"Soup of the day: yes",
+	}
+)
+
+// resourceHaiku returns the haiku of a resource of type typeName whose state
+// is state: three lines picked by a hash of the type and every attribute but
+// haiku itself, so the haiku changes exactly when the resource does.
+func resourceHaiku(typeName string, state tftypes.Value) (string, error) {
+	var attributes map[string]tftypes.Value
+	if err := state.As(&attributes); err != nil {
+		return "", err
+	}
+
+	// Value.String writes maps and objects in key order, so equal values
+	// hash alike. The map shares state's storage, so haiku is skipped rather
+	// than deleted.
+	hash := fnv.New64a()
+	hash.Write([]byte(typeName))
+	for _, name := range slices.Sorted(maps.Keys(attributes)) {
+		if name == "haiku" {
+			continue
+		}
+		fmt.Fprintf(hash, "\x00%s=%s", name, attributes[name])
+	}
+	sum := hash.Sum64()
+
+	lines := make([]string, 0, 3)
+	for _, choices := range [][]string{haikuOpenings, haikuMiddles, haikuClosings} {
+		lines = append(lines, choices[sum%uint64(len(choices))])
+		sum /= uint64(len(choices))
+	}
+	return strings.Join(lines, "\n"), nil
+}
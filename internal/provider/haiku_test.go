@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestResourceHaiku(t *testing.T) {
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"kind":  tftypes.String,
+		"haiku": tftypes.String,
+	}}
+	state := func(kind string, haiku tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"kind":  tftypes.NewValue(tftypes.String, kind),
+			"haiku": haiku,
+		})
+	}
+
+	rye, err := resourceHaiku("hw_bread", state("rye", tftypes.NewValue(tftypes.String, nil)))
+	if err != nil {
+		t.Fatalf("resourceHaiku: %v", err)
+	}
+	if lines := strings.Split(rye, "\n"); len(lines) != 3 {
+		t.Errorf("haiku %q has %d lines, want 3", rye, len(lines))
+	}
+
+	again, _ := resourceHaiku("hw_bread", state("rye", tftypes.NewValue(tftypes.String, rye)))
+	if again != rye {
+		t.Errorf("haiku changed with the haiku attribute: %q, then %q", rye, again)
+	}
+
+	seen := map[string]bool{rye: true}
+	for _, kind := range []string{"sourdough", "wheat", "white", "ciabatta", "brioche"} {
+		haiku, _ := resourceHaiku("hw_bread", state(kind, tftypes.NewValue(tftypes.String, nil)))
+		seen[haiku] = true
+	}
+	if len(seen) == 1 {
+		t.Error("every bread kind got the same haiku")
+	}
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+)
+
+// HappyHour is a weekly window in which drinks and desserts sell at a
+// discount.
+type HappyHour struct {
+	// Days are the lowercase weekday names the window repeats on
+	Days []string
+	// Start and End are minutes after midnight; the window includes Start
+	// and ends just before End
+	Start, End int
+	// DiscountPercent comes off the price, upcharge included
+	DiscountPercent *big.Float
+	// At is when the window is checked; the zero value means now, in the
+	// local time zone
+	At time.Time
+}
+
+// NewHappyHour validates a happy_hour window: days must be distinct weekday
+// names, start and end 24-hour HH:MM times with end after start, and the
+// discount a percentage from 0 to 100. at is an optional RFC 3339 time to
+// check the window at instead of now.
+func NewHappyHour(days []string, start, end string, discountPercent *big.Float, at string) (*HappyHour, error) {
+	h := &HappyHour{Days: days, DiscountPercent: discountPercent}
+
+	if len(days) == 0 {
+		return nil, fmt.Errorf("days must name at least one day")
+	}
+	for i, day := range days {
+		if !slices.Contains(weekdays, day) {
+			return nil, fmt.Errorf("days must be one of %s, got %q", strings.Join(weekdays, ", "), day)
+		}
+		if slices.Contains(days[:i], day) {
+			return nil, fmt.Errorf("%q appears in days more than once", day)
+		}
+	}
+
+	var ok bool
+	if h.Start, ok = parseClock(start); !ok {
+		return nil, fmt.Errorf("start must be in 24-hour HH:MM format (e.g., 16:00), got %q", start)
+	}
+	if h.End, ok = parseClock(end); !ok {
+		return nil, fmt.Errorf("end must be in 24-hour HH:MM format (e.g., 18:00), got %q", end)
+	}
+	if h.End <= h.Start {
+		return nil, fmt.Errorf("end (%s) must be after start (%s)", end, start)
+	}
+
+	if discountPercent == nil {
+		return nil, fmt.Errorf("discount must be set")
+	}
+	if discountPercent.Sign() < 0 || discountPercent.Cmp(big.NewFloat(100)) > 0 {
+		return nil, fmt.Errorf("discount must be a percentage from 0 to 100, got %s", discountPercent.String())
+	}
+
+	if at != "" {
+		parsed, err := time.Parse(time.RFC3339, at)
+		if err != nil {
+			return nil, fmt.Errorf("at must be an RFC 3339 time (e.g., 2024-06-07T17:30:00-07:00), got %q", at)
+		}
+		h.At = parsed
+	}
+	return h, nil
+}
+
+// Active reports whether the window is open at h.At, or now when At is
+// unset. It is safe to call on a nil happy hour, which is never active.
+func (h *HappyHour) Active() bool {
+	if h == nil {
+		return false
+	}
+
+	at := h.At
+	if at.IsZero() {
+		at = time.Now()
+	}
+	if !slices.Contains(h.Days, strings.ToLower(at.Weekday().String())) {
+		return false
+	}
+	minute := at.Hour()*60 + at.Minute()
+	return minute >= h.Start && minute < h.End
+}
+
+// HappyHourPrice applies the happy hour discount to the price of a drink or
+// dessert, and reports whether it did. It is safe to call on a nil config.
+func (c *ProviderConfig) HappyHourPrice(price *big.Float) (*big.Float, bool) {
+	if c == nil || !c.HappyHour.Active() {
+		return price, false
+	}
+
+	// price × (100 - discount) / 100
+	factor := new(big.Float).Sub(big.NewFloat(100), c.HappyHour.DiscountPercent)
+	discounted := new(big.Float).Mul(price, factor)
+	return discounted.Quo(discounted, big.NewFloat(100)), true
+}
@@ -0,0 +1,46 @@
+package provider
+
+import "strings"
+
+// menuLocales are the languages the menu is translated into, English first.
+var menuLocales = []string{"en", "es", "fr", "de", "nl"}
+
+// menuTranslations is the menu's i18n table: the name of each of the
+// menuItems in each of the menuLocales.
+var menuTranslations = map[string]map[string]string{
+	"sandwich":       {"en": "sandwich", "es": "sándwich", "fr": "sandwich", "de": "Sandwich", "nl": "broodje"},
+	"drink":          {"en": "drink", "es": "bebida", "fr": "boisson", "de": "Getränk", "nl": "drankje"},
+	"soup":           {"en": "soup", "es": "sopa", "fr": "soupe", "de": "Suppe", "nl": "soep"},
+	"salad":          {"en": "salad", "es": "ensalada", "fr": "salade", "de": "Salat", "nl": "salade"},
+	"cookie":         {"en": "cookie", "es": "galleta", "fr": "biscuit", "de": "Keks", "nl": "koekje"},
+	"brownie":        {"en": "brownie", "es": "brownie", "fr": "brownie", "de": "Brownie", "nl": "brownie"},
+	"stroopwafel":    {"en": "stroopwafel", "es": "stroopwafel", "fr": "gaufre au sirop", "de": "Sirupwaffel", "nl": "stroopwafel"},
+	"napkin":         {"en": "napkin", "es": "servilleta", "fr": "serviette", "de": "Serviette", "nl": "servet"},
+	"cracker":        {"en": "cracker", "es": "galleta salada", "fr": "cracker", "de": "Cracker", "nl": "cracker"},
+	"silverware":     {"en": "silverware", "es": "cubiertos", "fr": "couverts", "de": "Besteck", "nl": "bestek"},
+	"dogtreat_small": {"en": "small dog treat", "es": "premio para perro pequeño", "fr": "petite friandise pour chien", "de": "kleines Hundeleckerli", "nl": "klein hondensnoepje"},
+	"dogtreat_large": {"en": "large dog treat", "es": "premio para perro grande", "fr": "grande friandise pour chien", "de": "großes Hundeleckerli", "nl": "groot hondensnoepje"},
+}
+
+// menuLocale returns the menu locale for locale, ignoring case and any
+// region, so "es-MX" and "ES" both give "es". It reports false for languages
+// the menu isn't translated into.
+func menuLocale(locale string) (string, bool) {
+	language, _, _ := strings.Cut(strings.ToLower(locale), "-")
+	language, _, _ = strings.Cut(language, "_")
+	for _, known := range menuLocales {
+		if language == known {
+			return known, true
+		}
+	}
+	return "", false
+}
+
+// translateItem returns the name of the menu item in the menu locale.
+// Items the i18n table doesn't list are returned unchanged.
+func translateItem(item, locale string) string {
+	if name, ok := menuTranslations[item][locale]; ok {
+		return name
+	}
+	return item
+}
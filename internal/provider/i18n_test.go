@@ -0,0 +1,38 @@
+package provider
+
+import "testing"
+
+func TestMenuTranslations(t *testing.T) {
+	for _, item := range menuItems {
+		for _, locale := range menuLocales {
+			if _, ok := menuTranslations[item][locale]; !ok {
+				t.Errorf("menu item %q has no %q translation", item, locale)
+			}
+		}
+	}
+}
+
+func TestTranslateItem(t *testing.T) {
+	tests := []struct {
+		item, locale string
+		want         string
+	}{
+		{"soup", "es", "sopa"},
+		{"dogtreat_large", "de", "großes Hundeleckerli"},
+		{"dogtreat_small", "en", "small dog treat"},
+		{"pretzel", "fr", "pretzel"},
+	}
+
+	for _, tt := range tests {
+		if got := translateItem(tt.item, tt.locale); got != tt.want {
+			t.Errorf("translateItem(%q, %q) = %q, want %q", tt.item, tt.locale, got, tt.want)
+		}
+	}
+
+	locales := map[string]string{"es": "es", "es-MX": "es", "FR": "fr", "nl_BE": "nl", "pt": "", "": ""}
+	for locale, want := range locales {
+		if got, _ := menuLocale(locale); got != want {
+			t.Errorf("menuLocale(%q) = %q, want %q", locale, got, want)
+		}
+	}
+}
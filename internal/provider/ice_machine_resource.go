@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &IceMachineResource{}
+var _ resource.ResourceWithImportState = &IceMachineResource{}
+
+func NewIceMachineResource() resource.Resource {
+	return &IceMachineResource{}
+}
+
+type IceMachineResource struct {
+	client *ProviderConfig
+}
+
+type IceMachineResourceModel struct {
+	PoundsPerDay    types.Int64  `tfsdk:"pounds_per_day"`
+	Description     types.String `tfsdk:"description"`
+	Cost            MoneyValue   `tfsdk:"cost"`
+	IceDemand       types.Number `tfsdk:"ice_demand"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
+}
+
+// iceDemand is how many pounds of ice a day each hw_drink uses at the ice
+// levels that count against an ice machine. Drinks with some or no ice use
+// too little to matter.
+var iceDemand = map[string]int64{
+	"lots": 10,
+	"max":  15,
+}
+
+func (r *IceMachineResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ice_machine"
+}
+
+func (r *IceMachineResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Keeps the drinks cold. An ice machine makes a fixed number of pounds of ice a day, and every ` + "`hw_drink`" + ` poured with lots or max ice draws on it.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_drink" "iced_tea" {
+  kind = "iced tea"
+
+  ice {
+    max = true
+  }
+}
+
+resource "hw_ice_machine" "back" {
+  pounds_per_day = 50
+  # cost computed as $250 (50 × $5)
+  # ice_demand computed as 15 from the iced tea
+
+  depends_on = [hw_drink.iced_tea]
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates **aggregate constraint checking**: the demand of every ` + "`hw_drink`" + ` known to the provider is totaled and checked against one resource's output
+- Each drink with ` + "`lots`" + ` of ice uses 10 pounds a day, and each with ` + "`max`" + ` ice 15; ` + "`some`" + ` ice doesn't count
+- Creating or updating the machine fails when the demand exceeds ` + "`pounds_per_day`" + `
+- Drinks are only counted once they're known, so use ` + "`depends_on`" + ` to create them first
+- Capacity costs $5 per pound a day
+
+*Hum behind the wall,*
+*A scoop rattles through the bin,*
+*Lemonade sweats cold.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"pounds_per_day": schema.Int64Attribute{
+				MarkdownDescription: "Pounds of ice the machine makes a day (at least 1)",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Ice Output", min: 1, max: math.MaxInt64},
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the ice machine",
+				Optional:            true,
+			},
+			"cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Cost in dollars (pounds_per_day × $5)",
+			},
+			"ice_demand": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Pounds of ice a day used by the `hw_drink` resources with lots (10) or max (15) ice",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Ice machine identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *IceMachineResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *IceMachineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IceMachineResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setCostAndDemand(ctx, &data, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pounds := strconv.FormatInt(data.PoundsPerDay.ValueInt64(), 10)
+	id := r.client.NewId("ice", fmt.Sprintf("%s-%d", pounds, len(pounds)))
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created an ice machine resource", map[string]any{
+		"id":         data.Id.ValueString(),
+		"ice_demand": data.IceDemand.ValueBigFloat().String(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IceMachineResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IceMachineResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost and demand
+	resp.Diagnostics.Append(r.setCostAndDemand(ctx, &data, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IceMachineResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data IceMachineResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost and demand
+	resp.Diagnostics.Append(r.setCostAndDemand(ctx, &data, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state IceMachineResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.PoundsPerDay.Equal(state.PoundsPerDay) {
+		pounds := strconv.FormatInt(data.PoundsPerDay.ValueInt64(), 10)
+		id := r.client.NewId("ice", fmt.Sprintf("%s-%d", pounds, len(pounds)))
+		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IceMachineResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IceMachineResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted an ice machine resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *IceMachineResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setCostAndDemand computes the machine's cost and totals the ice demand of
+// the drinks in the registry. With enforce set, a demand above the output is
+// an error.
+func (r *IceMachineResource) setCostAndDemand(ctx context.Context, data *IceMachineResourceModel, enforce bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	output := data.PoundsPerDay.ValueInt64()
+	demand, heaviest := drinksIceDemand(ctx, r.client.Registry)
+	if enforce && demand > output {
+		diags.AddAttributeError(
+			path.Root("pounds_per_day"),
+			"Ice Demand Exceeds Output",
+			fmt.Sprintf("Drinks with lots or max ice need %d pounds of ice a day (%s uses the most), but the machine makes %d. Raise pounds_per_day or ease off the ice.",
+				demand, heaviest, output),
+		)
+		return diags
+	}
+
+	data.Cost = r.client.Price(ApplyUpcharge(new(big.Float).Mul(big.NewFloat(float64(output)), r.client.BasePrice("ice_machine_pound")), r.client.Upcharge))
+	data.IceDemand = types.NumberValue(big.NewFloat(float64(demand)))
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+	return diags
+}
+
+// drinksIceDemand totals the daily ice demand of the drinks known to the
+// registry and returns the ID of the drink that uses the most.
+func drinksIceDemand(ctx context.Context, registry *Registry) (int64, string) {
+	var total, most int64
+	var heaviest string
+	for _, drink := range ListRecords[DrinkResourceModel](registry) {
+		pounds := iceDemand[drinkIceLevel(ctx, drink)]
+		total += pounds
+		if pounds > most {
+			most = pounds
+			heaviest = drink.Id.ValueString()
+		}
+	}
+	return total, heaviest
+}
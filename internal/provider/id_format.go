@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// defaultIdFormat gives the provider's built-in IDs, such as bread-rye-3.
+const defaultIdFormat = "{type}-{name}"
+
+// idPlaceholders are the placeholders an id_format template may use:
+//   - {type} is the ID prefix of the resource type, such as bread or camera
+//   - {name} is the part of the built-in ID after the prefix, such as rye-3
+//   - {random} is 8 hex digits drawn from the provider's seed
+var idPlaceholders = []string{"{type}", "{name}", "{random}"}
+
+var idPlaceholderPattern = regexp.MustCompile(`\{[^{}]*\}`)
+
+// IdFormat is a validated id_format template. It generates resource IDs and
+// parses the name back out of them, which resources that refer to others by
+// ID rely on.
+type IdFormat struct {
+	template string
+	// patterns caches the regular expression matching the IDs of each type
+	patterns sync.Map
+}
+
+// ParseIdFormat validates an id_format template: it may only use the
+// placeholders in idPlaceholders, each at most once, and must use {type} and
+// {name} so IDs stay unique and references can be checked and read back.
+func ParseIdFormat(template string) (*IdFormat, error) {
+	counts := map[string]int{}
+	for _, placeholder := range idPlaceholderPattern.FindAllString(template, -1) {
+		if !slices.Contains(idPlaceholders, placeholder) {
+			return nil, fmt.Errorf("unknown placeholder %s; supported placeholders are %s", placeholder, strings.Join(idPlaceholders, ", "))
+		}
+		counts[placeholder]++
+		if counts[placeholder] > 1 {
+			return nil, fmt.Errorf("placeholder %s is used more than once", placeholder)
+		}
+	}
+	for _, required := range []string{"{type}", "{name}"} {
+		if counts[required] == 0 {
+			return nil, fmt.Errorf("it must include %s", required)
+		}
+	}
+	if rest := idPlaceholderPattern.ReplaceAllString(template, ""); strings.ContainsAny(rest, "{}") {
+		return nil, fmt.Errorf("it has an unmatched brace")
+	}
+	return &IdFormat{template: template}, nil
+}
+
+// Format builds the ID of a resource of the given type prefix and name.
+func (f *IdFormat) Format(typ, name, random string) string {
+	return strings.NewReplacer("{type}", typ, "{name}", name, "{random}", random).Replace(f.template)
+}
+
+// Name returns the name part of an ID built by Format for the given type
+// prefix. It reports false when the ID doesn't have that form.
+func (f *IdFormat) Name(id, typ string) (string, bool) {
+	match := f.pattern(typ).FindStringSubmatch(id)
+	if match == nil {
+		return "", false
+	}
+	return match[1], true
+}
+
+// pattern returns the regular expression matching the IDs of the given type
+// prefix, with the name as its only group.
+func (f *IdFormat) pattern(typ string) *regexp.Regexp {
+	if cached, ok := f.patterns.Load(typ); ok {
+		return cached.(*regexp.Regexp)
+	}
+
+	var expr strings.Builder
+	expr.WriteString("^")
+	last := 0
+	for _, loc := range idPlaceholderPattern.FindAllStringIndex(f.template, -1) {
+		expr.WriteString(regexp.QuoteMeta(f.template[last:loc[0]]))
+		switch f.template[loc[0]:loc[1]] {
+		case "{type}":
+			expr.WriteString(regexp.QuoteMeta(typ))
+		case "{name}":
+			expr.WriteString("(.+)")
+		case "{random}":
+			expr.WriteString("[0-9a-f]{8}")
+		}
+		last = loc[1]
+	}
+	expr.WriteString(regexp.QuoteMeta(f.template[last:]))
+	expr.WriteString("$")
+
+	compiled := regexp.MustCompile(expr.String())
+	f.patterns.Store(typ, compiled)
+	return compiled
+}
+
+// builtinIdFormat is the format used when id_format is unset.
+var builtinIdFormat, _ = ParseIdFormat(defaultIdFormat)
+
+// idFormat returns the provider's ID format. It is safe to call on a nil
+// config.
+func (c *ProviderConfig) idFormat() *IdFormat {
+	if c == nil || c.IdFormat == nil {
+		return builtinIdFormat
+	}
+	return c.IdFormat
+}
+
+// NewId builds the ID of a resource of the given type prefix (such as bread)
+// and name (the rest of the built-in ID, such as rye-3) from the provider's
+// id_format. The name is slugified, so "roast beef-10" gives roast-beef-10,
+// the same key hw_catalog and the slugify function use. {random} is drawn
+// from the provider's seed for the type and name, so plan and apply agree on
+// it. It is safe to call on a nil config.
+func (c *ProviderConfig) NewId(typ, name string) string {
+	name = slugify(name)
+	format := c.idFormat()
+	var random string
+	if strings.Contains(format.template, "{random}") {
+		random = fmt.Sprintf("%08x", c.Rand("id/"+typ+"/"+name).Uint32())
+	}
+	return format.Format(typ, name, random)
+}
+
+// idSerial counts NewUniqueId calls, so two calls never draw from the same
+// key.
+var idSerial atomic.Uint64
+
+// NewUniqueId is NewId for resources whose arguments don't tell them apart,
+// such as two orders a customer places for the same number of items: the
+// name ends in 8 hex digits drawn from the provider's seed for the time and
+// order of the call, so every call gives a new ID. It is safe to call on a
+// nil config.
+func (c *ProviderConfig) NewUniqueId(typ, name string) string {
+	key := fmt.Sprintf("id/%s/%s/%d/%d", typ, name, time.Now().UnixNano(), idSerial.Add(1))
+	return c.NewId(typ, fmt.Sprintf("%s-%08x", name, c.Rand(key).Uint32()))
+}
+
+// IsIdOf reports whether id has the form of the given type prefix's IDs.
+func (c *ProviderConfig) IsIdOf(id, typ string) bool {
+	_, ok := c.idFormat().Name(id, typ)
+	return ok
+}
+
+// TypeOfId returns the first of the given type prefixes whose IDs id has the
+// form of, so TypeOfId("cookie-oat-3", "drink", "cookie") gives cookie. It
+// reports false when id has none of their forms.
+func (c *ProviderConfig) TypeOfId(id string, typs ...string) (string, bool) {
+	for _, typ := range typs {
+		if c.IsIdOf(id, typ) {
+			return typ, true
+		}
+	}
+	return "", false
+}
+
+// KindFromId returns the kind encoded in the ID of a resource of the given
+// type prefix: its name less the trailing length, so bread-rye-3 gives rye.
+// It returns "unknown" when the ID is not of that type.
+func (c *ProviderConfig) KindFromId(id, typ string) string {
+	name, ok := c.idFormat().Name(id, typ)
+	if !ok {
+		return "unknown"
+	}
+
+	// The last dash separates the kind from its length
+	lastDash := strings.LastIndex(name, "-")
+	if lastDash == -1 {
+		return name
+	}
+	return name[:lastDash]
+}
@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// underscoreConfig returns a config whose IDs use "{type}_{name}", so code
+// that splits IDs on the default format's dash gets them wrong.
+func underscoreConfig(t *testing.T) *ProviderConfig {
+	t.Helper()
+	format, err := ParseIdFormat("{type}_{name}")
+	if err != nil {
+		t.Fatalf("ParseIdFormat: %v", err)
+	}
+	return &ProviderConfig{IdFormat: format, Registry: NewRegistry()}
+}
+
+func TestParseIdFormat(t *testing.T) {
+	tests := map[string]bool{
+		"{type}-{name}":          true,
+		"{type}_{name}":          true,
+		"hw:{type}/{name}":       true,
+		"{type}-{name}-{random}": true,
+		"{name}":                 false,
+		"{type}{name}{name}":     false,
+		"{type}-{nme}":           false,
+		"{type}-{name}}":         false,
+	}
+
+	for template, valid := range tests {
+		_, err := ParseIdFormat(template)
+		if valid && err != nil {
+			t.Errorf("ParseIdFormat(%q) = %v, want no error", template, err)
+		}
+		if !valid && err == nil {
+			t.Errorf("ParseIdFormat(%q) succeeded, want an error", template)
+		}
+	}
+}
+
+func TestIdFormatUnderscore(t *testing.T) {
+	c := underscoreConfig(t)
+
+	id := c.NewId("dessert-case", "cold-4")
+	if id != "dessert-case_cold-4" {
+		t.Fatalf("NewId = %q, want dessert-case_cold-4", id)
+	}
+	if !c.IsIdOf(id, "dessert-case") {
+		t.Errorf("IsIdOf(%q, dessert-case) = false, want true", id)
+	}
+	if c.IsIdOf(id, "dessert") {
+		t.Errorf("IsIdOf(%q, dessert) = true, want false", id)
+	}
+	if kind := c.KindFromId(id, "dessert-case"); kind != "cold" {
+		t.Errorf("KindFromId(%q) = %q, want cold", id, kind)
+	}
+
+	tests := map[string]string{
+		"cookie_oat-3":       "cookie",
+		"drink_cola-4":       "drink",
+		"sandwich_rye-ham-3": "sandwich",
+		"cookie-oat-3":       "",
+		"bread_rye-3":        "",
+	}
+	for id, want := range tests {
+		got, ok := c.TypeOfId(id, ticketItems...)
+		if got != want || ok != (want != "") {
+			t.Errorf("TypeOfId(%q) = %q, %t, want %q", id, got, ok, want)
+		}
+	}
+}
+
+func TestPackagingQuantityIdFormat(t *testing.T) {
+	c := underscoreConfig(t)
+
+	tests := map[string]struct {
+		kind     string
+		quantity int64
+		ok       bool
+	}{
+		"box_qty-12":   {"box", 12, true},
+		"cup_qty-3":    {"cup", 3, true},
+		"straw_qty-50": {"straw", 50, true},
+		"box-qty-12":   {"", 0, false},
+		"box_large-5":  {"", 0, false},
+		"napkin_qty-3": {"", 0, false},
+	}
+	for id, want := range tests {
+		kind, quantity, ok := packagingQuantity(c, id)
+		if kind != want.kind || quantity != want.quantity || ok != want.ok {
+			t.Errorf("packagingQuantity(%q) = %q, %d, %t, want %q, %d, %t", id, kind, quantity, ok, want.kind, want.quantity, want.ok)
+		}
+	}
+}
+
+func TestCheckDessertCaseIdFormat(t *testing.T) {
+	ctx := context.Background()
+	c := underscoreConfig(t)
+	c.Registry.Put("dessert-case_cold-4", DessertCaseResourceModel{Capacity: types.Int64Value(1)})
+
+	store := func(caseId string, items ...string) *StoreResourceModel {
+		itemIds, _ := types.SetValueFrom(ctx, types.StringType, items)
+		return &StoreResourceModel{MenuItemIds: itemIds, DessertCaseId: types.StringValue(caseId)}
+	}
+
+	if diags := checkDessertCase(ctx, c, store("dessert-case_cold-4", "sandwich_rye-ham-3", "cookie_oat-3")); diags.HasError() {
+		t.Errorf("one dessert in a one-tray case: %v", diags)
+	}
+	if diags := checkDessertCase(ctx, c, store("dessert-case_cold-4", "cookie_oat-3", "brownie_fudge-5")); !diags.HasError() {
+		t.Error("two desserts in a one-tray case: want Dessert Case Full")
+	}
+	if diags := checkDessertCase(ctx, c, store("dessert-case_warm-4", "cookie_oat-3")); diags.HasError() {
+		t.Errorf("unread dessert case: %v", diags)
+	}
+	if diags := checkDessertCase(ctx, c, store("dessert-case_cold-4", "cookie-oat-3")); !diags.HasError() {
+		t.Error("default-format menu item ID: want Unknown Menu Item")
+	}
+}
+
+func TestMenuPayloadIdFormat(t *testing.T) {
+	ctx := context.Background()
+	c := underscoreConfig(t)
+
+	itemIds, _ := types.SetValueFrom(ctx, types.StringType, []string{"drink_cola-4", "cookie_oat-3"})
+	data := &StoreResourceModel{
+		Id:          types.StringValue("store_main-4"),
+		Name:        types.StringValue("Main"),
+		MenuItemIds: itemIds,
+	}
+	if diags := setMenuPayload(ctx, c, data); diags.HasError() {
+		t.Fatalf("setMenuPayload: %v", diags)
+	}
+
+	encoded, err := base64.RawURLEncoding.DecodeString(data.MenuPayload.ValueString())
+	if err != nil {
+		t.Fatalf("decoding menu_payload: %v", err)
+	}
+	var payload menuPayload
+	if err := json.Unmarshal(encoded, &payload); err != nil {
+		t.Fatalf("unmarshaling menu_payload: %v", err)
+	}
+	want := map[string]string{"cookie_oat-3": "cookie", "drink_cola-4": "drink"}
+	if len(payload.Items) != len(want) {
+		t.Fatalf("menu_payload has %d items, want %d", len(payload.Items), len(want))
+	}
+	for _, item := range payload.Items {
+		if item.Item != want[item.Id] {
+			t.Errorf("menu item %q = %q, want %q", item.Id, item.Item, want[item.Id])
+		}
+	}
+}
+
+func TestDailySalesIdFormat(t *testing.T) {
+	c := underscoreConfig(t)
+	itemIds, _ := types.ListValueFrom(context.Background(), types.StringType, []string{"sandwich_rye-ham-3", "drink_cola-4", "drink_tea-3"})
+	c.Registry.Put("order_a-1", OrderResourceModel{
+		Id:       types.StringValue("order_a-1"),
+		StoreId:  types.StringValue("store_main-4"),
+		ItemIds:  itemIds,
+		Total:    NewMoneyCents(900),
+		Status:   types.StringValue("placed"),
+		PlacedAt: types.StringValue("2026-10-16T12:00:00Z"),
+	})
+
+	report := dailySales(c, "store_main-4", "2026-10-16")
+	want := []salesItemRank{{"drink", 2}, {"sandwich", 1}}
+	if !slices.Equal(report.TopItems, want) {
+		t.Errorf("top items = %v, want %v", report.TopItems, want)
+	}
+}
+
+func TestSeasonalMenuIdFormat(t *testing.T) {
+	ctx := context.Background()
+	r := &SeasonalMenuResource{client: underscoreConfig(t)}
+
+	menu := func(items ...string) *SeasonalMenuResourceModel {
+		itemIds, _ := types.SetValueFrom(ctx, types.StringType, items)
+		return &SeasonalMenuResourceModel{
+			StoreId:     types.StringValue("store_main-4"),
+			Season:      types.StringValue("fall"),
+			MenuItemIds: itemIds,
+		}
+	}
+
+	if diags := r.validate(ctx, menu("soup_pumpkin-7", "drink_cider-5"), ""); diags.HasError() {
+		t.Errorf("underscore menu item IDs: %v", diags)
+	}
+	if diags := r.validate(ctx, menu("soup-pumpkin-7"), ""); !diags.HasError() {
+		t.Error("default-format menu item ID: want Unknown Menu Item")
+	}
+}
+
+func TestNewIdRandomIsPerName(t *testing.T) {
+	// {random} is drawn from the seed for the type and name, so stores that
+	// share a name share an ID, as allow_duplicate_names documents
+	format, err := ParseIdFormat("{type}-{name}-{random}")
+	if err != nil {
+		t.Fatalf("ParseIdFormat: %v", err)
+	}
+	c := &ProviderConfig{IdFormat: format}
+
+	if a, b := c.NewId("store", "main-4"), c.NewId("store", "main-4"); a != b {
+		t.Errorf("NewId for the same name = %q and %q, want the same ID", a, b)
+	}
+	if a, b := c.NewId("store", "main-4"), c.NewId("store", "side-4"); a[len(a)-8:] == b[len(b)-8:] {
+		t.Errorf("NewId for different names share {random}: %q and %q", a, b)
+	}
+}
+
+func TestNewUniqueId(t *testing.T) {
+	// Orders from one customer for the same number of items share a name,
+	// but not an ID
+	c := &ProviderConfig{Registry: NewRegistry()}
+	seen := map[string]bool{}
+	for range 100 {
+		id := c.NewUniqueId("order", "pat-1")
+		if seen[id] {
+			t.Fatalf("NewUniqueId gave %q twice", id)
+		}
+		seen[id] = true
+		if !c.IsIdOf(id, "order") {
+			t.Errorf("NewUniqueId = %q, not an order ID", id)
+		}
+	}
+}
@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IngredientSubstitutionsDataSource{}
+
+func NewIngredientSubstitutionsDataSource() datasource.DataSource {
+	return &IngredientSubstitutionsDataSource{}
+}
+
+// IngredientSubstitutionsDataSource defines the data source implementation.
+type IngredientSubstitutionsDataSource struct {
+	client any
+}
+
+// IngredientSubstitutionsDataSourceModel describes the data source data model.
+type IngredientSubstitutionsDataSourceModel struct {
+	Ingredient      types.String `tfsdk:"ingredient"`
+	AvoidAllergens  types.Set    `tfsdk:"avoid_allergens"`
+	Allergens       types.List   `tfsdk:"allergens"`
+	Substitutes     types.List   `tfsdk:"substitutes"`
+	SafeSubstitutes types.List   `tfsdk:"safe_substitutes"`
+	Substitutions   types.Map    `tfsdk:"substitutions"`
+	Id              types.String `tfsdk:"id"`
+}
+
+// ingredientSubstitutes maps each sandwich ingredient to its acceptable
+// substitutes, closest match first.
+var ingredientSubstitutes = map[string][]string{
+	"chipotle mayo":   {"hot sauce", "guacamole"},
+	"ham":             {"turkey", "tempeh"},
+	"mayonnaise":      {"aioli", "hummus", "guacamole"},
+	"mustard":         {"horseradish", "relish"},
+	"pesto":           {"italian dressing", "oil and vinegar"},
+	"ranch":           {"tzatziki", "hummus"},
+	"roast beef":      {"pastrami", "portobello"},
+	"rye":             {"sourdough", "gluten-free"},
+	"sourdough":       {"rye", "gluten-free"},
+	"thousand island": {"barbecue sauce", "ketchup"},
+	"turkey":          {"chicken", "tofu"},
+	"whole wheat":     {"rye", "gluten-free"},
+}
+
+// ingredientAllergens holds the allergens of every ingredient and substitute
+// that contains any, in alphabetical order. It is the shared allergen table:
+// it also covers the common hw_bread and hw_meat kinds, for hw_sandwich's
+// allergens.
+var ingredientAllergens = map[string][]string{
+	"aioli":                {"egg"},
+	"anchovies":            {"fish"},
+	"blue cheese dressing": {"milk"},
+	"brioche":              {"egg", "milk", "wheat"},
+	"caesar dressing":      {"egg", "fish", "milk"},
+	"chicken salad":        {"egg"},
+	"chipotle mayo":        {"egg"},
+	"ciabatta":             {"wheat"},
+	"dijon mustard":        {"mustard"},
+	"egg salad":            {"egg"},
+	"honey mustard":        {"mustard"},
+	"hummus":               {"sesame"},
+	"lox":                  {"fish"},
+	"mayonnaise":           {"egg"},
+	"meatballs":            {"egg", "milk", "wheat"},
+	"mortadella":           {"tree nut"},
+	"multigrain":           {"wheat"},
+	"mustard":              {"mustard"},
+	"pesto":                {"milk", "tree nut"},
+	"ranch":                {"egg", "milk"},
+	"remoulade":            {"egg"},
+	"russian dressing":     {"egg"},
+	"rye":                  {"wheat"},
+	"sardines":             {"fish"},
+	"smoked salmon":        {"fish"},
+	"sourdough":            {"wheat"},
+	"spicy brown mustard":  {"mustard"},
+	"sriracha mayo":        {"egg"},
+	"tartar sauce":         {"egg"},
+	"tempeh":               {"soy"},
+	"thousand island":      {"egg"},
+	"tofu":                 {"soy"},
+	"tuna salad":           {"egg", "fish"},
+	"tzatziki":             {"milk"},
+	"wheat":                {"wheat"},
+	"white":                {"wheat"},
+	"whole wheat":          {"wheat"},
+}
+
+// IngredientAllergens returns the sorted, distinct allergens the substitution
+// table knows about.
+func IngredientAllergens() []string {
+	var allergens []string
+	for _, list := range ingredientAllergens {
+		allergens = append(allergens, list...)
+	}
+	slices.Sort(allergens)
+	return slices.Compact(allergens)
+}
+
+func (d *IngredientSubstitutionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ingredient_substitutions"
+}
+
+func (d *IngredientSubstitutionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `What the kitchen can swap in when an ingredient runs out or a customer can't eat it. Look up one ingredient's substitutes, narrowed to the ones free of the allergens to avoid, or take the whole table for your own checks.
+
+**Example Usage:**
+
+` + "```hcl" + `
+data "hw_ingredient_substitutions" "mayo" {
+  ingredient      = "mayonnaise"
+  avoid_allergens = ["egg"]
+  # substitutes computed as ["aioli", "hummus", "guacamole"]
+  # safe_substitutes computed as ["hummus", "guacamole"]
+}
+
+locals {
+  spread = contains(var.allergies, "egg") ? data.hw_ingredient_substitutions.mayo.safe_substitutes[0] : "mayonnaise"
+}
+
+# Reject a custom build that swaps in something the kitchen won't
+variable "bread_swap" {
+  type = string
+}
+
+resource "hw_bread" "custom" {
+  kind = var.bread_swap
+
+  lifecycle {
+    precondition {
+      condition     = contains(data.hw_ingredient_substitutions.mayo.substitutions["sourdough"], var.bread_swap)
+      error_message = "Sourdough can only be swapped for rye or gluten-free."
+    }
+  }
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates a lookup data source whose outputs feed **conditional expressions** and **preconditions**
+- ` + "`safe_substitutes`" + ` drops substitutes containing any of ` + "`avoid_allergens`" + `
+- Covers spreads, breads, and deli meats
+
+*No mayo, they said,*
+*Hummus slides in, just as smooth,*
+*Nobody misses it.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"ingredient": schema.StringAttribute{
+				MarkdownDescription: "Ingredient to find substitutes for (e.g., `mayonnaise`, `sourdough`, `turkey`)",
+				Optional:            true,
+			},
+			"avoid_allergens": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Allergens `safe_substitutes` must not contain: egg, milk, mustard, sesame, soy, tree nut, or wheat",
+				Optional:            true,
+			},
+			"allergens": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Allergens `ingredient` contains, in alphabetical order (null without an ingredient)",
+				Computed:            true,
+			},
+			"substitutes": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Substitutes for `ingredient`, closest match first (null without an ingredient)",
+				Computed:            true,
+			},
+			"safe_substitutes": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The `substitutes` that contain none of `avoid_allergens`, in the same order (null without an ingredient)",
+				Computed:            true,
+			},
+			"substitutions": schema.MapAttribute{
+				ElementType:         types.ListType{ElemType: types.StringType},
+				MarkdownDescription: "The whole substitution table: map of ingredient to its substitutes, closest match first",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *IngredientSubstitutionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData
+}
+
+func (d *IngredientSubstitutionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IngredientSubstitutionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var avoid []string
+	if !data.AvoidAllergens.IsNull() && !data.AvoidAllergens.IsUnknown() {
+		resp.Diagnostics.Append(data.AvoidAllergens.ElementsAs(ctx, &avoid, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	for _, allergen := range avoid {
+		if !slices.Contains(IngredientAllergens(), allergen) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("avoid_allergens").AtSetValue(types.StringValue(allergen)),
+				"Unknown Allergen",
+				fmt.Sprintf("%q is not a known allergen. Allergens: %s.", allergen, strings.Join(IngredientAllergens(), ", ")),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	substitutions, diags := types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, ingredientSubstitutes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Substitutions = substitutions
+
+	data.Allergens = types.ListNull(types.StringType)
+	data.Substitutes = types.ListNull(types.StringType)
+	data.SafeSubstitutes = types.ListNull(types.StringType)
+	data.Id = types.StringValue("ingredient-substitutions")
+
+	if ingredient := data.Ingredient.ValueString(); ingredient != "" {
+		substitutes, ok := ingredientSubstitutes[ingredient]
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ingredient"),
+				"Unknown Ingredient",
+				fmt.Sprintf("%q has no known substitutes. Ingredients: %s.", ingredient, strings.Join(slices.Sorted(maps.Keys(ingredientSubstitutes)), ", ")),
+			)
+			return
+		}
+
+		safe := []string{}
+		for _, substitute := range substitutes {
+			if !slices.ContainsFunc(ingredientAllergens[substitute], func(allergen string) bool {
+				return slices.Contains(avoid, allergen)
+			}) {
+				safe = append(safe, substitute)
+			}
+		}
+
+		allergens, diags := types.ListValueFrom(ctx, types.StringType, append([]string{}, ingredientAllergens[ingredient]...))
+		resp.Diagnostics.Append(diags...)
+		substitutesValue, diags := types.ListValueFrom(ctx, types.StringType, substitutes)
+		resp.Diagnostics.Append(diags...)
+		safeValue, diags := types.ListValueFrom(ctx, types.StringType, safe)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Allergens = allergens
+		data.Substitutes = substitutesValue
+		data.SafeSubstitutes = safeValue
+		data.Id = types.StringValue("ingredient-substitutions-" + ingredient)
+	}
+
+	tflog.Trace(ctx, "read ingredient substitutions data source", map[string]any{
+		"ingredient": data.Ingredient.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,407 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &InsurancePolicyResource{}
+var _ resource.ResourceWithImportState = &InsurancePolicyResource{}
+
+func NewInsurancePolicyResource() resource.Resource {
+	return &InsurancePolicyResource{}
+}
+
+type InsurancePolicyResource struct {
+	client *ProviderConfig
+}
+
+type InsurancePolicyResourceModel struct {
+	StoreId         types.String `tfsdk:"store_id"`
+	Coverage        types.String `tfsdk:"coverage"`
+	InsuredValue    MoneyValue   `tfsdk:"insured_value"`
+	EquipmentCount  types.Int64  `tfsdk:"equipment_count"`
+	DiscountPercent types.Int64  `tfsdk:"discount_percent"`
+	Premium         MoneyValue   `tfsdk:"premium"`
+	Deductible      MoneyValue   `tfsdk:"deductible"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
+}
+
+// insuranceCoverages are the accepted coverage tiers, from the cheapest.
+var insuranceCoverages = []string{"basic", "standard", "premium"}
+
+// insuranceRates are the monthly premium of each coverage tier, in basis
+// points (hundredths of a percent) of the insured value. Each tier's
+// deductible is the "insurance_deductible_<tier>" base price.
+var insuranceRates = map[string]int64{
+	"basic":    100,
+	"standard": 150,
+	"premium":  250,
+}
+
+// insuranceSafetyDiscount is the premium discount, in percent, for each kind
+// of safety equipment a store has: fire extinguishers and security cameras.
+const insuranceSafetyDiscount = 5
+
+// insuredEquipment is the equipment of a store that an insurance policy
+// covers on top of the store's own cost.
+type insuredEquipment struct {
+	Count         int64
+	Value         *big.Float
+	Extinguishers bool
+	Cameras       bool
+}
+
+func (r *InsurancePolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_insurance_policy"
+}
+
+func (r *InsurancePolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Insurance for a store and everything in it, in case the slicer meets the floor. The premium is worked out from the store's cost and the equipment around it, so it goes up as the store grows.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_insurance_policy" "main" {
+  store_id = hw_store.main.id
+  coverage = "standard"
+  # insured_value computed from the store's cost and its equipment
+  # premium computed as 1.5% of insured_value a month, less safety discounts
+  # deductible computed as $1,000
+}
+
+resource "hw_fire_extinguisher" "kitchen" {
+  quantity = 2
+  class    = "k"
+  store_id = hw_store.main.id
+  # adds $360 to the policy's insured_value and takes 5% off its premium
+}
+
+output "monthly_premium" {
+  value = hw_insurance_policy.main.premium
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates **pricing derived from an aggregate**: the premium totals records read from the registry, and is recomputed on refresh as the store's equipment changes
+- ` + "`insured_value`" + ` is the store's ` + "`cost`" + ` plus the cost of its dessert case, parking lot and bins, and of every dumpster, exit sign, fire extinguisher and security camera with its ` + "`store_id`" + `
+- Coverage tiers: basic 1% of ` + "`insured_value`" + ` a month with a $2,500 deductible, standard 1.5% with $1,000, premium 2.5% with $250
+- Fire extinguishers and security cameras each take 5% off the premium
+- Equipment whose record is not known to the provider is left out
+- The premium needs the store's record; when an apply leaves the store untouched, ` + "`insured_value`" + `, ` + "`equipment_count`" + `, ` + "`discount_percent`" + ` and ` + "`premium`" + ` are null until the next refresh prices them
+
+*The oven, the till,*
+*Every chair counted and priced,*
+*Sleep well, little deli.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_store the policy insures",
+				Required:            true,
+			},
+			"coverage": schema.StringAttribute{
+				MarkdownDescription: "Coverage tier: basic, standard, or premium. Better coverage costs more a month and has a smaller deductible",
+				Required:            true,
+			},
+			"insured_value": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Value insured in dollars: the store's `cost` plus the cost of the equipment counted in `equipment_count`",
+			},
+			"equipment_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Pieces of equipment insured on top of the store: its dessert case, parking lot and bins, and the dumpsters, exit signs, fire extinguishers and security cameras with its `store_id`, by quantity",
+			},
+			"discount_percent": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Safety discount on the premium, in percent: 5 when the store has fire extinguishers, and 5 more when it has security cameras",
+			},
+			"premium": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Monthly premium in dollars: the coverage tier's rate (basic 1%, standard 1.5%, premium 2.5%) of `insured_value`, less `discount_percent`. Null until the provider has read the store, which an apply that leaves the store unchanged doesn't; the next refresh fills it in",
+			},
+			"deductible": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Deductible per claim in dollars (basic=$2,500, standard=$1,000, premium=$250)",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Insurance policy identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *InsurancePolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *InsurancePolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data InsurancePolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	store, storeKnown, diags := lookupReference[StoreResourceModel](r.client, path.Root("store_id"), data.StoreId.ValueString(), "store")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setPremium(ctx, &data, store, storeKnown)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(r.newId(data))
+
+	tflog.Trace(ctx, "created an insurance policy resource", map[string]any{
+		"id":            data.Id.ValueString(),
+		"insured_value": data.InsuredValue.String(),
+		"premium":       data.Premium.String(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InsurancePolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data InsurancePolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reprice for the store's current equipment, or keep the last premium
+	// when the store's record is not known
+	if store, ok := LookupRecord[StoreResourceModel](r.client.Registry, data.StoreId.ValueString()); ok {
+		resp.Diagnostics.Append(r.setPremium(ctx, &data, store, true)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InsurancePolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data InsurancePolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state InsurancePolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	store, storeKnown, diags := lookupReference[StoreResourceModel](r.client, path.Root("store_id"), data.StoreId.ValueString(), "store")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setPremium(ctx, &data, store, storeKnown)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.StoreId.Equal(state.StoreId) {
+		data.Id = types.StringValue(r.newId(data))
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InsurancePolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data InsurancePolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted an insurance policy resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *InsurancePolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// newId returns the ID of a store's insurance policy.
+func (r *InsurancePolicyResource) newId(data InsurancePolicyResourceModel) string {
+	store := r.client.KindFromId(data.StoreId.ValueString(), "store")
+	return r.client.NewId("policy", fmt.Sprintf("%s-%d", store, len(store)))
+}
+
+// setPremium validates the policy's coverage tier and prices it from the
+// cost of store and the equipment the registry knows it has. Without the
+// store's record, when storeKnown is false, only the deductible is priced.
+func (r *InsurancePolicyResource) setPremium(ctx context.Context, data *InsurancePolicyResourceModel, store StoreResourceModel, storeKnown bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	coverage := data.Coverage.ValueString()
+	rate, ok := insuranceRates[coverage]
+	if !ok {
+		diags.AddAttributeError(
+			path.Root("coverage"),
+			"Invalid Coverage",
+			fmt.Sprintf("coverage must be one of %s, got %q.%s", strings.Join(insuranceCoverages, ", "), coverage, didYouMean(coverage, insuranceCoverages)),
+		)
+		return diags
+	}
+
+	data.Deductible = r.client.Price(r.client.VariantPrice("insurance_deductible", coverage, "standard"))
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+
+	// The store's cost and equipment are priced on the next refresh
+	if !storeKnown {
+		data.InsuredValue = NewMoneyNull()
+		data.EquipmentCount = types.Int64Null()
+		data.DiscountPercent = types.Int64Null()
+		data.Premium = NewMoneyNull()
+		return diags
+	}
+
+	equipment, equipmentDiags := storeEquipment(ctx, r.client.Registry, store)
+	diags.Append(equipmentDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	insured := new(big.Float).Add(store.Cost.ValueBigFloat(), equipment.Value)
+	discount := int64(0)
+	if equipment.Extinguishers {
+		discount += insuranceSafetyDiscount
+	}
+	if equipment.Cameras {
+		discount += insuranceSafetyDiscount
+	}
+
+	// premium = insured × rate basis points × (100 - discount) percent
+	premium := new(big.Float).Mul(insured, big.NewFloat(float64(rate*(100-discount))))
+	premium.Quo(premium, big.NewFloat(10000*100))
+
+	data.InsuredValue = r.client.Price(insured)
+	data.EquipmentCount = types.Int64Value(equipment.Count)
+	data.DiscountPercent = types.Int64Value(discount)
+	data.Premium = r.client.Price(premium)
+	return diags
+}
+
+// storeEquipment totals the equipment of store that is not already part of
+// its cost: the dessert case, parking lot and bins it references, and the
+// dumpsters, exit signs, fire extinguishers and security cameras that
+// reference it. Equipment whose record is not in the registry is left out.
+func storeEquipment(ctx context.Context, registry *Registry, store StoreResourceModel) (insuredEquipment, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	equipment := insuredEquipment{Value: new(big.Float)}
+	add := func(cost MoneyValue, quantity int64) {
+		equipment.Value.Add(equipment.Value, cost.ValueBigFloat())
+		equipment.Count += quantity
+	}
+
+	if dessertCase, ok := LookupRecord[DessertCaseResourceModel](registry, store.DessertCaseId.ValueString()); ok {
+		add(dessertCase.Cost, 1)
+	}
+	if lot, ok := LookupRecord[ParkingLotResourceModel](registry, store.ParkingLotId.ValueString()); ok {
+		add(lot.Cost, 1)
+	}
+	if !store.BinIds.IsNull() && !store.BinIds.IsUnknown() {
+		var binIds []string
+		diags.Append(store.BinIds.ElementsAs(ctx, &binIds, false)...)
+		for _, id := range binIds {
+			if bin, ok := LookupRecord[CompostBinResourceModel](registry, id); ok {
+				add(bin.Cost, 1)
+			} else if bin, ok := LookupRecord[RecyclingBinResourceModel](registry, id); ok {
+				add(bin.Cost, 1)
+			}
+		}
+	}
+
+	for _, dumpster := range ListRecords[DumpsterResourceModel](registry) {
+		if dumpster.StoreId.Equal(store.Id) {
+			add(dumpster.Cost, 1)
+		}
+	}
+	for _, sign := range ListRecords[ExitSignResourceModel](registry) {
+		if sign.StoreId.Equal(store.Id) {
+			add(sign.Cost, sign.Quantity.ValueInt64())
+		}
+	}
+	for _, extinguisher := range ListRecords[FireExtinguisherResourceModel](registry) {
+		if extinguisher.StoreId.Equal(store.Id) {
+			add(extinguisher.Cost, extinguisher.Quantity.ValueInt64())
+			equipment.Extinguishers = equipment.Extinguishers || extinguisher.Quantity.ValueInt64() > 0
+		}
+	}
+	for _, camera := range ListRecords[SecurityCameraResourceModel](registry) {
+		if camera.StoreId.Equal(store.Id) {
+			add(camera.Cost, camera.Quantity.ValueInt64())
+			equipment.Cameras = equipment.Cameras || camera.Quantity.ValueInt64() > 0
+		}
+	}
+	return equipment, diags
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestStoreEquipment(t *testing.T) {
+	registry := NewRegistry()
+	store := StoreResourceModel{
+		Id:            types.StringValue("store-main-4"),
+		DessertCaseId: types.StringValue("dessert-case-4-1"),
+		ParkingLotId:  types.StringValue("parking-lot-unknown"),
+		BinIds:        types.SetNull(types.StringType),
+	}
+	registry.Put("dessert-case-4-1", DessertCaseResourceModel{Cost: NewMoneyCents(20000)})
+	registry.Put("extinguisher-k-2", FireExtinguisherResourceModel{
+		StoreId:  types.StringValue("store-main-4"),
+		Quantity: types.Int64Value(2),
+		Cost:     NewMoneyCents(36000),
+	})
+	registry.Put("sign-3", ExitSignResourceModel{
+		StoreId:  types.StringValue("store-other-5"),
+		Quantity: types.Int64Value(3),
+		Cost:     NewMoneyCents(10500),
+	})
+
+	equipment, diags := storeEquipment(context.Background(), registry, store)
+	if diags.HasError() {
+		t.Fatalf("storeEquipment: %v", diags)
+	}
+	if equipment.Count != 3 || equipment.Value.Cmp(big.NewFloat(560)) != 0 {
+		t.Errorf("got %d pieces worth $%s, want 3 worth $560", equipment.Count, equipment.Value.Text('f', 2))
+	}
+	if !equipment.Extinguishers || equipment.Cameras {
+		t.Errorf("got extinguishers %t and cameras %t, want only extinguishers", equipment.Extinguishers, equipment.Cameras)
+	}
+}
@@ -0,0 +1,280 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &JanitorResource{}
+var _ resource.ResourceWithImportState = &JanitorResource{}
+
+func NewJanitorResource() resource.Resource {
+	return &JanitorResource{}
+}
+
+type JanitorResource struct {
+	client *ProviderConfig
+}
+
+type JanitorResourceModel struct {
+	Name            types.String `tfsdk:"name"`
+	ShiftsPerWeek   types.Int64  `tfsdk:"shifts_per_week"`
+	Description     types.String `tfsdk:"description"`
+	Cost            MoneyValue   `tfsdk:"cost"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
+}
+
+// Cleanliness model: a store loses 3 points a day from 100 after its last
+// deep clean, less the share of the 7 weekly shifts its janitors cover. Full
+// coverage keeps it spotless. Janitors without a record count as working 5
+// shifts, and nobody works more than 14 (two a day).
+const (
+	cleanlinessDecayPerDay = 3.0
+	requiredJanitorShifts  = 7
+	defaultJanitorShifts   = 5
+	maxJanitorShifts       = 14
+)
+
+func (r *JanitorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_janitor"
+}
+
+func (r *JanitorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Mops, buckets, and the closing shift. A store gets a little grubbier every day after its last deep clean unless its janitors cover enough shifts, and its ` + "`cleanliness_score`" + ` shows how far it has slipped.
+
+**Example Usage:**
+
+` + "```hcl" + `
+provider "hw" {
+  as_of = "2025-06-15" # keeps cleanliness_score stable between runs
+}
+
+resource "hw_janitor" "night" {
+  name            = "lee"
+  shifts_per_week = 5
+  # cost computed as $450 (5 × $90)
+}
+
+resource "hw_store" "main" {
+  # ...
+  janitor_ids     = [hw_janitor.night.id]
+  last_deep_clean = "2025-06-01"
+  # cleanliness_score computed as 88 (14 days × 3 × (1 - 5/7) off 100)
+}
+` + "```" + `
+
+**Key Concepts:**
+- Combines **time modeling** with a **cross-resource effect**: the score depends on the date and on the store's janitors
+- A store loses 3 points a day after ` + "`last_deep_clean`" + `, scaled down by the share of 7 weekly shifts its janitors cover
+- 7 or more shifts a week keep the score at 100; the score never drops below 0
+- Set the provider's ` + "`as_of`" + ` to make the score deterministic
+- Each shift costs $90
+
+*Chairs up on the tables,*
+*Mop water swirls down the drain,*
+*Floors shine by morning.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the janitor",
+				Required:            true,
+			},
+			"shifts_per_week": schema.Int64Attribute{
+				MarkdownDescription: "Shifts the janitor works a week (1 to 14)",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Shifts Per Week", min: 1, max: maxJanitorShifts},
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the janitor",
+				Optional:            true,
+			},
+			"cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Weekly cost in dollars (shifts_per_week × $90)",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Janitor identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *JanitorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *JanitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data JanitorResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setCost(&data)
+
+	id := r.client.NewId("janitor", fmt.Sprintf("%s-%d", data.Name.ValueString(), len(data.Name.ValueString())))
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created a janitor resource", map[string]any{
+		"id":   data.Id.ValueString(),
+		"cost": data.Cost.ValueBigFloat().String(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *JanitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data JanitorResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost
+	r.setCost(&data)
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *JanitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data JanitorResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost
+	r.setCost(&data)
+
+	var state JanitorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Name.Equal(state.Name) {
+		id := r.client.NewId("janitor", fmt.Sprintf("%s-%d", data.Name.ValueString(), len(data.Name.ValueString())))
+		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *JanitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data JanitorResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted a janitor resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *JanitorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setCost computes the janitor's weekly cost.
+func (r *JanitorResource) setCost(data *JanitorResourceModel) {
+	var totalCost big.Float
+	totalCost.Mul(big.NewFloat(float64(data.ShiftsPerWeek.ValueInt64())), r.client.BasePrice("janitor_shift"))
+	data.Cost = r.client.Price(ApplyUpcharge(&totalCost, r.client.Upcharge))
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+}
+
+// janitorShifts totals the weekly shifts of the janitors, from the registry
+// when a janitor's record is known and defaultJanitorShifts otherwise.
+func janitorShifts(registry *Registry, ids []string) int64 {
+	var total int64
+	for _, id := range ids {
+		janitor, ok := LookupRecord[JanitorResourceModel](registry, id)
+		if !ok {
+			total += defaultJanitorShifts
+			continue
+		}
+		total += janitor.ShiftsPerWeek.ValueInt64()
+	}
+	return total
+}
+
+// CleanlinessScore returns a store's cleanliness as of Today: 100 on the day
+// of its last deep clean, decaying each day after by the share of weekly
+// shifts its janitors leave uncovered. A zero lastDeepClean, or one after
+// Today, scores 100.
+func (c *ProviderConfig) CleanlinessScore(lastDeepClean time.Time, shifts int64) float64 {
+	if lastDeepClean.IsZero() {
+		return 100
+	}
+	days := math.Floor(c.Today().Sub(lastDeepClean).Hours() / 24)
+	if days <= 0 {
+		return 100
+	}
+
+	uncovered := 1 - math.Min(1, float64(shifts)/requiredJanitorShifts)
+	score := 100 - days*cleanlinessDecayPerDay*uncovered
+	return math.Max(0, math.Round(score))
+}
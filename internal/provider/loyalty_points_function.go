@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &LoyaltyPointsFunction{}
+
+func NewLoyaltyPointsFunction() function.Function {
+	return &LoyaltyPointsFunction{}
+}
+
+// LoyaltyPointsFunction defines the function implementation.
+type LoyaltyPointsFunction struct{}
+
+func (f *LoyaltyPointsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "loyalty_points"
+}
+
+func (f *LoyaltyPointsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Computes the loyalty points an order earns on a loyalty card tier",
+		MarkdownDescription: `Computes the loyalty points an order earns: 1 point per whole dollar of its total, scaled by the card's tier and rounded down. The tier multipliers live in the provider's pricing engine: bronze ×1, silver ×1.25, gold ×1.5, platinum ×2.
+
+**Example Usage:**
+
+` + "```hcl" + `
+# 41 points: 33 whole dollars at ×1.25
+output "silver_points" {
+  value = provider::hw::loyalty_points(33.80, "silver")
+}
+
+output "order_points" {
+  value = provider::hw::loyalty_points(hw_order.lunch.total, var.loyalty_tier)
+}
+` + "```" + `
+
+*Every sandwich counts,*
+*Bronze to platinum we climb,*
+*Free lunch on the way.*`,
+		Parameters: []function.Parameter{
+			function.NumberParameter{
+				Name:                "total",
+				MarkdownDescription: "Order total in dollars; may not be negative",
+			},
+			function.StringParameter{
+				Name:                "tier",
+				MarkdownDescription: "Loyalty card tier: bronze, silver, gold, or platinum",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *LoyaltyPointsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var total *big.Float
+	var tier string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &total, &tier))
+	if resp.Error != nil {
+		return
+	}
+
+	totalCents := toCents(total)
+	if totalCents < 0 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("total may not be negative, got %s.", total.Text('f', -1)))
+		return
+	}
+	if _, ok := loyaltyMultipliers[tier]; !ok {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("tier must be one of %s, got %q.%s", strings.Join(loyaltyTiers, ", "), tier, didYouMean(tier, loyaltyTiers)))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, LoyaltyPoints(totalCents, tier)))
+}
@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ManagerResource{}
+var _ resource.ResourceWithImportState = &ManagerResource{}
+
+func NewManagerResource() resource.Resource {
+	return &ManagerResource{}
+}
+
+type ManagerResource struct {
+	client *ProviderConfig
+}
+
+type ManagerResourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	MaxReports  types.Int64  `tfsdk:"max_reports"`
+	ReportIds   types.Set    `tfsdk:"report_ids"`
+	ReportCount types.Int64  `tfsdk:"report_count"`
+	Tags        types.Map    `tfsdk:"tags"`
+	TagsAll     types.Map    `tfsdk:"tags_all"`
+	Haiku       types.String `tfsdk:"haiku"`
+	Id          types.String `tfsdk:"id"`
+}
+
+func (r *ManagerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_manager"
+}
+
+func (r *ManagerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The person the cooks and cashiers answer to. A manager can only look after so many people, so the staff assigned in ` + "`report_ids`" + ` can't outnumber ` + "`max_reports`" + `.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_manager" "day_shift" {
+  name        = "sam"
+  max_reports = 3
+  report_ids = [
+    hw_cook.alice.id,
+    hw_employee.bob.id, # role = "cashier"
+  ]
+  # report_count computed as 2
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates **span-of-control validation** on a set of references
+- Reports are ` + "`hw_cook`" + ` resources, or ` + "`hw_employee`" + ` resources with the cook or cashier role
+- Janitors and managers can't report to a manager
+- Applying fails when the reports outnumber ` + "`max_reports`" + `
+
+*Clipboard in one hand,*
+*Three names on the morning shift,*
+*Room for one more yet.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the manager",
+				Required:            true,
+			},
+			"max_reports": schema.Int64Attribute{
+				MarkdownDescription: "Most cooks and cashiers the manager can look after (at least 1)",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Max Reports", min: 1, max: math.MaxInt64},
+				},
+			},
+			"report_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Set of hw_cook IDs, and hw_employee IDs with the cook or cashier role, that report to the manager. No more than `max_reports`",
+				Required:            true,
+			},
+			"report_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of cooks and cashiers reporting to the manager",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Manager identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ManagerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *ManagerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ManagerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.checkReports(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := r.client.NewId("manager", fmt.Sprintf("%s-%d", data.Name.ValueString(), len(data.Name.ValueString())))
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created a manager resource", map[string]any{
+		"id":           data.Id.ValueString(),
+		"report_count": data.ReportCount.ValueInt64(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ManagerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ManagerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recount the reports
+	data.ReportCount = types.Int64Value(int64(len(data.ReportIds.Elements())))
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ManagerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ManagerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.checkReports(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ManagerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Name.Equal(state.Name) {
+		id := r.client.NewId("manager", fmt.Sprintf("%s-%d", data.Name.ValueString(), len(data.Name.ValueString())))
+		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ManagerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ManagerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted a manager resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *ManagerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// checkReports validates that every report is a cook or cashier, then checks
+// the reports don't outnumber max_reports.
+func (r *ManagerResource) checkReports(ctx context.Context, data *ManagerResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var reportIds []string
+	diags.Append(data.ReportIds.ElementsAs(ctx, &reportIds, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, id := range reportIds {
+		switch {
+		case r.client.IsIdOf(id, "cook"):
+		case r.client.IsIdOf(id, "employee"):
+			// Employees report by role, when their record is known
+			employee, ok := LookupRecord[EmployeeResourceModel](r.client.Registry, id)
+			if role := employee.Role.ValueString(); ok && role != "cook" && role != "cashier" {
+				diags.AddAttributeError(
+					path.Root("report_ids").AtSetValue(types.StringValue(id)),
+					"Invalid Report",
+					fmt.Sprintf("%s is a %s. Only cooks and cashiers report to a manager.", id, role),
+				)
+			}
+		default:
+			diags.AddAttributeError(
+				path.Root("report_ids").AtSetValue(types.StringValue(id)),
+				"Unknown Report",
+				fmt.Sprintf("%q is not the ID of an hw_cook or hw_employee resource.", id),
+			)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	count := int64(len(reportIds))
+	if maxReports := data.MaxReports.ValueInt64(); count > maxReports {
+		diags.AddAttributeError(
+			path.Root("report_ids"),
+			"Too Many Reports",
+			fmt.Sprintf("%s has %d reports but can look after at most %d. Raise max_reports or assign some of them to another hw_manager.",
+				data.Name.ValueString(), count, maxReports),
+		)
+		return diags
+	}
+
+	data.ReportCount = types.Int64Value(count)
+	return diags
+}
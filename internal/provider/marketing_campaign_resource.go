@@ -0,0 +1,400 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &MarketingCampaignResource{}
+var _ resource.ResourceWithImportState = &MarketingCampaignResource{}
+
+func NewMarketingCampaignResource() resource.Resource {
+	return &MarketingCampaignResource{}
+}
+
+type MarketingCampaignResource struct {
+	client *ProviderConfig
+}
+
+type MarketingCampaignResourceModel struct {
+	StoreId            types.String `tfsdk:"store_id"`
+	Name               types.String `tfsdk:"name"`
+	Channel            types.String `tfsdk:"channel"`
+	Budget             types.Number `tfsdk:"budget"`
+	DurationDays       types.Int64  `tfsdk:"duration_days"`
+	StartsOn           types.String `tfsdk:"starts_on"`
+	EndsOn             types.String `tfsdk:"ends_on"`
+	Status             types.String `tfsdk:"status"`
+	DemandBoostPercent types.Number `tfsdk:"demand_boost_percent"`
+	Tags               types.Map    `tfsdk:"tags"`
+	TagsAll            types.Map    `tfsdk:"tags_all"`
+	Haiku              types.String `tfsdk:"haiku"`
+	Id                 types.String `tfsdk:"id"`
+}
+
+// marketingChannel is how well a marketing channel turns money into
+// customers: its boost to demand approaches MaxBoost percent as the daily
+// budget grows, and reaches half of it at HalfBudget dollars a day.
+type marketingChannel struct {
+	MaxBoost   float64
+	HalfBudget float64
+}
+
+// marketingChannels are the accepted channels and their returns.
+var marketingChannels = map[string]marketingChannel{
+	"flyers":       {MaxBoost: 10, HalfBudget: 20},
+	"social_media": {MaxBoost: 25, HalfBudget: 50},
+	"billboard":    {MaxBoost: 20, HalfBudget: 100},
+	"radio":        {MaxBoost: 30, HalfBudget: 150},
+}
+
+// maxMarketingBoost caps the combined demand boost, in percent, of a store's
+// active campaigns: past it, the neighborhood has heard of the store.
+const maxMarketingBoost = 50
+
+// maxCampaignDays is the longest campaign, a year.
+const maxCampaignDays = 365
+
+// campaignStatuses are the values of the campaign status attribute, in the
+// order a campaign goes through them.
+var campaignStatuses = []string{"scheduled", "active", "expired"}
+
+func (r *MarketingCampaignResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_marketing_campaign"
+}
+
+func (r *MarketingCampaignResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Flyers under windshield wipers, a jingle on the morning show. A campaign spends its budget over its run to bring more customers to a store, raising the store's ` + "`estimated_weekly_revenue`" + ` while it runs, then expires like a coupon.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_marketing_campaign" "grand_opening" {
+  store_id      = hw_store.main.id
+  name          = "grand-opening"
+  channel       = "social_media"
+  budget        = 1400
+  duration_days = 14
+  # $100 a day: demand_boost_percent computed as 16.67 (25 × 100 ÷ (100 + 50))
+  # ends_on computed as 13 days after starts_on
+}
+
+output "campaign_status" {
+  value = hw_marketing_campaign.grand_opening.status
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates **diminishing returns**: the boost approaches the channel's maximum as the daily budget (` + "`budget`" + ` ÷ ` + "`duration_days`" + `) grows, reaching half of it at the channel's half-budget
+- Channels: flyers up to 10% (half at $20 a day), social_media 25% ($50), billboard 20% ($100), radio 30% ($150)
+- Demonstrates **expiry over time**: ` + "`status`" + ` is scheduled before ` + "`starts_on`" + `, active through ` + "`ends_on`" + `, then expired, as of the provider's ` + "`as_of`" + ` date or today
+- While active, the boost raises the store's ` + "`estimated_weekly_revenue`" + `; a store's active campaigns add up to at most 50%
+- Campaigns are read after their store, so the store only learns of them from the provider's ` + "`backend_path`" + `, and with one set it picks up a new campaign on the next refresh
+
+*Posters on the pole,*
+*Hungry strangers read and turn,*
+*By Monday, faded.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_store the campaign brings customers to",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the campaign, unique within its store",
+				Required:            true,
+			},
+			"channel": schema.StringAttribute{
+				MarkdownDescription: "Where the campaign runs: flyers, social_media, billboard, or radio",
+				Required:            true,
+			},
+			"budget": schema.NumberAttribute{
+				MarkdownDescription: "Total spend in dollars over the campaign's run; must be positive",
+				Required:            true,
+			},
+			"duration_days": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Days the campaign runs, from 1 to %d. The budget is spread evenly over them", maxCampaignDays),
+				Required:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Duration", min: 1, max: maxCampaignDays},
+				},
+			},
+			"starts_on": schema.StringAttribute{
+				MarkdownDescription: "First day of the campaign (`YYYY-MM-DD`). Defaults to the day it is created (the provider's `as_of` date, when set)",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ends_on": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Last day of the campaign (`YYYY-MM-DD`): `duration_days` - 1 days after `starts_on`",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Where the campaign is in its run as of the provider's `as_of` date or today: scheduled, active, or expired. Only active campaigns boost the store",
+			},
+			"demand_boost_percent": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Percent the campaign raises the store's demand while active, to two decimal places: the channel's maximum × daily budget ÷ (daily budget + the channel's half-budget)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Marketing campaign identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *MarketingCampaignResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *MarketingCampaignResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MarketingCampaignResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, diags := lookupReference[StoreResourceModel](r.client, path.Root("store_id"), data.StoreId.ValueString(), "store")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setRun(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(r.newId(data))
+
+	tflog.Trace(ctx, "created a marketing campaign resource", map[string]any{
+		"id":     data.Id.ValueString(),
+		"status": data.Status.ValueString(),
+		"boost":  data.DemandBoostPercent.ValueBigFloat().String(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MarketingCampaignResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MarketingCampaignResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The campaign may have started or run out since the last refresh
+	resp.Diagnostics.Append(r.setRun(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MarketingCampaignResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MarketingCampaignResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state MarketingCampaignResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setRun(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.StoreId.Equal(state.StoreId) || !data.Name.Equal(state.Name) {
+		data.Id = types.StringValue(r.newId(data))
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MarketingCampaignResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MarketingCampaignResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted a marketing campaign resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *MarketingCampaignResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// newId returns the ID of a store's marketing campaign.
+func (r *MarketingCampaignResource) newId(data MarketingCampaignResourceModel) string {
+	name := data.Name.ValueString()
+	store := r.client.KindFromId(data.StoreId.ValueString(), "store")
+	return r.client.NewId("campaign", fmt.Sprintf("%s-%s-%d", store, name, len(name)))
+}
+
+// setRun validates the campaign's channel, budget and start date, defaulting
+// starts_on to Today for a new campaign, and computes its last day, status as
+// of Today and demand boost. It warns when the campaign has expired.
+func (r *MarketingCampaignResource) setRun(data *MarketingCampaignResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	channel, ok := marketingChannels[data.Channel.ValueString()]
+	if !ok {
+		names := slices.Sorted(maps.Keys(marketingChannels))
+		diags.AddAttributeError(
+			path.Root("channel"),
+			"Invalid Channel",
+			fmt.Sprintf("channel must be one of %s, got %q.%s", strings.Join(names, ", "), data.Channel.ValueString(), didYouMean(data.Channel.ValueString(), names)),
+		)
+	}
+	if data.Budget.ValueBigFloat().Sign() <= 0 {
+		diags.AddAttributeError(
+			path.Root("budget"),
+			"Invalid Budget",
+			fmt.Sprintf("budget must be positive, got %s.", data.Budget.ValueBigFloat().Text('f', -1)),
+		)
+	}
+
+	if data.StartsOn.IsUnknown() || data.StartsOn.IsNull() {
+		data.StartsOn = types.StringValue(r.client.Today().Format(dateLayout))
+	}
+	start, err := time.Parse(dateLayout, data.StartsOn.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("starts_on"),
+			"Invalid Starts On",
+			fmt.Sprintf("starts_on must be a date in YYYY-MM-DD format, got %q.", data.StartsOn.ValueString()),
+		)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	days := data.DurationDays.ValueInt64()
+	budget, _ := data.Budget.ValueBigFloat().Float64()
+	end := start.AddDate(0, 0, int(days)-1)
+	status := campaignStatus(start, end, r.client.Today())
+
+	data.EndsOn = types.StringValue(end.Format(dateLayout))
+	data.Status = types.StringValue(status)
+	data.DemandBoostPercent = types.NumberValue(big.NewFloat(channel.boost(budget / float64(days))))
+
+	if status == "expired" {
+		diags.AddAttributeWarning(
+			path.Root("status"),
+			"Marketing Campaign Expired",
+			fmt.Sprintf("Campaign %q ended on %s and no longer boosts %s. Move starts_on forward to run it again, or remove it.", data.Name.ValueString(), data.EndsOn.ValueString(), data.StoreId.ValueString()),
+		)
+	}
+	return diags
+}
+
+// boost returns the percent a campaign on the channel spending dailyBudget
+// dollars a day raises demand, to two decimal places. Each extra dollar buys
+// less than the one before: the boost nears MaxBoost but never reaches it.
+func (c marketingChannel) boost(dailyBudget float64) float64 {
+	if dailyBudget <= 0 {
+		return 0
+	}
+	return math.Round(c.MaxBoost*dailyBudget/(dailyBudget+c.HalfBudget)*100) / 100
+}
+
+// campaignStatus returns the status as of today of a campaign running from
+// start through end.
+func campaignStatus(start, end, today time.Time) string {
+	switch {
+	case today.Before(start):
+		return campaignStatuses[0]
+	case today.After(end):
+		return campaignStatuses[2]
+	default:
+		return campaignStatuses[1]
+	}
+}
+
+// MarketingBoost returns the combined demand boost, in percent, of the
+// marketing campaigns in the registry for the store with ID storeId that are
+// active as of Today, capped at maxMarketingBoost.
+func (c *ProviderConfig) MarketingBoost(storeId string) float64 {
+	today := c.Today()
+	var boost float64
+	for _, campaign := range ListRecords[MarketingCampaignResourceModel](c.Registry) {
+		if campaign.StoreId.ValueString() != storeId {
+			continue
+		}
+		start, startErr := time.Parse(dateLayout, campaign.StartsOn.ValueString())
+		end, endErr := time.Parse(dateLayout, campaign.EndsOn.ValueString())
+		if startErr != nil || endErr != nil || campaignStatus(start, end, today) != "active" {
+			continue
+		}
+		percent, _ := campaign.DemandBoostPercent.ValueBigFloat().Float64()
+		boost += percent
+	}
+	return min(boost, maxMarketingBoost)
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMarketingChannelBoost(t *testing.T) {
+	social := marketingChannels["social_media"]
+	tests := map[float64]float64{
+		0:     0,
+		50:    12.5,
+		100:   16.67,
+		1000:  23.81,
+		10000: 24.88,
+	}
+	for daily, want := range tests {
+		if got := social.boost(daily); got != want {
+			t.Errorf("social_media boost at $%g a day = %g, want %g", daily, got, want)
+		}
+	}
+}
+
+func TestCampaignStatus(t *testing.T) {
+	start := time.Date(2026, time.October, 5, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 13)
+	tests := map[string]string{
+		"2026-10-04": "scheduled",
+		"2026-10-05": "active",
+		"2026-10-18": "active",
+		"2026-10-19": "expired",
+	}
+	for day, want := range tests {
+		today, _ := time.Parse(dateLayout, day)
+		if got := campaignStatus(start, end, today); got != want {
+			t.Errorf("campaignStatus on %s = %s, want %s", day, got, want)
+		}
+	}
+}
@@ -3,12 +3,17 @@ package provider
 import (
 	"context"
 	"fmt"
+	"math"
+	"math/big"
+	"slices"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -23,16 +28,41 @@ func NewMeatResource() resource.Resource {
 
 // MeatResource defines the resource implementation.
 type MeatResource struct {
-	client any
+	client *ProviderConfig
 }
 
 // MeatResourceModel describes the resource data model.
 type MeatResourceModel struct {
 	Description types.String `tfsdk:"description"`
-	Kind        types.String `tfsdk:"kind"`
-	Id          types.String `tfsdk:"id"`
+	Kind         types.String `tfsdk:"kind"`
+	Thickness    types.String `tfsdk:"thickness"`
+	Slices       types.Int64  `tfsdk:"slices"`
+	WeightOunces types.Number `tfsdk:"weight_ounces"`
+	Cost         MoneyValue   `tfsdk:"cost"`
+	SlicesUsed   types.Int64  `tfsdk:"slices_used"`
+	Tags         types.Map    `tfsdk:"tags"`
+	TagsAll      types.Map    `tfsdk:"tags_all"`
+	Haiku        types.String `tfsdk:"haiku"`
+	Id           types.String `tfsdk:"id"`
 }
 
+// meatSlicing describes the slices of a thickness: each weighs Ounces, and a
+// sandwich takes PerSandwich of them.
+type meatSlicing struct {
+	Ounces      float64
+	PerSandwich int64
+}
+
+// meatThicknesses are the thicknesses meat can be sliced at.
+var meatThicknesses = map[string]meatSlicing{
+	"thin":    {Ounces: 0.5, PerSandwich: 4},
+	"regular": {Ounces: 0.75, PerSandwich: 3},
+	"thick":   {Ounces: 1, PerSandwich: 2},
+}
+
+// defaultMeatThickness is the thickness of meat that doesn't set one.
+const defaultMeatThickness = "regular"
+
 func (r *MeatResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_meat"
 }
@@ -60,6 +90,14 @@ resource "hw_meat" "roast_beef" {
   kind        = "roast beef"
   description = "Slow-roasted beef"
 }
+
+# A pound and a half of thin-sliced pastrami: 48 slices, enough for 12
+# sandwiches at 4 slices each
+resource "hw_meat" "pastrami" {
+  kind      = "pastrami"
+  thickness = "thin"
+  slices    = 48
+}
 ` + "```" + `
 
 **Common Meat Types:**
@@ -70,6 +108,8 @@ resource "hw_meat" "roast_beef" {
 - ` + "`pastrami`" + ` - Spiced pastrami
 - ` + "`salami`" + ` - Italian salami
 
+**Portioning:** ` + "`slices`" + ` makes the meat a **consumable capacity**. Each ` + "`hw_sandwich`" + ` using it takes 4 thin, 3 regular, or 2 thick slices, and a sandwich that would need more slices than are left fails to create. ` + "`weight_ounces`" + ` and ` + "`cost`" + ` follow from the slices (thin 0.5 oz, regular 0.75 oz, thick 1 oz each, at $0.60 an ounce).
+
 **Note:** The ` + "`kind`" + ` attribute accepts any string value, including multi-word names (e.g., "roast beef"). The resource ID is automatically computed and cannot be set manually.
 
 *Sliced thin and perfect,*
@@ -113,9 +153,37 @@ kind = "roast beef"  # Multi-word values are supported
 - Changing this value will cause the resource to be recreated (new ID generated)
 - The value is case-sensitive
 - Multi-word values (e.g., "roast beef") are supported
-- Any string value is accepted, but using standard meat types improves readability`,
+- Any string value is accepted, but using standard meat types improves readability
+- With the provider's ` + "`strict_catalog = true`" + `, the kind must be listed by ` + "`hw_deli_meats`" + `, and near misses get a suggestion`,
 				Required: true,
 			},
+			"thickness": schema.StringAttribute{
+				MarkdownDescription: "How thick the meat is sliced: thin, regular, or thick (defaults to regular). Sets each slice's weight and how many slices a sandwich takes",
+				Optional:            true,
+			},
+			"slices": schema.Int64Attribute{
+				MarkdownDescription: "Slices on hand. When set, the `hw_sandwich` resources using the meat can't take more slices than this. Unset, the supply is unlimited",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Slices", min: 1, max: math.MaxInt64},
+				},
+			},
+			"weight_ounces": schema.NumberAttribute{
+				MarkdownDescription: "Weight of the slices on hand in ounces (null without `slices`)",
+				Computed:            true,
+			},
+			"cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				MarkdownDescription: "Cost of the slices on hand in dollars: `weight_ounces` × $0.60, plus any provider upcharge (null without `slices`)",
+				Computed:            true,
+			},
+			"slices_used": schema.Int64Attribute{
+				MarkdownDescription: "Slices taken by the `hw_sandwich` resources using the meat",
+				Computed:            true,
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: `Automatically generated unique identifier for this meat resource.
@@ -148,7 +216,16 @@ func (r *MeatResource) Configure(ctx context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	r.client = req.ProviderData
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
 }
 
 func (r *MeatResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -161,10 +238,23 @@ func (r *MeatResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	resp.Diagnostics.Append(r.client.checkMeatKind(data.Kind.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if _, ok := meatThicknesses[meatThickness(data)]; !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("thickness"),
+			"Invalid Thickness",
+			fmt.Sprintf("thickness must be one of thin, regular, or thick, got %q.", data.Thickness.ValueString()),
+		)
+		return
+	}
+
 	// Simulate API delay
 
 	// Mock resource creation - generate a fake ID based on the kind
-	id := fmt.Sprintf("meat-%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString()))
+	id := r.client.NewId("meat", fmt.Sprintf("%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString())))
 	data.Id = types.StringValue(id)
 
 	tflog.Trace(ctx, "created a meat resource", map[string]any{
@@ -172,6 +262,14 @@ func (r *MeatResource) Create(ctx context.Context, req resource.CreateRequest, r
 		"kind": data.Kind.ValueString(),
 	})
 
+	resp.Diagnostics.Append(r.setPortions(&data, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -191,6 +289,14 @@ func (r *MeatResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// Mock resource read - just return the existing state
 	// In a real implementation, this would fetch from an API
 
+	resp.Diagnostics.Append(r.setPortions(&data, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -205,6 +311,19 @@ func (r *MeatResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	resp.Diagnostics.Append(r.client.checkMeatKind(data.Kind.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if _, ok := meatThicknesses[meatThickness(data)]; !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("thickness"),
+			"Invalid Thickness",
+			fmt.Sprintf("thickness must be one of thin, regular, or thick, got %q.", data.Thickness.ValueString()),
+		)
+		return
+	}
+
 	// Simulate API delay
 
 	// Mock resource update - regenerate ID if kind changed
@@ -216,13 +335,22 @@ func (r *MeatResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	// If kind changed, regenerate ID
 	if !data.Kind.Equal(state.Kind) {
-		id := fmt.Sprintf("meat-%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString()))
+		id := r.client.NewId("meat", fmt.Sprintf("%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString())))
 		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
 	} else {
 		// Keep existing ID
 		data.Id = state.Id
 	}
 
+	resp.Diagnostics.Append(r.setPortions(&data, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -239,7 +367,9 @@ func (r *MeatResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 	// Simulate API delay
 
-	// Mock resource deletion - nothing to do
+	// Mock resource deletion - forget the meat's record
+	r.client.Registry.Delete(data.Id.ValueString())
+
 	tflog.Trace(ctx, "deleted a meat resource", map[string]any{
 		"id": data.Id.ValueString(),
 	})
@@ -248,3 +378,72 @@ func (r *MeatResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 func (r *MeatResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// checkMeatKind requires kind to be in the hw_deli_meats catalog when the
+// provider's strict_catalog is set. It is safe to call on a nil config.
+func (c *ProviderConfig) checkMeatKind(kind string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if c == nil || !c.StrictCatalog || slices.Contains(deliMeats, kind) {
+		return diags
+	}
+
+	diags.AddAttributeError(
+		path.Root("kind"),
+		"Unknown Meat Kind",
+		fmt.Sprintf("%q is not in the hw_deli_meats catalog, and the provider's strict_catalog is set.%s", kind, didYouMean(kind, deliMeats)),
+	)
+	return diags
+}
+
+// meatThickness returns the meat's thickness, defaultMeatThickness when it
+// has none.
+func meatThickness(data MeatResourceModel) string {
+	if data.Thickness.IsNull() || data.Thickness.IsUnknown() {
+		return defaultMeatThickness
+	}
+	return data.Thickness.ValueString()
+}
+
+// setPortions computes the weight and cost of the meat's slices and counts
+// the slices taken by the sandwiches in the registry. With enforce set,
+// taking more slices than the meat has is an error.
+func (r *MeatResource) setPortions(data *MeatResourceModel, enforce bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	used := meatSlicesUsed(r.client.Registry, *data, "")
+	data.SlicesUsed = types.Int64Value(used)
+	if data.Slices.IsNull() {
+		data.WeightOunces = types.NumberNull()
+		data.Cost = NewMoneyNull()
+		return diags
+	}
+
+	onHand := data.Slices.ValueInt64()
+	if enforce && used > onHand {
+		diags.AddAttributeError(
+			path.Root("slices"),
+			"Not Enough Slices",
+			fmt.Sprintf("The sandwiches using %s take %d slices, but it has %d. Raise slices, slice the meat thinner, or make fewer sandwiches.", data.Id.ValueString(), used, onHand),
+		)
+		return diags
+	}
+
+	weight := big.NewFloat(float64(onHand) * meatThicknesses[meatThickness(*data)].Ounces)
+	data.WeightOunces = types.NumberValue(weight)
+	data.Cost = r.client.Price(ApplyUpcharge(new(big.Float).Mul(weight, r.client.BasePrice("meat_ounce")), r.client.Upcharge))
+	return diags
+}
+
+// meatSlicesUsed counts the slices of meat taken by the sandwiches in the
+// registry using it, leaving out the sandwich with ID skip.
+func meatSlicesUsed(registry *Registry, meat MeatResourceModel, skip string) int64 {
+	perSandwich := meatThicknesses[meatThickness(meat)].PerSandwich
+
+	var used int64
+	for _, sandwich := range ListRecords[SandwichResourceModel](registry) {
+		if sandwich.MeatId.Equal(meat.Id) && sandwich.Id.ValueString() != skip {
+			used += perSandwich
+		}
+	}
+	return used
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// loadMenuCSV reads the custom menu at filePath: rows of item,price where the
+// item is a pricing engine key and the price a non-negative dollar amount. An
+// optional item,price header row is skipped, as are blank lines. Every
+// invalid row is reported, with its line number, against menu_csv_path.
+func loadMenuCSV(filePath string) (map[string]*big.Float, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	attrPath := path.Root("menu_csv_path")
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		diags.AddAttributeError(attrPath, "Unable to Read Menu CSV", fmt.Sprintf("The custom menu could not be opened: %s", err))
+		return nil, diags
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	prices := map[string]*big.Float{}
+	lines := map[string]int{}
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			diags.AddAttributeError(attrPath, "Invalid Menu CSV", fmt.Sprintf("%s is not valid CSV: %s", filePath, err))
+			return nil, diags
+		}
+		line, _ := reader.FieldPos(0)
+
+		if len(record) != 2 {
+			diags.AddAttributeError(attrPath, "Invalid Menu CSV Row",
+				fmt.Sprintf("Line %d of %s should have 2 fields (item,price), got %d.", line, filePath, len(record)))
+			continue
+		}
+		item, priceText := strings.TrimSpace(record[0]), strings.TrimSpace(record[1])
+		if first && strings.EqualFold(item, "item") && strings.EqualFold(priceText, "price") {
+			continue
+		}
+
+		if _, ok := basePrices[item]; !ok {
+			diags.AddAttributeError(attrPath, "Unknown Menu CSV Item",
+				fmt.Sprintf("Line %d of %s: %q is not a priced item. Valid items are: %s", line, filePath, item, strings.Join(PriceKeys(), ", ")))
+			continue
+		}
+		if previous, ok := lines[item]; ok {
+			diags.AddAttributeError(attrPath, "Duplicate Menu CSV Item",
+				fmt.Sprintf("Line %d of %s prices %q again; it was already priced on line %d.", line, filePath, item, previous))
+			continue
+		}
+		price, ok := new(big.Float).SetString(priceText)
+		if !ok || price.Sign() < 0 {
+			diags.AddAttributeError(attrPath, "Invalid Menu CSV Price",
+				fmt.Sprintf("Line %d of %s: the price for %q must be a non-negative number of dollars, got %q.", line, filePath, item, priceText))
+			continue
+		}
+
+		prices[item] = price
+		lines[item] = line
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+	return prices, diags
+}
@@ -2,11 +2,13 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -18,6 +20,22 @@ func NewMenuDataSource() datasource.DataSource {
 	return &MenuDataSource{}
 }
 
+// menuItems are the pricing engine keys exposed by hw_menu.
+var menuItems = []string{
+	"sandwich",
+	"drink",
+	"soup",
+	"salad",
+	"cookie",
+	"brownie",
+	"stroopwafel",
+	"napkin",
+	"cracker",
+	"silverware",
+	"dogtreat_small",
+	"dogtreat_large",
+}
+
 // MenuDataSource defines the data source implementation.
 type MenuDataSource struct {
 	client *ProviderConfig
@@ -26,6 +44,7 @@ type MenuDataSource struct {
 // MenuDataSourceModel describes the data source data model.
 type MenuDataSourceModel struct {
 	Prices types.Object `tfsdk:"prices"`
+	Json   types.String `tfsdk:"json"`
 	Id     types.String `tfsdk:"id"`
 }
 
@@ -101,12 +120,21 @@ output "price_comparison" {
 output "all_prices" {
   value = data.hw_menu.pricing.prices
 }
+
+# Export the price list for external tools
+resource "local_file" "menu" {
+  filename = "menu.json"
+  content  = data.hw_menu.pricing.json
+}
 ` + "```" + `
 
 **Key Concepts:**
 - Demonstrates **nested object attributes** for pricing
 - Provides base prices for all menu items (before upcharge)
+- Honors the provider's ` + "`price_overrides`" + `, so repricing an item needs no code changes
+- Scales with the provider's ` + "`price_year`" + ` (inflation table, base year 2024)
 - Access prices with: ` + "`data.hw_menu.pricing.prices.sandwich`" + `
+- ` + "`json`" + ` holds the same price list after upcharge as canonical JSON: keys sorted, no whitespace, prices with two decimals
 - Useful for calculations and cost analysis
 
 *Prices listed clear,*
@@ -117,50 +145,62 @@ output "all_prices" {
 			"prices": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"sandwich": schema.NumberAttribute{
+						CustomType:          MoneyType{},
 						MarkdownDescription: "Base price of a sandwich",
 						Computed:            true,
 					},
 					"drink": schema.NumberAttribute{
+						CustomType:          MoneyType{},
 						MarkdownDescription: "Base price of a drink",
 						Computed:            true,
 					},
 					"soup": schema.NumberAttribute{
+						CustomType:          MoneyType{},
 						MarkdownDescription: "Base price of a soup",
 						Computed:            true,
 					},
 					"salad": schema.NumberAttribute{
+						CustomType:          MoneyType{},
 						MarkdownDescription: "Base price of a salad",
 						Computed:            true,
 					},
 					"cookie": schema.NumberAttribute{
+						CustomType:          MoneyType{},
 						MarkdownDescription: "Base price of a cookie",
 						Computed:            true,
 					},
 					"brownie": schema.NumberAttribute{
+						CustomType:          MoneyType{},
 						MarkdownDescription: "Base price of a brownie",
 						Computed:            true,
 					},
 					"stroopwafel": schema.NumberAttribute{
+						CustomType:          MoneyType{},
 						MarkdownDescription: "Base price of a stroopwafel",
 						Computed:            true,
 					},
 					"napkin": schema.NumberAttribute{
+						CustomType:          MoneyType{},
 						MarkdownDescription: "Base price per napkin",
 						Computed:            true,
 					},
 					"cracker": schema.NumberAttribute{
+						CustomType:          MoneyType{},
 						MarkdownDescription: "Base price per cracker pack",
 						Computed:            true,
 					},
 					"silverware": schema.NumberAttribute{
+						CustomType:          MoneyType{},
 						MarkdownDescription: "Base price per silverware pack",
 						Computed:            true,
 					},
 					"dogtreat_small": schema.NumberAttribute{
+						CustomType:          MoneyType{},
 						MarkdownDescription: "Base price of a small dog treat",
 						Computed:            true,
 					},
 					"dogtreat_large": schema.NumberAttribute{
+						CustomType:          MoneyType{},
 						MarkdownDescription: "Base price of a large dog treat",
 						Computed:            true,
 					},
@@ -168,6 +208,10 @@ output "all_prices" {
 				MarkdownDescription: "Base prices for all menu items (before upcharge)",
 				Computed:            true,
 			},
+			"json": schema.StringAttribute{
+				MarkdownDescription: "The price list after upcharge as canonical JSON (e.g., `{\"brownie\":3.50,...}`), keyed by item name",
+				Computed:            true,
+			},
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Data source identifier",
 				Computed:            true,
@@ -205,54 +249,23 @@ func (d *MenuDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	// Base prices (before upcharge)
-	basePrices := map[string]attr.Value{
-		"sandwich":      types.NumberValue(big.NewFloat(5.00)),
-		"drink":         types.NumberValue(big.NewFloat(1.00)),
-		"soup":          types.NumberValue(big.NewFloat(2.50)),
-		"salad":         types.NumberValue(big.NewFloat(4.00)),
-		"cookie":        types.NumberValue(big.NewFloat(1.50)),
-		"brownie":       types.NumberValue(big.NewFloat(2.00)),
-		"stroopwafel":   types.NumberValue(big.NewFloat(1.75)),
-		"napkin":        types.NumberValue(big.NewFloat(0.25)),
-		"cracker":       types.NumberValue(big.NewFloat(0.50)),
-		"silverware":    types.NumberValue(big.NewFloat(1.00)),
-		"dogtreat_small": types.NumberValue(big.NewFloat(1.00)),
-		"dogtreat_large": types.NumberValue(big.NewFloat(2.00)),
-	}
-
-	// Apply upcharge if provider config is available
-	if d.client != nil && d.client.Upcharge != nil && d.client.Upcharge.Sign() != 0 {
-		for key, basePrice := range basePrices {
-			base := basePrice.(types.Number).ValueBigFloat()
-			finalPrice := ApplyUpcharge(base, d.client.Upcharge)
-			basePrices[key] = types.NumberValue(finalPrice)
-		}
+	// Prices are fixed for the life of the provider, so build them once
+	prices, diags := cachedValue(catalogsOf(d.client), "menu/prices", d.prices)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	prices, diags := types.ObjectValue(
-		map[string]attr.Type{
-			"sandwich":      types.NumberType,
-			"drink":         types.NumberType,
-			"soup":          types.NumberType,
-			"salad":         types.NumberType,
-			"cookie":        types.NumberType,
-			"brownie":       types.NumberType,
-			"stroopwafel":   types.NumberType,
-			"napkin":        types.NumberType,
-			"cracker":       types.NumberType,
-			"silverware":    types.NumberType,
-			"dogtreat_small": types.NumberType,
-			"dogtreat_large": types.NumberType,
-		},
-		basePrices,
-	)
+	menuJson, diags := cachedValue(catalogsOf(d.client), "menu/json", func() (types.String, diag.Diagnostics) {
+		return menuPriceListJson(prices)
+	})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	data.Prices = prices
+	data.Json = menuJson
 	data.Id = types.StringValue("menu")
 
 	tflog.Trace(ctx, "read menu data source")
@@ -260,3 +273,42 @@ func (d *MenuDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// prices builds the menu's prices object: base prices from the pricing engine
+// (including any price_overrides) plus the provider's upcharge, rounded by
+// its rounding policy.
+func (d *MenuDataSource) prices() (types.Object, diag.Diagnostics) {
+	var upcharge *big.Float
+	if d.client != nil {
+		upcharge = d.client.Upcharge
+	}
+
+	prices := make(map[string]attr.Value, len(menuItems))
+	attrTypes := make(map[string]attr.Type, len(menuItems))
+	for _, key := range menuItems {
+		prices[key] = d.client.Price(ApplyUpcharge(d.client.BasePrice(key), upcharge))
+		attrTypes[key] = MoneyType{}
+	}
+
+	return types.ObjectValue(attrTypes, prices)
+}
+
+// menuPriceListJson renders the menu's prices object as canonical JSON: an
+// object keyed by item in sorted order, without whitespace, with every price
+// written to the cent.
+func menuPriceListJson(prices types.Object) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	priceList := make(map[string]json.Number, len(menuItems))
+	for key, price := range prices.Attributes() {
+		priceList[key] = json.Number(formatCents(price.(MoneyValue).Cents()))
+	}
+
+	// encoding/json writes map keys in sorted order
+	encoded, err := json.Marshal(priceList)
+	if err != nil {
+		diags.AddError("Unable to Render Menu", "The price list could not be encoded as JSON: "+err.Error())
+		return types.StringNull(), diags
+	}
+	return types.StringValue(string(encoded)), diags
+}
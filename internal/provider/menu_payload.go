@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// menuUrlBase is where a store's menu_url points: a made-up menu site that
+// decodes the payload in its m query parameter.
+const menuUrlBase = "https://menu.hashiwich.example/"
+
+// menuPayloadItem is an entry of the items in a menu payload.
+type menuPayloadItem struct {
+	Id    string      `json:"id"`
+	Item  string      `json:"item"`
+	Price json.Number `json:"price"`
+}
+
+// menuPayload is the document a store's menu_payload encodes.
+type menuPayload struct {
+	Store string            `json:"store"`
+	Items []menuPayloadItem `json:"items"`
+}
+
+// setMenuPayload sets the store's menu_payload and menu_url from its name and
+// menu_item_ids. The payload is canonical JSON, with items sorted by ID and
+// priced with the provider's upcharge, encoded as unpadded base64url, so the
+// same menu always gives the same payload. The store's ID must be set.
+func setMenuPayload(ctx context.Context, client *ProviderConfig, data *StoreResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var itemIds []string
+	if !data.MenuItemIds.IsNull() && !data.MenuItemIds.IsUnknown() {
+		diags.Append(data.MenuItemIds.ElementsAs(ctx, &itemIds, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	slices.Sort(itemIds)
+
+	payload := menuPayload{Store: data.Name.ValueString(), Items: []menuPayloadItem{}}
+	for _, itemId := range itemIds {
+		item, _ := client.TypeOfId(itemId, ticketItems...)
+		price := client.Price(ApplyUpcharge(client.BasePrice(item), client.Upcharge))
+		payload.Items = append(payload.Items, menuPayloadItem{
+			Id:    itemId,
+			Item:  item,
+			Price: json.Number(formatCents(price.Cents())),
+		})
+	}
+
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		diags.AddError("Unable to Encode Menu", "The store's menu could not be encoded as JSON: "+err.Error())
+		return diags
+	}
+	data.MenuPayload = types.StringValue(base64.RawURLEncoding.EncodeToString(encoded))
+	data.MenuUrl = types.StringValue(menuUrlBase + data.Id.ValueString() + "?m=" + data.MenuPayload.ValueString())
+	return diags
+}
@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// metered wraps a resource constructor so the resource's CRUD operations are
+// counted and timed in the provider's Metrics, its successful changes
+// recorded in the provider's event log, and its deleted record kept in the
+// registry's trash when the provider has a trash_retention. It also plans
+// every resource's tags_all from its tags and the provider's default_tags,
+// writes its haiku, and, with replace_on_kind_change, plans a replacement
+// when a resource's kind, size or style changes. The wrapper forwards the
+// optional interfaces the provider's resources implement: Configure,
+// ImportState and, where the resource has it, UpgradeState.
+//
+// With the provider's backend_path set, the wrapper also stores every
+// resource's state in the backend after each change and refresh, and removes
+// resources whose record is gone from state on Read.
+func metered(newResource func() resource.Resource) func() resource.Resource {
+	return func() resource.Resource {
+		inner := newResource()
+
+		// Resource instances are created per request without a Metadata
+		// call, so resolve the type name up front
+		var metadata resource.MetadataResponse
+		inner.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "hw"}, &metadata)
+
+		m := &meteredResource{Resource: inner, typeName: metadata.TypeName}
+		if _, ok := inner.(resource.ResourceWithUpgradeState); ok {
+			return &meteredResourceWithUpgradeState{meteredResource: m}
+		}
+		return m
+	}
+}
+
+var _ resource.ResourceWithConfigure = &meteredResource{}
+var _ resource.ResourceWithImportState = &meteredResource{}
+var _ resource.ResourceWithModifyPlan = &meteredResource{}
+var _ resource.ResourceWithUpgradeState = &meteredResourceWithUpgradeState{}
+
+type meteredResource struct {
+	resource.Resource
+	typeName string
+	metrics  *Metrics
+	events   *EventLog
+	registry *Registry
+	// trashRetention is how long deleted records stay in the trash; zero
+	// deletes them outright
+	trashRetention time.Duration
+	defaultTags    map[string]string
+	// replaceOnKindChange replaces, rather than updates, a resource whose
+	// kindAttributes change
+	replaceOnKindChange bool
+	emitHaikus          bool
+}
+
+// kindAttributes are the attributes saying what a resource is, rather than
+// how it is set up, which replace_on_kind_change makes replace the resource.
+var kindAttributes = []string{"kind", "size", "style"}
+
+type meteredResourceWithUpgradeState struct {
+	*meteredResource
+}
+
+func (r *meteredResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if config, ok := req.ProviderData.(*ProviderConfig); ok {
+		r.metrics = config.Metrics
+		r.events = config.Events
+		r.registry = config.Registry
+		r.trashRetention = config.TrashRetention
+		r.defaultTags = config.DefaultTags
+		r.replaceOnKindChange = config.ReplaceOnKindChange
+		r.emitHaikus = config.EmitHaikus
+	}
+	if inner, ok := r.Resource.(resource.ResourceWithConfigure); ok {
+		inner.Configure(ctx, req, resp)
+	}
+}
+
+func (r *meteredResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer r.metrics.Track(r.typeName, "create")()
+	r.Resource.Create(ctx, req, resp)
+	r.writeHaiku(ctx, &resp.State, &resp.Diagnostics)
+	r.save(ctx, resp.State, &resp.Diagnostics)
+	r.logEvent(ctx, "create", resp.State, &resp.Diagnostics)
+}
+
+func (r *meteredResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer r.metrics.Track(r.typeName, "read")()
+
+	// A record gone from the backend means the resource was deleted outside
+	// Terraform: drop it from state, so the next plan creates it again
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if r.registry.Missing(id.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.Resource.Read(ctx, req, resp)
+	r.writeHaiku(ctx, &resp.State, &resp.Diagnostics)
+	r.save(ctx, resp.State, &resp.Diagnostics)
+}
+
+func (r *meteredResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer r.metrics.Track(r.typeName, "update")()
+	r.Resource.Update(ctx, req, resp)
+	r.writeHaiku(ctx, &resp.State, &resp.Diagnostics)
+	r.save(ctx, resp.State, &resp.Diagnostics)
+	r.logEvent(ctx, "update", resp.State, &resp.Diagnostics)
+}
+
+func (r *meteredResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer r.metrics.Track(r.typeName, "delete")()
+
+	// Look the record up before the resource removes it, to keep it in the
+	// trash
+	var id types.String
+	req.State.GetAttribute(ctx, path.Root("id"), &id)
+	record, hasRecord := LookupRecord[any](r.registry, id.ValueString())
+	// A backend may hold a record this process never read, which it keeps
+	// in the trash itself
+	hasRecord = hasRecord || !r.registry.Missing(id.ValueString()) && r.registry.Persistent()
+
+	r.Resource.Delete(ctx, req, resp)
+	if hasRecord && r.trashRetention > 0 && !resp.Diagnostics.HasError() {
+		r.registry.Trash(id.ValueString(), r.typeName, record, r.trashRetention)
+	}
+	if !resp.Diagnostics.HasError() {
+		r.registry.Delete(id.ValueString())
+	}
+	r.flush(&resp.Diagnostics)
+	r.logEvent(ctx, "delete", req.State, &resp.Diagnostics)
+}
+
+// ModifyPlan plans tags_all, so changes to default_tags show in the plan, and
+// a null haiku without emit_haikus, and marks changed kindAttributes as
+// requiring replacement under replace_on_kind_change.
+func (r *meteredResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to tag when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var tags types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), mergeTags(r.defaultTags, tags))...)
+
+	// With emit_haikus, a changed resource gets its haiku after apply
+	if !r.emitHaikus {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("haiku"), types.StringNull())...)
+	}
+
+	// Nothing to replace while creating
+	if !r.replaceOnKindChange || req.State.Raw.IsNull() {
+		return
+	}
+	for _, name := range kindAttributes {
+		attributePath := path.Root(name)
+		if _, diags := req.Plan.Schema.AttributeAtPath(ctx, attributePath); diags.HasError() {
+			continue
+		}
+
+		var planned, prior types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, attributePath, &planned)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, attributePath, &prior)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !planned.Equal(prior) {
+			resp.RequiresReplace = append(resp.RequiresReplace, attributePath)
+		}
+	}
+}
+
+func (r *meteredResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	inner, ok := r.Resource.(resource.ResourceWithImportState)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Import Not Supported",
+			r.typeName+" does not support import.",
+		)
+		return
+	}
+	inner.ImportState(ctx, req, resp)
+}
+
+func (r *meteredResourceWithUpgradeState) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return r.Resource.(resource.ResourceWithUpgradeState).UpgradeState(ctx)
+}
+
+// writeHaiku sets the haiku in state, the resource's new state after a
+// successful operation: written from its attributes with emit_haikus, null
+// otherwise.
+func (r *meteredResource) writeHaiku(ctx context.Context, state *tfsdk.State, diags *diag.Diagnostics) {
+	if diags.HasError() || state.Raw.IsNull() {
+		return
+	}
+
+	haiku := types.StringNull()
+	if r.emitHaikus {
+		text, err := resourceHaiku(r.typeName, state.Raw)
+		if err != nil {
+			diags.AddError(
+				"Unable to Write Haiku",
+				fmt.Sprintf("The haiku of %s could not be written: %s", r.typeName, err),
+			)
+			return
+		}
+		haiku = types.StringValue(text)
+	}
+	diags.Append(state.SetAttribute(ctx, path.Root("haiku"), haiku)...)
+}
+
+// save stores state, the resource's new state after a successful operation,
+// in the registry's backend and flushes it. It does nothing without a
+// backend.
+func (r *meteredResource) save(ctx context.Context, state tfsdk.State, diags *diag.Diagnostics) {
+	if diags.HasError() {
+		return
+	}
+
+	var id types.String
+	diags.Append(state.GetAttribute(ctx, path.Root("id"), &id)...)
+	r.registry.PutState(id.ValueString(), r.typeName, state.Raw)
+	r.flush(diags)
+}
+
+// flush writes the backend's changes after a successful operation. Failing
+// to write them fails the operation, as a failed backend call would.
+func (r *meteredResource) flush(diags *diag.Diagnostics) {
+	if diags.HasError() {
+		return
+	}
+
+	if err := r.registry.Flush(); err != nil {
+		diags.AddError(
+			"Unable to Save Record",
+			fmt.Sprintf("The record of %s could not be written to the backend: %s", r.typeName, err),
+		)
+	}
+}
+
+// logEvent records a successful action on the resource whose state is state
+// in the event log. Failing to write the log is only a warning: the change
+// itself has been made.
+func (r *meteredResource) logEvent(ctx context.Context, action string, state tfsdk.State, diags *diag.Diagnostics) {
+	if r.events == nil || diags.HasError() {
+		return
+	}
+
+	var id types.String
+	diags.Append(state.GetAttribute(ctx, path.Root("id"), &id)...)
+	err := r.events.Append(LifecycleEvent{
+		ResourceType: r.typeName,
+		Action:       action,
+		Id:           id.ValueString(),
+	})
+	if err != nil {
+		diags.AddWarning(
+			"Unable to Write Event Log",
+			fmt.Sprintf("The %s of %s %q succeeded but was not logged: %s", action, r.typeName, id.ValueString(), err),
+		)
+	}
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// operations are the CRUD operations the metrics collector counts, in the
+// order they are reported.
+var operations = []string{"create", "read", "update", "delete"}
+
+// Metrics counts the CRUD operations the provider performs and how long they
+// take, per resource type. It lives as long as the provider process, so it
+// covers a single plan or apply.
+type Metrics struct {
+	mu    sync.Mutex
+	stats map[metricsKey]*operationTimes
+}
+
+type metricsKey struct {
+	resourceType string
+	operation    string
+}
+
+type operationTimes struct {
+	count int64
+	total time.Duration
+	max   time.Duration
+}
+
+// OperationStats summarizes one operation on one resource type.
+type OperationStats struct {
+	ResourceType string  `json:"resource_type"`
+	Operation    string  `json:"operation"`
+	Count        int64   `json:"count"`
+	TotalMs      float64 `json:"total_ms"`
+	AverageMs    float64 `json:"average_ms"`
+	MaxMs        float64 `json:"max_ms"`
+}
+
+// NewMetrics returns an empty metrics collector.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		stats: map[metricsKey]*operationTimes{},
+	}
+}
+
+// Track starts timing operation on resourceType and returns the function
+// that stops the timer and records it, meant to be deferred. It is safe to
+// call on a nil collector.
+func (m *Metrics) Track(resourceType, operation string) func() {
+	if m == nil {
+		return func() {}
+	}
+
+	start := time.Now()
+	return func() {
+		elapsed := time.Since(start)
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		key := metricsKey{resourceType: resourceType, operation: operation}
+		times, ok := m.stats[key]
+		if !ok {
+			times = &operationTimes{}
+			m.stats[key] = times
+		}
+		times.count++
+		times.total += elapsed
+		times.max = max(times.max, elapsed)
+	}
+}
+
+// Snapshot returns the stats recorded so far, sorted by resource type and
+// then by operation in CRUD order.
+func (m *Metrics) Snapshot() []OperationStats {
+	if m == nil {
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshot := make([]OperationStats, 0, len(m.stats))
+	for key, times := range m.stats {
+		snapshot = append(snapshot, OperationStats{
+			ResourceType: key.resourceType,
+			Operation:    key.operation,
+			Count:        times.count,
+			TotalMs:      milliseconds(times.total),
+			AverageMs:    milliseconds(times.total / time.Duration(times.count)),
+			MaxMs:        milliseconds(times.max),
+		})
+	}
+	slices.SortFunc(snapshot, func(a, b OperationStats) int {
+		if c := strings.Compare(a.ResourceType, b.ResourceType); c != 0 {
+			return c
+		}
+		return slices.Index(operations, a.Operation) - slices.Index(operations, b.Operation)
+	})
+	return snapshot
+}
+
+// milliseconds converts d to fractional milliseconds.
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// WriteJSON writes the snapshot to path as an indented JSON array.
+func (m *Metrics) WriteJSON(path string) error {
+	out, err := json.MarshalIndent(m.Snapshot(), "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(out, '\n'), 0o644)
+}
+
+// metricsDumps are the metrics_file paths of the configured providers, keyed
+// by their collectors, for Shutdown to write.
+var metricsDumps = struct {
+	mu    sync.Mutex
+	paths map[*Metrics]string
+}{paths: map[*Metrics]string{}}
+
+// dumpMetricsOnShutdown arranges for Shutdown to write m to path.
+func dumpMetricsOnShutdown(m *Metrics, path string) {
+	metricsDumps.mu.Lock()
+	defer metricsDumps.mu.Unlock()
+	metricsDumps.paths[m] = path
+}
+
+// Shutdown writes the metrics of every provider configured with a
+// metrics_file. The provider server calls it once it has stopped serving.
+func Shutdown() error {
+	metricsDumps.mu.Lock()
+	defer metricsDumps.mu.Unlock()
+
+	var errs []error
+	for m, path := range metricsDumps.paths {
+		if err := m.WriteJSON(path); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	clear(metricsDumps.paths)
+	return errors.Join(errs...)
+}
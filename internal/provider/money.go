@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.NumberTypable = MoneyType{}
+var _ basetypes.NumberValuableWithSemanticEquals = MoneyValue{}
+
+// MoneyType is the attribute type of dollar amounts. Money is still a number
+// in Terraform, so attributes can switch to it without a state upgrade, but
+// values are held as whole cents: NewMoneyValue rounds to the nearest cent,
+// and amounts that round to the same cent are semantically equal, so float
+// noise such as 4.4999999 never shows up as a diff. Only prices computed with
+// the provider's rounding = "none" keep fractions of a cent.
+type MoneyType struct {
+	basetypes.NumberType
+}
+
+func (t MoneyType) Equal(o attr.Type) bool {
+	_, ok := o.(MoneyType)
+	return ok
+}
+
+func (t MoneyType) String() string {
+	return "MoneyType"
+}
+
+func (t MoneyType) ValueFromNumber(ctx context.Context, in basetypes.NumberValue) (basetypes.NumberValuable, diag.Diagnostics) {
+	return MoneyValue{NumberValue: in}, nil
+}
+
+func (t MoneyType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.NumberType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	numberValue, ok := attrValue.(basetypes.NumberValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return MoneyValue{NumberValue: numberValue}, nil
+}
+
+func (t MoneyType) ValueType(ctx context.Context) attr.Value {
+	return MoneyValue{}
+}
+
+// MoneyValue is a dollar amount of a MoneyType attribute.
+type MoneyValue struct {
+	basetypes.NumberValue
+}
+
+// NewMoneyValue returns dollars rounded to the nearest cent.
+func NewMoneyValue(dollars *big.Float) MoneyValue {
+	return NewMoneyCents(toCents(dollars))
+}
+
+// NewMoneyCents returns an amount of whole cents.
+func NewMoneyCents(cents int64) MoneyValue {
+	return MoneyValue{NumberValue: basetypes.NewNumberValue(centsValue(cents))}
+}
+
+// NewMoneyExact returns dollars as they are, without rounding to the cent,
+// for the provider's rounding = "none".
+func NewMoneyExact(dollars *big.Float) MoneyValue {
+	return MoneyValue{NumberValue: basetypes.NewNumberValue(new(big.Float).Copy(dollars))}
+}
+
+// NewMoneyNull returns a null amount.
+func NewMoneyNull() MoneyValue {
+	return MoneyValue{NumberValue: basetypes.NewNumberNull()}
+}
+
+// NewMoneyUnknown returns an amount that is not yet known.
+func NewMoneyUnknown() MoneyValue {
+	return MoneyValue{NumberValue: basetypes.NewNumberUnknown()}
+}
+
+func (v MoneyValue) Equal(o attr.Value) bool {
+	other, ok := o.(MoneyValue)
+	if !ok {
+		return false
+	}
+	return v.NumberValue.Equal(other.NumberValue)
+}
+
+func (v MoneyValue) Type(ctx context.Context) attr.Type {
+	return MoneyType{}
+}
+
+// NumberSemanticEquals reports whether both amounts round to the same cent.
+func (v MoneyValue) NumberSemanticEquals(ctx context.Context, newValuable basetypes.NumberValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(MoneyValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got %T. Please report this to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	if v.IsNull() || v.IsUnknown() || newValue.IsNull() || newValue.IsUnknown() {
+		return v.Equal(newValue), diags
+	}
+	return v.Cents() == newValue.Cents(), diags
+}
+
+// Cents returns the amount in whole cents, or 0 when it is null or unknown.
+func (v MoneyValue) Cents() int64 {
+	if v.IsNull() || v.IsUnknown() {
+		return 0
+	}
+	return toCents(v.ValueBigFloat())
+}
+
+// String formats the amount in dollars with two decimals, e.g. "4.50".
+func (v MoneyValue) String() string {
+	if v.IsNull() || v.IsUnknown() {
+		return v.NumberValue.String()
+	}
+	return formatCents(v.Cents())
+}
+
+// toCents converts a dollar amount to whole cents, rounding to the nearest.
+func toCents(dollars *big.Float) int64 {
+	f, _ := dollars.Float64()
+	return int64(math.Round(f * 100))
+}
+
+// formatCents formats whole cents as dollars with two decimals.
+func formatCents(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+// centsValue converts whole cents to a dollar amount.
+func centsValue(cents int64) *big.Float {
+	value, _ := new(big.Float).SetString(formatCents(cents))
+	return value
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MusicCatalogDataSource{}
+
+func NewMusicCatalogDataSource() datasource.DataSource {
+	return &MusicCatalogDataSource{}
+}
+
+// MusicCatalogDataSource defines the data source implementation.
+type MusicCatalogDataSource struct {
+	client any
+}
+
+// MusicCatalogDataSourceModel describes the data source data model.
+type MusicCatalogDataSourceModel struct {
+	Genres types.List   `tfsdk:"genres"`
+	Id     types.String `tfsdk:"id"`
+}
+
+// genreAmbiance is the music catalog: each genre the store's sound system
+// can play, with the ambiance points it adds to a store.
+var genreAmbiance = map[string]int64{
+	"acoustic":     8,
+	"classical":    10,
+	"jazz":         12,
+	"lofi":         10,
+	"metal":        -5,
+	"polka":        15,
+	"pop":          6,
+	"rock":         4,
+	"sea_shanties": 9,
+}
+
+// MusicGenres returns the sorted genres in the music catalog.
+func MusicGenres() []string {
+	genres := make([]string, 0, len(genreAmbiance))
+	for genre := range genreAmbiance {
+		genres = append(genres, genre)
+	}
+	slices.Sort(genres)
+	return genres
+}
+
+func (d *MusicCatalogDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_music_catalog"
+}
+
+func (d *MusicCatalogDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The genres the store's sound system can play. ` + "`hw_music_playlist`" + ` only accepts genres from this catalog, so use it to build playlists that are valid by construction.
+
+**Example Usage:**
+
+` + "```hcl" + `
+data "hw_music_catalog" "all" {}
+
+# Everything except metal
+resource "hw_music_playlist" "mellow" {
+  store_id = hw_store.main.id
+  genres   = [for g in data.hw_music_catalog.all.genres : g if g != "metal"]
+  volume   = 4
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates a **catalog data source** that a resource validates against
+- Returns the genres in alphabetical order
+- No input parameters required
+
+*Needle finds the groove,*
+*Shelves of records, A to Z,*
+*Pick a song for lunch.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"genres": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "List of genres in the catalog, in alphabetical order",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *MusicCatalogDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData
+}
+
+func (d *MusicCatalogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MusicCatalogDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	genres, diags := cachedValue(catalogsOf(d.client), "music_catalog/genres", func() (types.List, diag.Diagnostics) {
+		return types.ListValueFrom(ctx, types.StringType, MusicGenres())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Genres = genres
+	data.Id = types.StringValue("music-catalog")
+
+	tflog.Trace(ctx, "read music catalog data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,300 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &MusicPlaylistResource{}
+var _ resource.ResourceWithImportState = &MusicPlaylistResource{}
+
+func NewMusicPlaylistResource() resource.Resource {
+	return &MusicPlaylistResource{}
+}
+
+type MusicPlaylistResource struct {
+	client *ProviderConfig
+}
+
+type MusicPlaylistResourceModel struct {
+	StoreId       types.String `tfsdk:"store_id"`
+	Genres        types.List   `tfsdk:"genres"`
+	Volume        types.Int64  `tfsdk:"volume"`
+	AmbianceScore types.Number `tfsdk:"ambiance_score"`
+	Tags          types.Map    `tfsdk:"tags"`
+	TagsAll       types.Map    `tfsdk:"tags_all"`
+	Haiku         types.String `tfsdk:"haiku"`
+	Id            types.String `tfsdk:"id"`
+}
+
+// Ambiance scoring: a silent store scores 50, each distinct genre adds its
+// catalog points, and every step of volume away from the sweet spot of 5
+// costs 5 points. Scores are clamped to 0-100.
+const (
+	baseAmbianceScore   = 50
+	idealVolume         = 5
+	volumePenaltyPoints = 5
+	maxVolume           = 10
+)
+
+func (r *MusicPlaylistResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_music_playlist"
+}
+
+func (r *MusicPlaylistResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `What's playing over the speakers while customers eat. A playlist mixes genres from the ` + "`hw_music_catalog`" + ` at a chosen volume, and the store it plays in takes on its ` + "`ambiance_score`" + `.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_music_playlist" "lunch" {
+  store_id = hw_store.main.id
+  genres   = ["jazz", "lofi"]
+  volume   = 4
+  # ambiance_score computed as 67 (50 + 12 + 10 - 5)
+}
+
+output "vibe" {
+  value = hw_store.main.ambiance_score
+}
+` + "```" + `
+
+**Key Concepts:**
+- Validates a list attribute against a **catalog data source** (` + "`hw_music_catalog`" + `)
+- ` + "`ambiance_score`" + ` starts at 50 and adds each genre's points (polka 15, jazz 12, classical and lofi 10, sea_shanties 9, acoustic 8, pop 6, rock 4, metal -5)
+- Every step of ` + "`volume`" + ` away from 5 costs 5 points; the score stays within 0-100
+- The store's ` + "`ambiance_score`" + ` is the best of its playlists' scores. Terraform reads the store first, so the store only learns of its playlists from the provider's ` + "`backend_path`" + `, and with one set it picks up changes on the next refresh
+
+*Soft jazz, clinking plates,*
+*The volume knob at four,*
+*Even the bread hums.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_store the playlist plays in",
+				Required:            true,
+			},
+			"genres": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Genres on the playlist, from the `hw_music_catalog` data source (at least one)",
+				Required:            true,
+			},
+			"volume": schema.Int64Attribute{
+				MarkdownDescription: "Volume from 0 (silent) to 10 (deafening). 5 is just right",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Volume", min: 0, max: maxVolume},
+				},
+			},
+			"ambiance_score": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "How pleasant the playlist makes the store, from 0 to 100",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Playlist identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *MusicPlaylistResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *MusicPlaylistResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MusicPlaylistResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setAmbianceScore(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := r.client.NewId("playlist", fmt.Sprintf("%s-%d", r.client.KindFromId(data.StoreId.ValueString(), "store"), len(data.Genres.Elements())))
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created a music playlist resource", map[string]any{
+		"id":             data.Id.ValueString(),
+		"ambiance_score": data.AmbianceScore.ValueBigFloat().String(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MusicPlaylistResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MusicPlaylistResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate the ambiance score
+	resp.Diagnostics.Append(setAmbianceScore(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MusicPlaylistResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MusicPlaylistResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate the ambiance score
+	resp.Diagnostics.Append(setAmbianceScore(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state MusicPlaylistResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.StoreId.Equal(state.StoreId) || len(data.Genres.Elements()) != len(state.Genres.Elements()) {
+		id := r.client.NewId("playlist", fmt.Sprintf("%s-%d", r.client.KindFromId(data.StoreId.ValueString(), "store"), len(data.Genres.Elements())))
+		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MusicPlaylistResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MusicPlaylistResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted a music playlist resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *MusicPlaylistResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setAmbianceScore validates the playlist's genres against the music catalog,
+// then computes ambiance_score.
+func setAmbianceScore(ctx context.Context, data *MusicPlaylistResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var genres []string
+	diags.Append(data.Genres.ElementsAs(ctx, &genres, false)...)
+	if diags.HasError() {
+		return diags
+	}
+	if len(genres) == 0 {
+		diags.AddAttributeError(
+			path.Root("genres"),
+			"Empty Playlist",
+			"A playlist needs at least one genre from the hw_music_catalog data source.",
+		)
+	}
+
+	score := int64(baseAmbianceScore)
+	var seen []string
+	for i, genre := range genres {
+		points, ok := genreAmbiance[genre]
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("genres").AtListIndex(i),
+				"Unknown Genre",
+				fmt.Sprintf("%q is not in the music catalog. Catalog genres: %s.", genre, strings.Join(MusicGenres(), ", ")),
+			)
+			continue
+		}
+		if slices.Contains(seen, genre) {
+			continue
+		}
+		seen = append(seen, genre)
+		score += points
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	level := data.Volume.ValueInt64()
+	score -= volumePenaltyPoints * max(level-idealVolume, idealVolume-level)
+	score = min(max(score, 0), 100)
+
+	data.AmbianceScore = types.NumberValue(big.NewFloat(float64(score)))
+	return diags
+}
+
+// storeAmbianceScore returns the best ambiance_score of the store's playlists
+// known to the registry. It reports false when none are known.
+func storeAmbianceScore(registry *Registry, storeId string) (*big.Float, bool) {
+	var best *big.Float
+	for _, playlist := range ListRecords[MusicPlaylistResourceModel](registry) {
+		if playlist.StoreId.ValueString() != storeId || playlist.AmbianceScore.IsNull() {
+			continue
+		}
+		if score := playlist.AmbianceScore.ValueBigFloat(); best == nil || score.Cmp(best) > 0 {
+			best = score
+		}
+	}
+	return best, best != nil
+}
@@ -3,13 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
-	"math/big"
+	"math"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -17,6 +18,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &NapkinResource{}
 var _ resource.ResourceWithImportState = &NapkinResource{}
+var _ resource.ResourceWithUpgradeState = &NapkinResource{}
 
 func NewNapkinResource() resource.Resource {
 	return &NapkinResource{}
@@ -29,10 +31,18 @@ type NapkinResource struct {
 
 // NapkinResourceModel describes the resource data model.
 type NapkinResourceModel struct {
-	Description types.String `tfsdk:"description"`
-	Quantity    types.Number `tfsdk:"quantity"`
-	Price       types.Number `tfsdk:"price"`
-	Id          types.String `tfsdk:"id"`
+	Description     types.String `tfsdk:"description"`
+	Quantity        types.Int64  `tfsdk:"quantity"`
+	Price           MoneyValue   `tfsdk:"price"`
+	UnitPrice       MoneyValue   `tfsdk:"unit_price"`
+	Subtotal        MoneyValue   `tfsdk:"subtotal"`
+	Discount        MoneyValue   `tfsdk:"discount"`
+	Total           MoneyValue   `tfsdk:"total"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
 }
 
 func (r *NapkinResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -41,6 +51,8 @@ func (r *NapkinResource) Metadata(ctx context.Context, req resource.MetadataRequ
 
 func (r *NapkinResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		// Version 1 made quantity a whole number
+		Version: 1,
 		MarkdownDescription: `A humble but essential resource that demonstrates numeric attributes and simple computations. Sometimes the smallest resources teach the biggest lessons about Terraform fundamentals.
 
 **Example Usage:**
@@ -74,13 +86,24 @@ output "napkin_total_cost" {
   value = hw_napkin.bulk_order.price
   description = "Total cost for bulk napkin order"
 }
+
+# Show the bulk discount breakdown
+output "napkin_breakdown" {
+  value = {
+    unit_price = hw_napkin.bulk_order.unit_price
+    subtotal   = hw_napkin.bulk_order.subtotal
+    discount   = hw_napkin.bulk_order.discount
+    total      = hw_napkin.bulk_order.total
+  }
+}
 ` + "```" + `
 
 **Key Concepts:**
 - Demonstrates **numeric attributes** (quantity)
 - Shows **computed pricing** ($0.25 per napkin)
 - Simple resource perfect for learning basics
-- Price = quantity × $0.25
+- Itemizes ` + "`unit_price`" + `, ` + "`subtotal`" + `, ` + "`discount`" + ` and ` + "`total`" + `
+- Bulk discount: 5% from 25 napkins, 10% from 50, 15% from 100
 
 *Soft paper squares,*
 *Ready for messy hands,*
@@ -91,14 +114,45 @@ output "napkin_total_cost" {
 				MarkdownDescription: "A description of the napkin resource",
 				Optional:            true,
 			},
-			"quantity": schema.NumberAttribute{
+			"quantity": schema.Int64Attribute{
 				MarkdownDescription: "The number of napkins",
 				Required:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Quantity", min: 1, max: math.MaxInt64},
+				},
 			},
 			"price": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The total price of the napkins in dollars (same as `total`)",
+			},
+			"unit_price": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The base price per napkin in dollars ($0.25 unless overridden)",
+			},
+			"subtotal": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The undiscounted price in dollars (quantity × unit_price)",
+			},
+			"discount": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The bulk discount in dollars (5% from 25 units, 10% from 50, 15% from 100)",
+			},
+			"total": schema.NumberAttribute{
+				CustomType:          MoneyType{},
 				Computed:            true,
-				MarkdownDescription: "The price of the napkins in dollars (hardcoded to $0.25 per napkin)",
+				MarkdownDescription: "The final price in dollars (subtotal - discount + upcharge)",
 			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Napkin identifier",
@@ -140,23 +194,21 @@ func (r *NapkinResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Simulate API delay
 
-	// Calculate base price: $0.25 per napkin, then apply upcharge
-	quantity := data.Quantity.ValueBigFloat()
-	pricePerNapkin := big.NewFloat(0.25)
-	var basePrice big.Float
-	basePrice.Mul(quantity, pricePerNapkin)
-	finalPrice := ApplyUpcharge(&basePrice, r.client.Upcharge)
-	data.Price = types.NumberValue(finalPrice)
+	// Itemize price: $0.25 per napkin, less any bulk discount, then apply upcharge
+	quantity := data.Quantity.ValueInt64()
+	r.setPrices(&data)
 
 	// Mock resource creation - generate a fake ID
-	id := fmt.Sprintf("napkin-qty-%s", quantity.Text('f', 0))
+	id := r.client.NewId("napkin", fmt.Sprintf("qty-%d", quantity))
 	data.Id = types.StringValue(id)
 
 	tflog.Trace(ctx, "created a napkin resource", map[string]any{
 		"id":       data.Id.ValueString(),
-		"quantity": data.Quantity.ValueBigFloat().String(),
+		"quantity": data.Quantity.ValueInt64(),
 	})
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -174,15 +226,13 @@ func (r *NapkinResource) Read(ctx context.Context, req resource.ReadRequest, res
 	// Simulate API delay
 
 	// Recalculate price based on quantity
-	quantity := data.Quantity.ValueBigFloat()
-	pricePerNapkin := big.NewFloat(0.25)
-	var totalPrice big.Float
-	totalPrice.Mul(quantity, pricePerNapkin)
-	data.Price = types.NumberValue(&totalPrice)
+	r.setPrices(&data)
 
 	// Mock resource read - just return the existing state
 	// In a real implementation, this would fetch from an API
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -200,11 +250,8 @@ func (r *NapkinResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Simulate API delay
 
 	// Recalculate price based on quantity
-	quantity := data.Quantity.ValueBigFloat()
-	pricePerNapkin := big.NewFloat(0.25)
-	var totalPrice big.Float
-	totalPrice.Mul(quantity, pricePerNapkin)
-	data.Price = types.NumberValue(&totalPrice)
+	quantity := data.Quantity.ValueInt64()
+	r.setPrices(&data)
 
 	// Mock resource update
 	var state NapkinResourceModel
@@ -215,12 +262,14 @@ func (r *NapkinResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	// Keep existing ID unless quantity changed significantly
 	if !data.Quantity.Equal(state.Quantity) {
-		id := fmt.Sprintf("napkin-qty-%s", quantity.Text('f', 0))
+		id := r.client.NewId("napkin", fmt.Sprintf("qty-%d", quantity))
 		data.Id = types.StringValue(id)
 	} else {
 		data.Id = state.Id
 	}
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -243,6 +292,25 @@ func (r *NapkinResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	})
 }
 
+func (r *NapkinResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: numberToInt64Upgrader("quantity"),
+	}
+}
+
 func (r *NapkinResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// setPrices itemizes the napkin price for the configured quantity: the
+// per-napkin price from the pricing engine ($0.25 by default), the subtotal,
+// the bulk discount and the total including upcharge. Price mirrors the total.
+func (r *NapkinResource) setPrices(data *NapkinResourceModel) {
+	bulk := r.client.BulkPriceFor("napkin", data.Quantity.ValueInt64())
+	data.UnitPrice = NewMoneyValue(bulk.UnitPrice)
+	data.Subtotal = NewMoneyValue(bulk.Subtotal)
+	data.Discount = NewMoneyValue(bulk.Discount)
+	data.Total = r.client.Price(bulk.Total)
+	data.Price = r.client.Price(bulk.Total)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+}
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &OccupancyPermitResource{}
+var _ resource.ResourceWithImportState = &OccupancyPermitResource{}
+
+func NewOccupancyPermitResource() resource.Resource {
+	return &OccupancyPermitResource{}
+}
+
+type OccupancyPermitResource struct {
+	client *ProviderConfig
+}
+
+type OccupancyPermitResourceModel struct {
+	StoreId         types.String `tfsdk:"store_id"`
+	MaxOccupancy    types.Int64  `tfsdk:"max_occupancy"`
+	Cost            MoneyValue   `tfsdk:"cost"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
+}
+
+func (r *OccupancyPermitResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_occupancy_permit"
+}
+
+func (r *OccupancyPermitResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The framed certificate by the door that says how many people the store may hold. A closed store can't be set to ` + "`status = \"open\"`" + ` without a permit for more people than its ` + "`customers_per_hour`" + ` capacity.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_store" "main" {
+  # ...
+  # Start closed, then set status = "open" once the permit exists
+  status = "closed"
+}
+
+resource "hw_occupancy_permit" "main" {
+  store_id      = hw_store.main.id
+  max_occupancy = 60
+  # cost computed as $150
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates a **compliance prerequisite** enforced through the provider's registry: the store looks up its permits when it opens
+- The permit needs the store's ID, so a new store starts ` + "`closed`" + ` and opens in a later apply, once the permit exists
+- The store checks its permits only when it goes from ` + "`closed`" + ` to open, either by setting ` + "`status = \"open\"`" + ` or by unsetting ` + "`status`" + `; stores created open, or already open, aren't checked again
+- Without the provider's ` + "`backend_path`" + `, the store only sees the permits read or changed in the same apply, so finding none is a warning; with it, a missing permit is an error
+- With several permits for a store, the one with the highest ` + "`max_occupancy`" + ` counts
+
+*Stamped and signed and framed,*
+*Sixty souls and not one more,*
+*The doors may now swing.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_store the permit is issued to",
+				Required:            true,
+			},
+			"max_occupancy": schema.Int64Attribute{
+				MarkdownDescription: "Most people the store may hold at once (at least 1). Must exceed the store's `customers_per_hour` for it to open",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Max Occupancy", min: 1, max: math.MaxInt64},
+				},
+			},
+			"cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Permit fee in dollars ($150)",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Occupancy permit identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OccupancyPermitResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *OccupancyPermitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OccupancyPermitResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setCost(&data)
+
+	store := r.client.KindFromId(data.StoreId.ValueString(), "store")
+	id := r.client.NewId("permit", fmt.Sprintf("%s-%d", store, len(store)))
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created an occupancy permit resource", map[string]any{
+		"id":            data.Id.ValueString(),
+		"max_occupancy": data.MaxOccupancy.ValueInt64(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OccupancyPermitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OccupancyPermitResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost
+	r.setCost(&data)
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OccupancyPermitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OccupancyPermitResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state OccupancyPermitResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost
+	r.setCost(&data)
+
+	if !data.StoreId.Equal(state.StoreId) {
+		store := r.client.KindFromId(data.StoreId.ValueString(), "store")
+		id := r.client.NewId("permit", fmt.Sprintf("%s-%d", store, len(store)))
+		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OccupancyPermitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OccupancyPermitResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted an occupancy permit resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *OccupancyPermitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setCost computes the permit fee.
+func (r *OccupancyPermitResource) setCost(data *OccupancyPermitResourceModel) {
+	data.Cost = r.client.Price(ApplyUpcharge(r.client.BasePrice("occupancy_permit"), r.client.Upcharge))
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+}
+
+// checkOccupancyPermit requires a store going from closed, its status in
+// state, to open, its status in data, to hold an occupancy permit in the
+// registry for more people than its customers_per_hour. Other transitions
+// aren't checked. Finding no permit is only a warning without a backend,
+// since the permit's record may just not have been read.
+func checkOccupancyPermit(registry *Registry, state, data *StoreResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if storeStatus(*state) != "closed" || storeStatus(*data) != "open" || data.CustomersPerHour.IsUnknown() {
+		return diags
+	}
+
+	storeId := data.Id.ValueString()
+	var permitted int64
+	for _, permit := range ListRecords[OccupancyPermitResourceModel](registry) {
+		if permit.StoreId.ValueString() == storeId {
+			permitted = max(permitted, permit.MaxOccupancy.ValueInt64())
+		}
+	}
+
+	capacity := data.CustomersPerHour.ValueBigFloat()
+	switch {
+	case permitted == 0 && !registry.Persistent():
+		diags.AddAttributeWarning(
+			path.Root("status"),
+			"Occupancy Permit Not Read",
+			fmt.Sprintf("The provider has no record of an hw_occupancy_permit for %s in this run, so its capacity of %s wasn't checked against one. Set the provider's backend_path for stores to see every permit.",
+				storeId, capacity.String()),
+		)
+	case permitted == 0:
+		diags.AddAttributeError(
+			path.Root("status"),
+			"Missing Occupancy Permit",
+			fmt.Sprintf("%s needs an hw_occupancy_permit with max_occupancy above its capacity of %s before it can open. Create the permit with the store closed, then set status = \"open\".",
+				storeId, capacity.String()),
+		)
+	case big.NewFloat(float64(permitted)).Cmp(capacity) <= 0:
+		diags.AddAttributeError(
+			path.Root("status"),
+			"Occupancy Permit Too Small",
+			fmt.Sprintf("%s serves %s customers per hour, but its occupancy permits allow at most %d people. Raise the permit's max_occupancy above %s or lower the store's capacity.",
+				storeId, capacity.String(), permitted, capacity.String()),
+		)
+	}
+	return diags
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCheckOccupancyPermit(t *testing.T) {
+	registry := NewRegistry()
+	store := StoreResourceModel{
+		Id:               types.StringValue("store-main-4"),
+		CustomersPerHour: types.NumberValue(big.NewFloat(40)),
+	}
+	permit := func(maxOccupancy int64) {
+		registry.Put("permit-main-4", OccupancyPermitResourceModel{
+			StoreId:      store.Id,
+			MaxOccupancy: types.Int64Value(maxOccupancy),
+		})
+	}
+
+	tests := []struct {
+		name     string
+		setup    func()
+		from, to types.String
+		want     string
+	}{
+		{"no permit read", func() {}, types.StringValue("closed"), types.StringValue("open"), "Occupancy Permit Not Read"},
+		{"status unset", func() {}, types.StringValue("closed"), types.StringNull(), "Occupancy Permit Not Read"},
+		{"already open", func() {}, types.StringValue("open"), types.StringValue("open"), ""},
+		{"seasonal", func() {}, types.StringValue("seasonal"), types.StringValue("open"), ""},
+		{"closing", func() {}, types.StringValue("open"), types.StringValue("closed"), ""},
+		{"permit at capacity", func() { permit(40) }, types.StringValue("closed"), types.StringValue("open"), "Occupancy Permit Too Small"},
+		{"permit above capacity", func() { permit(41) }, types.StringValue("closed"), types.StringValue("open"), ""},
+	}
+	for _, tt := range tests {
+		tt.setup()
+		state, data := store, store
+		state.Status, data.Status = tt.from, tt.to
+		var got string
+		for _, d := range checkOccupancyPermit(registry, &state, &data) {
+			got = d.Summary()
+		}
+		if got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCheckOccupancyPermitWithBackend(t *testing.T) {
+	// With a backend, a permit the registry doesn't find doesn't exist
+	registry := openTestRegistry(t, filepath.Join(t.TempDir(), "backend.json"))
+	state := StoreResourceModel{
+		Id:               types.StringValue("store-main-4"),
+		Status:           types.StringValue("closed"),
+		CustomersPerHour: types.NumberValue(big.NewFloat(40)),
+	}
+	data := state
+	data.Status = types.StringValue("open")
+
+	diags := checkOccupancyPermit(registry, &state, &data)
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Missing Occupancy Permit" {
+		t.Errorf("got %v, want Missing Occupancy Permit", diags)
+	}
+}
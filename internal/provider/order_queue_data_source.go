@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OrderQueueDataSource{}
+
+func NewOrderQueueDataSource() datasource.DataSource {
+	return &OrderQueueDataSource{}
+}
+
+// OrderQueueDataSource defines the data source implementation.
+type OrderQueueDataSource struct {
+	client *ProviderConfig
+}
+
+// OrderQueueDataSourceModel describes the data source data model.
+type OrderQueueDataSourceModel struct {
+	StoreId      types.String `tfsdk:"store_id"`
+	Orders       types.List   `tfsdk:"orders"`
+	PendingCount types.Int64  `tfsdk:"pending_count"`
+	Total        MoneyValue   `tfsdk:"total"`
+	Id           types.String `tfsdk:"id"`
+}
+
+// queuedOrderAttrTypes are the attribute types of an orders list element.
+var queuedOrderAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"customer_id": types.StringType,
+	"status":      types.StringType,
+	"total":       MoneyType{},
+	"placed_at":   types.StringType,
+	"age_seconds": types.Int64Type,
+}
+
+// queuedOrderModel is an element of the orders list.
+type queuedOrderModel struct {
+	Id         string       `tfsdk:"id"`
+	CustomerId string       `tfsdk:"customer_id"`
+	Status     string       `tfsdk:"status"`
+	Total      MoneyValue   `tfsdk:"total"`
+	PlacedAt   types.String `tfsdk:"placed_at"`
+	AgeSeconds types.Int64  `tfsdk:"age_seconds"`
+}
+
+func (d *OrderQueueDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_order_queue"
+}
+
+func (d *OrderQueueDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The queue of pending orders at a store: every ` + "`hw_order`" + ` placed there and not yet delivered, oldest first, with how long each has been waiting. Read from the provider's live records, like ` + "`hw_store_stats`" + `, so it shows the kitchen's backlog as it stands.
+
+**Example Usage:**
+
+` + "```hcl" + `
+data "hw_order_queue" "main" {
+  store_id = hw_store.main.id
+
+  # Queue the orders created in this apply
+  depends_on = [hw_order.lunch, hw_order.dinner]
+}
+
+output "main_queue" {
+  value = {
+    waiting = data.hw_order_queue.main.pending_count
+    owed    = data.hw_order_queue.main.total
+    next_up = try(data.hw_order_queue.main.orders[0].id, null)
+    longest = try(data.hw_order_queue.main.orders[0].age_seconds, 0)
+  }
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates a data source reading **live provider state** as an ordered list
+- Pending means placed or prepared; delivered orders leave the queue
+- Orders are sorted by ` + "`placed_at`" + `, so ` + "`orders[0]`" + ` is the next one to work on
+- Counts only orders in the same configuration; use ` + "`depends_on`" + ` so they are placed first
+
+*Tickets in a row,*
+*The oldest one at the front,*
+*Clock ticks while they wait.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_store whose queue to list",
+				Required:            true,
+			},
+			"orders": schema.ListNestedAttribute{
+				MarkdownDescription: "The store's pending orders, oldest first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "ID of the hw_order",
+							Computed:            true,
+						},
+						"customer_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the hw_customer who placed the order",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Order status: placed or prepared",
+							Computed:            true,
+						},
+						"total": schema.NumberAttribute{
+							CustomType:          MoneyType{},
+							MarkdownDescription: "Order total in dollars",
+							Computed:            true,
+						},
+						"placed_at": schema.StringAttribute{
+							MarkdownDescription: "When the order was placed, as an RFC 3339 UTC time (null for orders placed before it was recorded)",
+							Computed:            true,
+						},
+						"age_seconds": schema.Int64Attribute{
+							MarkdownDescription: "Seconds since the order was placed (null when `placed_at` is)",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"pending_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of pending orders",
+				Computed:            true,
+			},
+			"total": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				MarkdownDescription: "Sum of the pending orders' totals in dollars",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *OrderQueueDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	d.client = config
+}
+
+func (d *OrderQueueDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrderQueueDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var registry *Registry
+	if d.client != nil {
+		registry = d.client.Registry
+	}
+
+	storeId := data.StoreId.ValueString()
+	_, _, diags := lookupReference[StoreResourceModel](d.client, path.Root("store_id"), storeId, "store")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pending := pendingOrders(registry, storeId)
+	now := time.Now()
+	orders := make([]queuedOrderModel, 0, len(pending))
+	var totalCents int64
+	for _, order := range pending {
+		age := types.Int64Null()
+		if placedAt, err := time.Parse(time.RFC3339, order.PlacedAt.ValueString()); err == nil {
+			age = types.Int64Value(int64(now.Sub(placedAt).Seconds()))
+		}
+		totalCents += order.Total.Cents()
+
+		orders = append(orders, queuedOrderModel{
+			Id:         order.Id.ValueString(),
+			CustomerId: order.CustomerId.ValueString(),
+			Status:     order.Status.ValueString(),
+			Total:      order.Total,
+			PlacedAt:   order.PlacedAt,
+			AgeSeconds: age,
+		})
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: queuedOrderAttrTypes}, orders)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Orders = list
+	data.PendingCount = types.Int64Value(int64(len(orders)))
+	data.Total = NewMoneyCents(totalCents)
+	data.Id = types.StringValue("order-queue-" + storeId)
+
+	tflog.Trace(ctx, "read order queue data source", map[string]any{
+		"store_id": storeId,
+		"count":    len(orders),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,351 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &OrderResource{}
+var _ resource.ResourceWithImportState = &OrderResource{}
+
+func NewOrderResource() resource.Resource {
+	return &OrderResource{}
+}
+
+type OrderResource struct {
+	client *ProviderConfig
+}
+
+type OrderResourceModel struct {
+	CustomerId types.String `tfsdk:"customer_id"`
+	StoreId    types.String `tfsdk:"store_id"`
+	ItemIds    types.List   `tfsdk:"item_ids"`
+	Total      MoneyValue   `tfsdk:"total"`
+	Status     types.String `tfsdk:"status"`
+	PlacedAt   types.String `tfsdk:"placed_at"`
+	Tags       types.Map    `tfsdk:"tags"`
+	TagsAll    types.Map    `tfsdk:"tags_all"`
+	Haiku      types.String `tfsdk:"haiku"`
+	Id         types.String `tfsdk:"id"`
+}
+
+// orderStatuses is the order lifecycle, in order. Each Read advances an order
+// one step until it is delivered, unless hw_fulfill_orders delivered it first.
+// hw_refund_order can also take an order out of the lifecycle at any step, to
+// the final status "refunded".
+var orderStatuses = []string{"placed", "prepared", "delivered"}
+
+func (r *OrderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_order"
+}
+
+func (r *OrderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `A customer's order at a store, from the register to the table. Unlike the ` + "`hw_order`" + ` data source, this is a managed resource whose status moves forward on its own, like a real backend processing the order between Terraform runs.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_order" "lunch" {
+  customer_id = hw_customer.pat.id
+  store_id    = hw_store.main.id
+  item_ids    = [hw_sandwich.blt.id, hw_drink.cola.id, hw_cookie.choc.id]
+  # total computed from the menu prices
+  # status starts as "placed"
+}
+
+output "lunch_status" {
+  value = hw_order.lunch.status
+}
+` + "```" + `
+
+**Key Concepts:**
+- The provider's first resource with **server-side status progression**
+- ` + "`status`" + ` goes placed → prepared → delivered, one step per refresh (` + "`terraform refresh`" + ` or ` + "`terraform plan`" + `)
+- ` + "`total`" + ` is the sum of the menu prices of the items (sandwich, drink, soup, salad, cookie, brownie, stroopwafel)
+- Orders are refused when the store already has as many open orders as its ` + "`customers_per_hour`" + `
+- Changing the items starts the order over as "placed", with a new ` + "`placed_at`" + `
+- ` + "`hw_refund_order`" + ` reverses an order; it shows as "refunded" from its next refresh on
+
+*Ticket on the rail,*
+*Placed, prepared, then carried out,*
+*Lunch arrives at last.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"customer_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_customer placing the order",
+				Required:            true,
+			},
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_store preparing the order",
+				Required:            true,
+			},
+			"item_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "List of menu item resource IDs (hw_sandwich, hw_drink, hw_soup, hw_salad, hw_cookie, hw_brownie, hw_stroopwafel). List an item twice to order it twice",
+				Required:            true,
+			},
+			"total": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Order total in dollars: the sum of the items' menu prices",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Order status: placed, prepared, or delivered. Advances one step on each refresh, or becomes refunded after hw_refund_order",
+			},
+			"placed_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the order was placed, as an RFC 3339 UTC time. Reset when the items or store change",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Order identifier, unique to the order even when the same customer places another with the same items",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OrderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *OrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := r.client.NewUniqueId("order", fmt.Sprintf("%s-%d", r.client.KindFromId(data.CustomerId.ValueString(), "customer"), len(data.ItemIds.Elements())))
+
+	resp.Diagnostics.Append(r.place(ctx, &data, id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created an order resource", map[string]any{
+		"id":    data.Id.ValueString(),
+		"total": data.Total.ValueBigFloat().String(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrderResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The kitchen works on the order between runs: advance it one step, or
+	// further when hw_fulfill_orders has delivered it. A refunded order stays
+	// refunded
+	previous := data.Status.ValueString()
+	status := nextOrderStatus(previous)
+	if record, ok := LookupRecord[OrderResourceModel](r.client.Registry, data.Id.ValueString()); ok &&
+		(record.Status.ValueString() == "refunded" ||
+			slices.Index(orderStatuses, record.Status.ValueString()) > slices.Index(orderStatuses, status)) {
+		status = record.Status.ValueString()
+	}
+	data.Status = types.StringValue(status)
+	if data.Status.ValueString() != previous {
+		tflog.Debug(ctx, "order status advanced", map[string]any{
+			"id":   data.Id.ValueString(),
+			"from": previous,
+			"to":   data.Status.ValueString(),
+		})
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OrderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state OrderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changing the order starts it over; otherwise it keeps its progress
+	resp.Diagnostics.Append(r.place(ctx, &data, state.Id.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.ItemIds.Equal(state.ItemIds) && data.StoreId.Equal(state.StoreId) {
+		data.Status = state.Status
+		data.PlacedAt = state.PlacedAt
+	}
+	data.Id = state.Id
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OrderResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted an order resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *OrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// place validates the order's references and the store's capacity, then
+// prices the items and sets the status to placed. id is the order's own ID,
+// which is left out when counting the store's open orders. The store's status
+// and capacity are only checked when its record is known.
+func (r *OrderResource) place(ctx context.Context, data *OrderResourceModel, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	_, _, customerDiags := lookupReference[CustomerResourceModel](r.client, path.Root("customer_id"), data.CustomerId.ValueString(), "customer")
+	diags.Append(customerDiags...)
+
+	store, storeKnown, storeDiags := lookupReference[StoreResourceModel](r.client, path.Root("store_id"), data.StoreId.ValueString(), "store")
+	diags.Append(storeDiags...)
+	if storeKnown && storeStatus(store) == "closed" {
+		diags.AddAttributeError(
+			path.Root("store_id"),
+			"Store Closed",
+			fmt.Sprintf("%s is closed and takes no orders. Set the store's status to open first.", data.StoreId.ValueString()),
+		)
+	}
+
+	var itemIds []string
+	diags.Append(data.ItemIds.ElementsAs(ctx, &itemIds, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Price each item from the menu item type encoded in its ID
+	total := new(big.Float)
+	for i, itemId := range itemIds {
+		item, ok := r.client.TypeOfId(itemId, ticketItems...)
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("item_ids").AtListIndex(i),
+				"Unknown Menu Item",
+				fmt.Sprintf("%q is not the ID of a menu item. Order items are: %s.", itemId, strings.Join(ticketItems, ", ")),
+			)
+			continue
+		}
+		total.Add(total, r.client.Price(ApplyUpcharge(r.client.BasePrice(item), r.client.Upcharge)).ValueBigFloat())
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	// The store can work on at most customers_per_hour open orders at once
+	if storeKnown {
+		capacity := store.CustomersPerHour.ValueBigFloat()
+		open := 0
+		for _, order := range pendingOrders(r.client.Registry, data.StoreId.ValueString()) {
+			if order.Id.ValueString() != id {
+				open++
+			}
+		}
+		if big.NewFloat(float64(open)).Cmp(capacity) >= 0 {
+			diags.AddAttributeError(
+				path.Root("store_id"),
+				"Store At Capacity",
+				fmt.Sprintf("%s already has %d open orders and serves %s customers per hour. Wait for orders to be delivered or raise the store's capacity (see its bottleneck_advice).",
+					data.StoreId.ValueString(), open, capacity.String()),
+			)
+			return diags
+		}
+	}
+
+	data.Total = r.client.Price(total)
+	data.Status = types.StringValue(orderStatuses[0])
+	data.PlacedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	return diags
+}
+
+// pendingOrders returns the orders placed at a store and not yet delivered
+// or refunded, oldest first. Orders placed at the same time, or without a placed_at, keep
+// their ID order.
+func pendingOrders(registry *Registry, storeId string) []OrderResourceModel {
+	var pending []OrderResourceModel
+	for _, order := range ListRecords[OrderResourceModel](registry) {
+		if status := order.Status.ValueString(); order.StoreId.ValueString() == storeId && status != "delivered" && status != "refunded" {
+			pending = append(pending, order)
+		}
+	}
+
+	// RFC 3339 UTC times sort as strings
+	slices.SortStableFunc(pending, func(a, b OrderResourceModel) int {
+		return strings.Compare(a.PlacedAt.ValueString(), b.PlacedAt.ValueString())
+	})
+	return pending
+}
+
+// nextOrderStatus returns the status after status in the order lifecycle.
+// Delivered orders, and orders in an unknown status, stay where they are.
+func nextOrderStatus(status string) string {
+	i := slices.Index(orderStatuses, status)
+	if i < 0 || i == len(orderStatuses)-1 {
+		return status
+	}
+	return orderStatuses[i+1]
+}
@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -17,6 +16,9 @@ import (
 var _ resource.Resource = &OvenResource{}
 var _ resource.ResourceWithImportState = &OvenResource{}
 
+// ovenUsefulLifeYears is the default useful_life_years of an oven.
+const ovenUsefulLifeYears = 10
+
 func NewOvenResource() resource.Resource {
 	return &OvenResource{}
 }
@@ -26,10 +28,17 @@ type OvenResource struct {
 }
 
 type OvenResourceModel struct {
-	Type        types.String `tfsdk:"type"`
-	Description types.String `tfsdk:"description"`
-	Cost        types.Number `tfsdk:"cost"`
-	Id          types.String `tfsdk:"id"`
+	Type            types.String `tfsdk:"type"`
+	Description     types.String `tfsdk:"description"`
+	Cost            MoneyValue   `tfsdk:"cost"`
+	PurchaseDate    types.String `tfsdk:"purchase_date"`
+	UsefulLifeYears types.Number `tfsdk:"useful_life_years"`
+	BookValue       MoneyValue   `tfsdk:"book_value"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
 }
 
 func (r *OvenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -64,6 +73,14 @@ resource "hw_oven" "high_capacity" {
   # cost computed as $2000
 }
 
+# A used oven, depreciated over 10 years
+resource "hw_oven" "secondhand" {
+  type              = "commercial"
+  purchase_date     = "2020-03-01"
+  useful_life_years = 10
+  # book_value declines from $1200 to $0 by 2030-03-01
+}
+
 # Using variables
 variable "oven_type" {
   type    = string
@@ -81,6 +98,7 @@ resource "hw_oven" "variable" {
 - Required for ` + "`hw_store`" + ` resource
 - Types: standard ($500), commercial ($1200), high-capacity ($2000)
 - Cost is automatically computed
+- Set ` + "`purchase_date`" + ` to **depreciate** the oven: ` + "`book_value`" + ` declines over time
 
 *Heat radiates warm,*
 *Baking bread to golden brown,*
@@ -96,9 +114,30 @@ resource "hw_oven" "variable" {
 				Optional:            true,
 			},
 			"cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
 				Computed:            true,
 				MarkdownDescription: "Cost of the oven in dollars (varies by type: standard=$500, commercial=$1200, high-capacity=$2000)",
 			},
+			"purchase_date": schema.StringAttribute{
+				MarkdownDescription: "Date the oven was bought (`YYYY-MM-DD`). Without it the oven is treated as new and `book_value` equals `cost`",
+				Optional:            true,
+			},
+			"useful_life_years": schema.NumberAttribute{
+				MarkdownDescription: "Years over which the oven depreciates to zero (defaults to 10)",
+				Optional:            true,
+			},
+			"book_value": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Straight-line depreciated value of the oven as of today (or the provider's `as_of` date)",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Oven identifier",
@@ -137,23 +176,21 @@ func (r *OvenResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 
 	// Calculate cost based on type
-	var basePrice *big.Float
 	ovenType := data.Type.ValueString()
-	switch ovenType {
-	case "standard":
-		basePrice = big.NewFloat(500.00)
-	case "commercial":
-		basePrice = big.NewFloat(1200.00)
-	case "high-capacity":
-		basePrice = big.NewFloat(2000.00)
-	default:
-		basePrice = big.NewFloat(500.00) // default to standard
-	}
+	basePrice := r.client.VariantPrice("oven", ovenType, "standard")
 
 	finalPrice := ApplyUpcharge(basePrice, r.client.Upcharge)
-	data.Cost = types.NumberValue(finalPrice)
+	data.Cost = r.client.Price(finalPrice)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
 
-	id := fmt.Sprintf("oven-%s-%d", ovenType, len(ovenType))
+	bookValue, diags := r.client.BookValue(finalPrice, data.PurchaseDate, data.UsefulLifeYears, ovenUsefulLifeYears)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.BookValue = bookValue
+
+	id := r.client.NewId("oven", fmt.Sprintf("%s-%d", ovenType, len(ovenType)))
 	data.Id = types.StringValue(id)
 
 	tflog.Trace(ctx, "created an oven resource", map[string]any{
@@ -162,6 +199,7 @@ func (r *OvenResource) Create(ctx context.Context, req resource.CreateRequest, r
 		"cost": data.Cost.ValueBigFloat().String(),
 	})
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -175,22 +213,21 @@ func (r *OvenResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 
 	// Recalculate cost
-	var basePrice *big.Float
 	ovenType := data.Type.ValueString()
-	switch ovenType {
-	case "standard":
-		basePrice = big.NewFloat(500.00)
-	case "commercial":
-		basePrice = big.NewFloat(1200.00)
-	case "high-capacity":
-		basePrice = big.NewFloat(2000.00)
-	default:
-		basePrice = big.NewFloat(500.00)
-	}
+	basePrice := r.client.VariantPrice("oven", ovenType, "standard")
 
 	finalPrice := ApplyUpcharge(basePrice, r.client.Upcharge)
-	data.Cost = types.NumberValue(finalPrice)
+	data.Cost = r.client.Price(finalPrice)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+
+	bookValue, diags := r.client.BookValue(finalPrice, data.PurchaseDate, data.UsefulLifeYears, ovenUsefulLifeYears)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.BookValue = bookValue
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -204,21 +241,19 @@ func (r *OvenResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 
 	// Recalculate cost
-	var basePrice *big.Float
 	ovenType := data.Type.ValueString()
-	switch ovenType {
-	case "standard":
-		basePrice = big.NewFloat(500.00)
-	case "commercial":
-		basePrice = big.NewFloat(1200.00)
-	case "high-capacity":
-		basePrice = big.NewFloat(2000.00)
-	default:
-		basePrice = big.NewFloat(500.00)
-	}
+	basePrice := r.client.VariantPrice("oven", ovenType, "standard")
 
 	finalPrice := ApplyUpcharge(basePrice, r.client.Upcharge)
-	data.Cost = types.NumberValue(finalPrice)
+	data.Cost = r.client.Price(finalPrice)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+
+	bookValue, diags := r.client.BookValue(finalPrice, data.PurchaseDate, data.UsefulLifeYears, ovenUsefulLifeYears)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.BookValue = bookValue
 
 	var state OvenResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -227,12 +262,13 @@ func (r *OvenResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	if !data.Type.Equal(state.Type) {
-		id := fmt.Sprintf("oven-%s-%d", ovenType, len(ovenType))
+		id := r.client.NewId("oven", fmt.Sprintf("%s-%d", ovenType, len(ovenType)))
 		data.Id = types.StringValue(id)
 	} else {
 		data.Id = state.Id
 	}
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -253,3 +289,16 @@ func (r *OvenResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 func (r *OvenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// ovenThroughput returns how many customers per hour an oven of the given
+// type can serve. Unknown types are treated as standard.
+func ovenThroughput(ovenType string) float64 {
+	switch ovenType {
+	case "commercial":
+		return 30.0
+	case "high-capacity":
+		return 40.0
+	default:
+		return 20.0
+	}
+}
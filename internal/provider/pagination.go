@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maxPageSize is the largest page_size the paginated catalog data sources
+// accept.
+const maxPageSize = 100
+
+// errInvalidPageToken is returned by paginate for a token it didn't issue.
+var errInvalidPageToken = errors.New("invalid page token")
+
+// pageSizeAttribute is the page_size attribute of a paginated catalog data
+// source.
+func pageSizeAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: fmt.Sprintf("Most items to return, from 1 to %d. Without it, every item from `page_token` on comes back in one page", maxPageSize),
+		Optional:            true,
+		Validators: []validator.Int64{
+			int64RangeValidator{summary: "Invalid Page Size", min: 1, max: maxPageSize},
+		},
+	}
+}
+
+// pageTokenAttribute is the page_token attribute of a paginated catalog data
+// source.
+func pageTokenAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "`next_page_token` of the previous page, to read the page after it. Omit it to start from the first page",
+		Optional:            true,
+	}
+}
+
+// allPagesAttribute is the all_pages attribute of a paginated catalog data
+// source.
+func allPagesAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: "Follow `next_page_token` from page to page and return every item at once, as a client library's auto-pager would",
+		Optional:            true,
+	}
+}
+
+// nextPageTokenAttribute is the next_page_token attribute of a paginated
+// catalog data source.
+func nextPageTokenAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Token to pass as `page_token` for the next page, or null on the last page",
+		Computed:            true,
+	}
+}
+
+// pageToken returns the opaque token for the page of the named catalog
+// starting at offset.
+func pageToken(catalog string, offset int) string {
+	return base64.RawURLEncoding.EncodeToString(fmt.Appendf(nil, "%s:%d", catalog, offset))
+}
+
+// paginate returns the page of at most pageSize items starting at the one
+// token points to, or at the first item for an empty token, with the token
+// of the next page, or "" on the last. Tokens are tied to the named catalog,
+// so one catalog's tokens are refused by another.
+func paginate[T any](catalog string, items []T, pageSize int, token string) ([]T, string, error) {
+	offset := 0
+	if token != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(token)
+		if err != nil {
+			return nil, "", errInvalidPageToken
+		}
+		name, position, ok := strings.Cut(string(decoded), ":")
+		if !ok || name != catalog {
+			return nil, "", errInvalidPageToken
+		}
+		offset, err = strconv.Atoi(position)
+		if err != nil || offset < 0 || offset > len(items) {
+			return nil, "", errInvalidPageToken
+		}
+	}
+
+	end := min(offset+pageSize, len(items))
+	if end == len(items) {
+		return items[offset:end], "", nil
+	}
+	return items[offset:end], pageToken(catalog, end), nil
+}
+
+// pageOf returns the page of the named catalog's items a paginated data
+// source's configuration asks for, and the next_page_token to report. With
+// all_pages set it follows the tokens to the last page and returns every
+// item from page_token on.
+func pageOf[T any](catalog string, items []T, pageSize types.Int64, token types.String, allPages types.Bool) ([]T, types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	size := len(items)
+	if !pageSize.IsNull() {
+		size = int(pageSize.ValueInt64())
+	}
+
+	page, next, err := paginate(catalog, items, size, token.ValueString())
+	for err == nil && next != "" && allPages.ValueBool() {
+		var more []T
+		more, next, err = paginate(catalog, items, size, next)
+		page = append(page[:len(page):len(page)], more...)
+	}
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("page_token"),
+			"Invalid Page Token",
+			fmt.Sprintf("%q is not a page token of this data source. Pass the next_page_token of a previous page, or omit page_token to start from the first page.", token.ValueString()),
+		)
+		return nil, types.StringNull(), diags
+	}
+
+	if next == "" {
+		return page, types.StringNull(), diags
+	}
+	return page, types.StringValue(next), diags
+}
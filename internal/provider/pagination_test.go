@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPaginate(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e"}
+
+	var got []string
+	token, pages := "", 0
+	for {
+		page, next, err := paginate("letters", items, 2, token)
+		if err != nil {
+			t.Fatalf("paginate(%q): %v", token, err)
+		}
+		got = append(got, page...)
+		pages++
+		if next == "" {
+			break
+		}
+		token = next
+	}
+	if !slices.Equal(got, items) || pages != 3 {
+		t.Errorf("paged through %v in %d pages, want %v in 3", got, pages, items)
+	}
+
+	for _, token := range []string{"not base64!", pageToken("numbers", 2), pageToken("letters", 6), pageToken("letters", -1)} {
+		if _, _, err := paginate("letters", items, 2, token); err == nil {
+			t.Errorf("paginate accepted token %q", token)
+		}
+	}
+}
+
+func TestPageOf(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e"}
+
+	page, next, diags := pageOf("letters", items, types.Int64Null(), types.StringNull(), types.BoolNull())
+	if diags.HasError() || !slices.Equal(page, items) || !next.IsNull() {
+		t.Errorf("without page_size got %v, next %v, want every item and no next page", page, next)
+	}
+
+	page, next, _ = pageOf("letters", items, types.Int64Value(2), types.StringNull(), types.BoolNull())
+	if !slices.Equal(page, items[:2]) || next.ValueString() != pageToken("letters", 2) {
+		t.Errorf("first page got %v, next %v", page, next)
+	}
+
+	page, next, _ = pageOf("letters", items, types.Int64Value(2), types.StringValue(pageToken("letters", 2)), types.BoolValue(true))
+	if !slices.Equal(page, items[2:]) || !next.IsNull() {
+		t.Errorf("all_pages from the second page got %v, next %v, want %v and no next page", page, next, items[2:])
+	}
+
+	if _, _, diags := pageOf("letters", items, types.Int64Value(2), types.StringValue("bogus"), types.BoolNull()); !diags.HasError() {
+		t.Error("pageOf accepted a bogus page_token")
+	}
+}
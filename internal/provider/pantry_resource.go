@@ -0,0 +1,405 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math/big"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &PantryResource{}
+var _ resource.ResourceWithImportState = &PantryResource{}
+
+func NewPantryResource() resource.Resource {
+	return &PantryResource{}
+}
+
+type PantryResource struct {
+	client *ProviderConfig
+}
+
+type PantryResourceModel struct {
+	Size            types.String `tfsdk:"size"`
+	Ingredients     types.Map    `tfsdk:"ingredients"`
+	Unit            types.String `tfsdk:"unit"`
+	TotalQuantity   types.Number `tfsdk:"total_quantity"`
+	Stock           types.Map    `tfsdk:"stock"`
+	Cost            MoneyValue   `tfsdk:"cost"`
+	StorageCost     MoneyValue   `tfsdk:"storage_cost"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
+}
+
+// pantryCapacity is how many pounds of ingredients a pantry of each size
+// holds. The keys are also the accepted sizes.
+var pantryCapacity = map[string]int64{
+	"small":  100,
+	"medium": 250,
+	"large":  500,
+}
+
+func (r *PantryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pantry"
+}
+
+func (r *PantryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Shelves of flour sacks, pickle jars, and onions by the bag. A pantry stores bulk ingredients by weight, up to what its size holds, and charges for the space they take.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_pantry" "back_room" {
+  size = "medium"
+
+  ingredients = {
+    flour   = 100
+    onions  = 40
+    pickles = 25
+  }
+  # total_quantity computed as 165 (of 250 pounds)
+  # storage_cost computed as $41.25 a month (165 × $0.25)
+}
+
+# Stocked from a delivery note in kilograms
+resource "hw_pantry" "walk_in" {
+  size = "small"
+  unit = "kg"
+
+  ingredients = {
+    rice = 20
+    oats = 5
+  }
+  # total_quantity computed as about 55.1 pounds
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates a **map attribute** of ingredient name to quantity in pounds
+- Sizes hold: small 100 pounds ($200), medium 250 ($400), large 500 ($700)
+- Storage costs $0.25 per pound a month
+- Quantities can't be negative, and their total can't exceed the pantry's size
+- Optional ` + "`unit`" + ` takes quantities in g, kg, or oz instead, converted to pounds like ` + "`provider::hw::convert_units`" + `
+- ` + "`stock`" + ` adds the ingredients of delivered ` + "`hw_supply_order`" + ` resources for this pantry to ` + "`ingredients`" + `, in the same unit
+
+*Flour dust on the shelf,*
+*Jars lined up by the doorway,*
+*Monday's bread waits here.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"size": schema.StringAttribute{
+				MarkdownDescription: "Size of the pantry: small (100 pounds), medium (250), or large (500)",
+				Required:            true,
+			},
+			"ingredients": schema.MapAttribute{
+				ElementType:         types.NumberType,
+				MarkdownDescription: "Map of ingredient name to the quantity stored, in `unit`. The total can't exceed the pantry's size",
+				Required:            true,
+			},
+			"unit": schema.StringAttribute{
+				MarkdownDescription: "Unit of mass the `ingredients` quantities are in: g, kg, oz, or lb. Defaults to lb",
+				Optional:            true,
+			},
+			"total_quantity": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Total pounds of ingredients stored",
+			},
+			"stock": schema.MapAttribute{
+				ElementType:         types.NumberType,
+				Computed:            true,
+				MarkdownDescription: "Map of ingredient name to the quantity on hand, in `unit`: `ingredients` plus the ingredients of the hw_supply_order resources delivered to this pantry",
+			},
+			"cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Cost of the pantry in dollars (small=$200, medium=$400, large=$700)",
+			},
+			"storage_cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Monthly cost in dollars of storing the ingredients (total_quantity × $0.25)",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Pantry identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PantryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *PantryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PantryResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setCosts(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	size := data.Size.ValueString()
+	id := r.client.NewId("pantry", fmt.Sprintf("%s-%d", size, len(size)))
+	data.Id = types.StringValue(id)
+	resp.Diagnostics.Append(r.setStock(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created a pantry resource", map[string]any{
+		"id":             data.Id.ValueString(),
+		"total_quantity": data.TotalQuantity.ValueBigFloat().String(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PantryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PantryResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate totals and costs
+	resp.Diagnostics.Append(r.setCosts(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Supply orders may have been delivered since the last refresh
+	resp.Diagnostics.Append(r.setStock(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PantryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PantryResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate totals and costs
+	resp.Diagnostics.Append(r.setCosts(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state PantryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Size.Equal(state.Size) {
+		size := data.Size.ValueString()
+		id := r.client.NewId("pantry", fmt.Sprintf("%s-%d", size, len(size)))
+		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+	resp.Diagnostics.Append(r.setStock(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PantryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PantryResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted a pantry resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *PantryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setCosts validates the pantry's size and ingredient quantities, checks they
+// fit, and computes the total quantity, the pantry's cost and the monthly
+// storage cost.
+func (r *PantryResource) setCosts(ctx context.Context, data *PantryResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	size := data.Size.ValueString()
+	capacity, ok := pantryCapacity[size]
+	if !ok {
+		diags.AddAttributeError(
+			path.Root("size"),
+			"Invalid Pantry Size",
+			fmt.Sprintf("Size %q is not supported. Supported sizes: small, medium, large.", size),
+		)
+	}
+
+	var ingredients map[string]types.Number
+	diags.Append(data.Ingredients.ElementsAs(ctx, &ingredients, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	total := new(big.Float)
+	for _, name := range slices.Sorted(maps.Keys(ingredients)) {
+		quantity := ingredients[name]
+		if quantity.IsNull() || quantity.IsUnknown() {
+			continue
+		}
+		if quantity.ValueBigFloat().Sign() < 0 {
+			diags.AddAttributeError(
+				path.Root("ingredients").AtMapKey(name),
+				"Invalid Ingredient Quantity",
+				fmt.Sprintf("The quantity of %s can't be negative, got %s.", name, quantity.ValueBigFloat().String()),
+			)
+			continue
+		}
+		total.Add(total, quantity.ValueBigFloat())
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	// Weigh the ingredients in pounds
+	if unit := data.Unit.ValueString(); unit != "" && unit != "lb" {
+		pounds, err := convertUnits(total, unit, "lb")
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("unit"),
+				"Invalid Pantry Unit",
+				fmt.Sprintf("unit must be a unit of mass (g, kg, oz, or lb): %s.", err),
+			)
+			return diags
+		}
+		total = pounds
+	}
+
+	if total.Cmp(big.NewFloat(float64(capacity))) > 0 {
+		diags.AddAttributeError(
+			path.Root("ingredients"),
+			"Pantry Over Capacity",
+			fmt.Sprintf("The ingredients weigh %s pounds, but a %s pantry holds %d. Store less or choose a larger size.", total.String(), size, capacity),
+		)
+		return diags
+	}
+
+	data.TotalQuantity = types.NumberValue(total)
+	data.Cost = r.client.Price(ApplyUpcharge(r.client.VariantPrice("pantry", size, "small"), r.client.Upcharge))
+	data.StorageCost = r.client.Price(new(big.Float).Mul(total, r.client.BasePrice("pantry_pound")))
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+	return diags
+}
+
+// setStock computes the pantry's stock: its ingredients plus the ingredients
+// delivered to it by supply orders, converted from pounds to its unit. The
+// pantry must already have its ID.
+func (r *PantryResource) setStock(ctx context.Context, data *PantryResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var ingredients map[string]types.Number
+	diags.Append(data.Ingredients.ElementsAs(ctx, &ingredients, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	stock := map[string]*big.Float{}
+	for name, quantity := range ingredients {
+		if quantity.IsNull() || quantity.IsUnknown() {
+			continue
+		}
+		stock[name] = new(big.Float).Set(quantity.ValueBigFloat())
+	}
+
+	unit := data.Unit.ValueString()
+	if unit == "" {
+		unit = "lb"
+	}
+	for name, pounds := range r.client.deliveredStock(ctx, data.Id.ValueString()) {
+		quantity, err := convertUnits(pounds, "lb", unit)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("unit"),
+				"Invalid Pantry Unit",
+				fmt.Sprintf("unit must be a unit of mass (g, kg, oz, or lb): %s.", err),
+			)
+			return diags
+		}
+		if stock[name] == nil {
+			stock[name] = new(big.Float)
+		}
+		stock[name].Add(stock[name], quantity)
+	}
+
+	values := make(map[string]attr.Value, len(stock))
+	for name, quantity := range stock {
+		values[name] = types.NumberValue(quantity)
+	}
+	data.Stock = types.MapValueMust(types.NumberType, values)
+	return diags
+}
@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ParkingLotResource{}
+var _ resource.ResourceWithImportState = &ParkingLotResource{}
+
+func NewParkingLotResource() resource.Resource {
+	return &ParkingLotResource{}
+}
+
+type ParkingLotResource struct {
+	client *ProviderConfig
+}
+
+type ParkingLotResourceModel struct {
+	Spaces           types.Int64  `tfsdk:"spaces"`
+	AccessibleSpaces types.Int64  `tfsdk:"accessible_spaces"`
+	Description      types.String `tfsdk:"description"`
+	Cost             MoneyValue   `tfsdk:"cost"`
+	CustomersPerHour types.Number `tfsdk:"customers_per_hour"`
+	PriceMultiplier  types.Number `tfsdk:"price_multiplier"`
+	Tags             types.Map    `tfsdk:"tags"`
+	TagsAll          types.Map    `tfsdk:"tags_all"`
+	Haiku            types.String `tfsdk:"haiku"`
+	Id               types.String `tfsdk:"id"`
+}
+
+// Parking rules: each space turns over twice an hour (30-minute visits), and
+// at least one space in every 25, rounded up, must be accessible.
+const (
+	customersPerParkingSpace = 2
+	spacesPerAccessibleSpace = 25
+)
+
+func (r *ParkingLotResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_parking_lot"
+}
+
+func (r *ParkingLotResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Somewhere for customers to leave the car. A store linked to a parking lot can't serve more customers per hour than the lot can park, which makes parking one more bottleneck to plan around.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_parking_lot" "main" {
+  spaces            = 20
+  accessible_spaces = 1
+  # cost computed as $1000 (20 × $50)
+  # customers_per_hour computed as 40 (20 spaces × 2 visits/hour)
+}
+
+resource "hw_store" "main" {
+  # ...
+  parking_lot_id = hw_parking_lot.main.id
+  # customers_per_hour is capped at 40, bottleneck = "parking" if that's the limit
+}
+` + "```" + `
+
+**Key Concepts:**
+- Feeds a **bottleneck input** into ` + "`hw_store`" + `: the store's ` + "`customers_per_hour`" + ` is capped at the lot's
+- Each space serves 2 customers per hour (30-minute visits)
+- At least 1 in every 25 spaces, rounded up, must be accessible
+- Each space costs $50 to pave and stripe
+
+*Lines freshly painted,*
+*One blue space beside the door,*
+*Engines cool at noon.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"spaces": schema.Int64Attribute{
+				MarkdownDescription: "Total number of parking spaces, including accessible spaces (at least 1)",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Parking Spaces", min: 1, max: math.MaxInt64},
+				},
+			},
+			"accessible_spaces": schema.Int64Attribute{
+				MarkdownDescription: "Number of the spaces that are accessible. At least 1 in every 25 spaces, rounded up",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Accessible Spaces", min: 0, max: math.MaxInt64},
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the parking lot",
+				Optional:            true,
+			},
+			"cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Cost in dollars (spaces × $50)",
+			},
+			"customers_per_hour": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Customers per hour the lot can park (spaces × 2). Caps the `customers_per_hour` of stores linked with `parking_lot_id`",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Parking lot identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ParkingLotResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *ParkingLotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ParkingLotResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setCostAndCapacity(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaces := strconv.FormatInt(data.Spaces.ValueInt64(), 10)
+	id := r.client.NewId("parking", fmt.Sprintf("%s-%d", spaces, len(spaces)))
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created a parking lot resource", map[string]any{
+		"id":                 data.Id.ValueString(),
+		"customers_per_hour": data.CustomersPerHour.ValueBigFloat().String(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ParkingLotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ParkingLotResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost and capacity
+	resp.Diagnostics.Append(r.setCostAndCapacity(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ParkingLotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ParkingLotResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost and capacity
+	resp.Diagnostics.Append(r.setCostAndCapacity(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ParkingLotResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Spaces.Equal(state.Spaces) {
+		spaces := strconv.FormatInt(data.Spaces.ValueInt64(), 10)
+		id := r.client.NewId("parking", fmt.Sprintf("%s-%d", spaces, len(spaces)))
+		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ParkingLotResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ParkingLotResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted a parking lot resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *ParkingLotResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setCostAndCapacity validates the minimum ratio of accessible spaces and
+// computes the lot's cost and customer capacity.
+func (r *ParkingLotResource) setCostAndCapacity(data *ParkingLotResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	spaces := data.Spaces.ValueInt64()
+	accessible := data.AccessibleSpaces.ValueInt64()
+	required := (spaces + spacesPerAccessibleSpace - 1) / spacesPerAccessibleSpace
+	switch {
+	case accessible > spaces:
+		diags.AddAttributeError(
+			path.Root("accessible_spaces"),
+			"Invalid Accessible Spaces",
+			fmt.Sprintf("accessible_spaces must be no greater than spaces (%d), got %d.", spaces, accessible),
+		)
+		return diags
+	case accessible < required:
+		diags.AddAttributeError(
+			path.Root("accessible_spaces"),
+			"Not Enough Accessible Spaces",
+			fmt.Sprintf("A lot with %d spaces needs at least %d accessible spaces (1 in every %d, rounded up), got %d.",
+				spaces, required, spacesPerAccessibleSpace, accessible),
+		)
+		return diags
+	}
+
+	var totalCost big.Float
+	totalCost.Mul(big.NewFloat(float64(spaces)), r.client.BasePrice("parking_space"))
+	data.Cost = r.client.Price(ApplyUpcharge(&totalCost, r.client.Upcharge))
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+	data.CustomersPerHour = types.NumberValue(big.NewFloat(float64(spaces * customersPerParkingSpace)))
+	return diags
+}
@@ -0,0 +1,442 @@
+package provider
+
+import (
+	"math/big"
+	"slices"
+	"sort"
+	"sync"
+)
+
+// basePrices is the pricing engine: the base price in dollars of every priced
+// item before overrides and upcharge. Quantity-priced items hold the per-unit
+// price, and items with variants are keyed as "<item>_<variant>". The store_*
+// entries are the typical component costs hw_store uses for its estimates.
+var basePrices = map[string]float64{
+	// Menu items
+	"sandwich":       5.00,
+	"drink":          1.00,
+	"soup":           2.50,
+	"salad":          4.00,
+	"cookie":         1.50,
+	"brownie":        2.00,
+	"stroopwafel":    1.75,
+	"napkin":         0.25,
+	"cracker":        0.50,
+	"silverware":     1.00,
+	"to_go_box":      0.40,
+	"cup":            0.20,
+	"straw":          0.05,
+	"dogtreat_small": 1.00,
+	"dogtreat_large": 2.00,
+
+	// Equipment and staff
+	"oven_standard":      500.00,
+	"oven_commercial":    1200.00,
+	"oven_high-capacity": 2000.00,
+	"cook_junior":        120.00,
+	"cook_experienced":   160.00,
+	"cook_expert":        200.00,
+	"tables_small":       50.00,
+	"tables_medium":      100.00,
+	"tables_large":       150.00,
+	"chairs_basic":       20.00,
+	"chairs_comfortable": 35.00,
+	"chairs_premium":     50.00,
+	"fridge_small":       300.00,
+	"fridge_medium":      500.00,
+	"fridge_large":       800.00,
+	"employee_cook":      160.00,
+	"employee_cashier":   110.00,
+	"employee_janitor":   90.00,
+	"employee_manager":   240.00,
+	"camera_720p":        80.00,
+	"camera_1080p":       150.00,
+	"camera_4k":          300.00,
+	"extinguisher_abc":   60.00,
+	"extinguisher_k":     180.00,
+	"exit_sign":          35.00,
+	"occupancy_permit":   150.00,
+	"decor_retro":        25.00,
+	"decor_modern":       40.00,
+	"decor_nautical":     30.00,
+	"parking_space":      50.00,
+	"dumpster_small":     150.00,
+	"dumpster_medium":    250.00,
+	"dumpster_large":     400.00,
+	"compost_bin_small":  60.00,
+	"compost_bin_large":  110.00,
+	"recycling_bin":      45.00,
+	"ice_machine_pound":  5.00,
+	"pantry_small":       200.00,
+	"pantry_medium":      400.00,
+	"pantry_large":       700.00,
+	"pantry_pound":       0.25,
+	"spice_basil":        3.00,
+	"spice_chili":        3.50,
+	"spice_cinnamon":     4.00,
+	"spice_cumin":        3.50,
+	"spice_nutmeg":       5.00,
+	"spice_oregano":      3.00,
+	"spice_paprika":      4.50,
+	"spice_pepper":       2.50,
+	"spice_salt":         1.00,
+	"spice_turmeric":     4.00,
+	"janitor_shift":      90.00,
+	"wifi_mbps":          0.50,
+	"wifi_portal":        20.00,
+	"dessert_case_tray":  50.00,
+	"dessert_case_cold":  300.00,
+
+	// Ingredients
+	"meat_ounce": 0.60,
+
+	// Giveaways
+	"sticker_logo":        0.10,
+	"sticker_mascot":      0.15,
+	"sticker_holographic": 0.30,
+
+	// Insurance deductibles
+	"insurance_deductible_basic":    2500.00,
+	"insurance_deductible_standard": 1000.00,
+	"insurance_deductible_premium":  250.00,
+
+	// Supply orders, per pound
+	"supplier_farm_fresh":      1.50,
+	"supplier_harbor_foods":    1.20,
+	"supplier_metro_wholesale": 0.80,
+
+	// Store amenities
+	"amenity_coffee_machine": 800.00,
+	"amenity_drive_thru":     4000.00,
+	"amenity_patio":          2500.00,
+	"amenity_dessert_case":   600.00,
+
+	// Store component estimates
+	"store_oven":   1000.00,
+	"store_cook":   160.00,
+	"store_tables": 500.00,
+	"store_chairs": 300.00,
+	"store_fridge": 500.00,
+}
+
+// priceBaseYear is the year the built-in basePrices are quoted in.
+const priceBaseYear = 2024
+
+// inflationTable holds, per year, the price level relative to priceBaseYear
+// as a whole-number percentage. Years after the base year are projections at
+// roughly 3% a year.
+var inflationTable = map[int64]int64{
+	2020: 83,
+	2021: 86,
+	2022: 93,
+	2023: 97,
+	2024: 100,
+	2025: 103,
+	2026: 106,
+	2027: 109,
+	2028: 113,
+	2029: 116,
+	2030: 119,
+}
+
+// PriceYears returns the sorted years the inflation table covers.
+func PriceYears() []int64 {
+	years := make([]int64, 0, len(inflationTable))
+	for year := range inflationTable {
+		years = append(years, year)
+	}
+	slices.Sort(years)
+	return years
+}
+
+// PriceMultiplier returns the inflation multiplier applied to built-in prices
+// for the provider's price_year (1 when unset). It is safe to call on a nil
+// config.
+func (c *ProviderConfig) PriceMultiplier() *big.Float {
+	percent := c.pricePercent()
+	return new(big.Float).Quo(big.NewFloat(float64(percent)), big.NewFloat(100))
+}
+
+// pricePercent returns the price_year's inflation percentage.
+func (c *ProviderConfig) pricePercent() int64 {
+	if c == nil || c.PricePercent == 0 {
+		return 100
+	}
+	return c.PricePercent
+}
+
+// PriceKeys returns the sorted keys of the pricing engine, which are also the
+// keys accepted by the provider's price_overrides attribute.
+func PriceKeys() []string {
+	keys := make([]string, 0, len(basePrices))
+	for key := range basePrices {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// scaledPrices caches, per inflation percentage, basePrices converted to
+// big.Float and scaled to that price level. The tables are computed once and
+// never modified; BasePrice hands out copies.
+var scaledPrices sync.Map // map[int64]map[string]*big.Float
+
+// pricesAt returns the built-in prices scaled to the inflation percentage.
+func pricesAt(percent int64) map[string]*big.Float {
+	if prices, ok := scaledPrices.Load(percent); ok {
+		return prices.(map[string]*big.Float)
+	}
+
+	prices := make(map[string]*big.Float, len(basePrices))
+	for key, base := range basePrices {
+		price := big.NewFloat(base)
+		if percent != 100 {
+			price.Mul(price, big.NewFloat(float64(percent)))
+			price.Quo(price, big.NewFloat(100))
+		}
+		prices[key] = price
+	}
+	actual, _ := scaledPrices.LoadOrStore(percent, prices)
+	return actual.(map[string]*big.Float)
+}
+
+// BasePrice returns the base price for key, honoring any price_overrides
+// configured on the provider. Built-in prices are scaled to the provider's
+// price_year; overrides are taken as already quoted in that year. The result
+// is the caller's to modify. It is safe to call on a nil config.
+func (c *ProviderConfig) BasePrice(key string) *big.Float {
+	if c != nil {
+		if override, ok := c.PriceOverrides[key]; ok {
+			return new(big.Float).Copy(override)
+		}
+	}
+
+	price, ok := pricesAt(c.pricePercent())[key]
+	if !ok {
+		return big.NewFloat(0)
+	}
+	return new(big.Float).Copy(price)
+}
+
+// VariantPrice returns the base price for a variant of item (e.g. the
+// "commercial" oven), falling back to defaultVariant for unknown variants.
+func (c *ProviderConfig) VariantPrice(item, variant, defaultVariant string) *big.Float {
+	key := item + "_" + variant
+	if _, ok := basePrices[key]; !ok {
+		key = item + "_" + defaultVariant
+	}
+	return c.BasePrice(key)
+}
+
+// regionalMultipliers scale a store's component and labor costs by its
+// location, as whole-number percentages to keep the arithmetic exact.
+var regionalMultipliers = map[string]int64{
+	"rural":    85,
+	"suburban": 100,
+	"urban":    120,
+	"metro":    150,
+}
+
+// defaultLocation is the location of stores that don't set one.
+const defaultLocation = "suburban"
+
+// LocationKeys returns the sorted locations the regional multiplier table
+// knows about.
+func LocationKeys() []string {
+	keys := make([]string, 0, len(regionalMultipliers))
+	for key := range regionalMultipliers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// loyaltyTiers are the loyalty card tiers, from the entry tier up, and
+// loyaltyMultipliers how many points each earns per dollar spent, as
+// whole-number percentages of the base rate of 1 point a dollar.
+var loyaltyTiers = []string{"bronze", "silver", "gold", "platinum"}
+
+var loyaltyMultipliers = map[string]int64{
+	"bronze":   100,
+	"silver":   125,
+	"gold":     150,
+	"platinum": 200,
+}
+
+// LoyaltyPoints returns the points an order totalling totalCents earns on a
+// loyalty card of the given tier: 1 point per whole dollar, scaled by the
+// tier's multiplier and rounded down. Unknown tiers earn at the base rate.
+func LoyaltyPoints(totalCents int64, tier string) int64 {
+	percent, ok := loyaltyMultipliers[tier]
+	if !ok {
+		percent = 100
+	}
+	return totalCents / 100 * percent / 100
+}
+
+// RegionalCost scales cost by the regional multiplier percent.
+func RegionalCost(cost *big.Float, percent int64) *big.Float {
+	scaled := new(big.Float).Mul(cost, big.NewFloat(float64(percent)))
+	return scaled.Quo(scaled, big.NewFloat(100))
+}
+
+// bulkDiscountTiers are the tiered bulk-discount rules for quantity-priced
+// items (napkin, cracker, silverware and packaging), ordered from the largest
+// minimum quantity down. Percentages are whole numbers to keep the arithmetic exact.
+var bulkDiscountTiers = []struct {
+	minQuantity int64
+	percent     int64
+}{
+	{minQuantity: 100, percent: 15},
+	{minQuantity: 50, percent: 10},
+	{minQuantity: 25, percent: 5},
+}
+
+// BulkPrice is the itemized price of a quantity-priced item.
+type BulkPrice struct {
+	// UnitPrice is the base price of one unit
+	UnitPrice *big.Float
+	// Subtotal is UnitPrice times the quantity
+	Subtotal *big.Float
+	// Discount is the bulk discount taken off the subtotal
+	Discount *big.Float
+	// Total is Subtotal minus Discount, plus the provider upcharge
+	Total *big.Float
+}
+
+// BulkDiscountPercent returns the whole-number discount percentage the
+// bulk-discount tiers grant for quantity.
+func BulkDiscountPercent(quantity int64) int64 {
+	for _, tier := range bulkDiscountTiers {
+		if quantity >= tier.minQuantity {
+			return tier.percent
+		}
+	}
+	return 0
+}
+
+// BulkPriceFor itemizes the price of quantity units of the item at key, applying
+// the bulk-discount tiers and then the provider upcharge.
+func (c *ProviderConfig) BulkPriceFor(key string, quantity int64) BulkPrice {
+	unitPrice := c.BasePrice(key)
+
+	// Subtotal and discount are rounded to cents so the itemized amounts add up
+	subtotal := centsValue(toCents(new(big.Float).Mul(big.NewFloat(float64(quantity)), unitPrice)))
+
+	discount := new(big.Float).Mul(subtotal, big.NewFloat(float64(BulkDiscountPercent(quantity))))
+	discount = centsValue(toCents(discount.Quo(discount, big.NewFloat(100))))
+
+	var total big.Float
+	total.Sub(subtotal, discount)
+
+	var upcharge *big.Float
+	if c != nil {
+		upcharge = c.Upcharge
+	}
+
+	return BulkPrice{
+		UnitPrice: unitPrice,
+		Subtotal:  subtotal,
+		Discount:  discount,
+		Total:     ApplyUpcharge(&total, upcharge),
+	}
+}
+
+// roundingPolicies are the accepted values of the provider's rounding
+// attribute.
+var roundingPolicies = []string{"none", "nearest_cent", "nearest_nickel", "swedish"}
+
+// defaultRounding is the rounding policy when the provider doesn't set one.
+const defaultRounding = "nearest_cent"
+
+// roundingSteps are the whole cents each rounding policy rounds prices to. The
+// none policy has no step: prices keep fractions of a cent.
+var roundingSteps = map[string]int64{
+	"nearest_cent":   1,
+	"nearest_nickel": 5,
+	"swedish":        10,
+}
+
+// roundCents rounds cents to the nearest multiple of step, halves away from
+// zero. Steps below 2 leave cents as they are.
+func roundCents(cents, step int64) int64 {
+	if step < 2 {
+		return cents
+	}
+	sign := int64(1)
+	if cents < 0 {
+		sign, cents = -1, -cents
+	}
+	rounded := cents / step * step
+	if 2*(cents-rounded) >= step {
+		rounded += step
+	}
+	return sign * rounded
+}
+
+// roundingPolicy returns the provider's rounding, or defaultRounding when it
+// isn't set.
+func (c *ProviderConfig) roundingPolicy() string {
+	if c == nil || c.Rounding == "" {
+		return defaultRounding
+	}
+	return c.Rounding
+}
+
+// RoundCents rounds an amount in whole cents by the provider's rounding
+// policy. Under none it is already as exact as cents allow. It is safe to
+// call on a nil config.
+func (c *ProviderConfig) RoundCents(cents int64) int64 {
+	return roundCents(cents, roundingSteps[c.roundingPolicy()])
+}
+
+// Price is the final step of a price computation: it rounds dollars by the
+// provider's rounding policy. Every price and cost attribute goes through it,
+// so the whole configuration rounds alike. It is safe to call on a nil
+// config.
+func (c *ProviderConfig) Price(dollars *big.Float) MoneyValue {
+	if c.roundingPolicy() == "none" {
+		return NewMoneyExact(dollars)
+	}
+	return NewMoneyCents(c.RoundCents(toCents(dollars)))
+}
+
+// ticketItems are the menu items a typical customer orders from, used to
+// estimate the average ticket.
+var ticketItems = []string{"sandwich", "drink", "soup", "salad", "cookie", "brownie", "stroopwafel"}
+
+// AverageTicket returns the average menu price a customer pays per visit: the
+// mean of the ticket items' prices after overrides and upcharge.
+func (c *ProviderConfig) AverageTicket() *big.Float {
+	var upcharge *big.Float
+	if c != nil {
+		upcharge = c.Upcharge
+	}
+
+	// Reuse one price across items rather than allocating per item
+	var total, price big.Float
+	for _, key := range ticketItems {
+		c.basePriceInto(&price, key)
+		if upcharge != nil && upcharge.Sign() != 0 {
+			price.Add(&price, upcharge)
+		}
+		total.Add(&total, &price)
+	}
+	return total.Quo(&total, big.NewFloat(float64(len(ticketItems))))
+}
+
+// basePriceInto sets z to BasePrice(key) without allocating a new value.
+func (c *ProviderConfig) basePriceInto(z *big.Float, key string) {
+	if c != nil {
+		if override, ok := c.PriceOverrides[key]; ok {
+			z.Copy(override)
+			return
+		}
+	}
+
+	if price, ok := pricesAt(c.pricePercent())[key]; ok {
+		z.Copy(price)
+		return
+	}
+	z.SetFloat64(0)
+}
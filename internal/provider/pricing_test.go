@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"math/big"
+	"testing"
+)
+
+// benchmarkConfig is a provider configured for a non-base price year with an
+// upcharge, so benchmarks exercise the inflation and upcharge arithmetic.
+func benchmarkConfig() *ProviderConfig {
+	return &ProviderConfig{
+		Upcharge:       big.NewFloat(0.5),
+		PriceOverrides: map[string]*big.Float{"cookie": big.NewFloat(1.75)},
+		PricePercent:   inflationTable[2026],
+	}
+}
+
+func BenchmarkBasePrice(b *testing.B) {
+	config := benchmarkConfig()
+	keys := PriceKeys()
+	b.ReportAllocs()
+	for i := 0; b.Loop(); i++ {
+		config.BasePrice(keys[i%len(keys)])
+	}
+}
+
+func BenchmarkVariantPrice(b *testing.B) {
+	config := benchmarkConfig()
+	b.ReportAllocs()
+	for b.Loop() {
+		config.VariantPrice("oven", "commercial", "standard")
+	}
+}
+
+func BenchmarkBulkPriceFor(b *testing.B) {
+	config := benchmarkConfig()
+	quantity := int64(120)
+	b.ReportAllocs()
+	for b.Loop() {
+		config.BulkPriceFor("napkin", quantity)
+	}
+}
+
+func BenchmarkAverageTicket(b *testing.B) {
+	config := benchmarkConfig()
+	b.ReportAllocs()
+	for b.Loop() {
+		config.AverageTicket()
+	}
+}
+
+func TestBasePrice(t *testing.T) {
+	config := benchmarkConfig()
+
+	tests := map[string]string{
+		"sandwich": "5.3",  // 5.00 scaled to 106%
+		"cookie":   "1.75", // override, not scaled
+		"unknown":  "0",
+	}
+	for key, want := range tests {
+		if got := config.BasePrice(key).Text('f', -1); got != want {
+			t.Errorf("BasePrice(%q) = %s, want %s", key, got, want)
+		}
+	}
+
+	// Callers may modify the returned price without affecting later calls
+	config.BasePrice("sandwich").SetInt64(0)
+	if got := config.BasePrice("sandwich").Text('f', -1); got != "5.3" {
+		t.Errorf("BasePrice(%q) after modifying a result = %s, want 5.3", "sandwich", got)
+	}
+
+	var nilConfig *ProviderConfig
+	if got := nilConfig.BasePrice("sandwich").Text('f', -1); got != "5" {
+		t.Errorf("nil config BasePrice(%q) = %s, want 5", "sandwich", got)
+	}
+}
+
+func TestLoyaltyPoints(t *testing.T) {
+	tests := []struct {
+		totalCents int64
+		tier       string
+		want       int64
+	}{
+		{3380, "bronze", 33},
+		{3380, "silver", 41}, // 33 whole dollars at 125%
+		{3380, "gold", 49},
+		{3380, "platinum", 66},
+		{99, "platinum", 0},
+		{1000, "unknown", 10},
+	}
+
+	for _, tt := range tests {
+		if got := LoyaltyPoints(tt.totalCents, tt.tier); got != tt.want {
+			t.Errorf("LoyaltyPoints(%d, %q) = %d, want %d", tt.totalCents, tt.tier, got, tt.want)
+		}
+	}
+}
+
+func TestPrice(t *testing.T) {
+	price := big.NewFloat(4.8285) // 4.50 scaled to 107.3%
+
+	tests := map[string]string{
+		"":               "4.83",
+		"none":           "4.8285",
+		"nearest_cent":   "4.83",
+		"nearest_nickel": "4.85",
+		"swedish":        "4.8",
+	}
+	for rounding, want := range tests {
+		config := &ProviderConfig{Rounding: rounding}
+		if got := config.Price(price).ValueBigFloat().Text('f', -1); got != want {
+			t.Errorf("Price(4.8285) with rounding %q = %s, want %s", rounding, got, want)
+		}
+	}
+
+	var nilConfig *ProviderConfig
+	if got := nilConfig.Price(price).String(); got != "4.83" {
+		t.Errorf("nil config Price(4.8285) = %s, want 4.83", got)
+	}
+}
+
+func TestRoundCents(t *testing.T) {
+	tests := []struct {
+		cents, step, want int64
+	}{
+		{482, 1, 482},
+		{482, 5, 480},
+		{483, 5, 485},
+		{485, 10, 490}, // halves round up
+		{484, 10, 480},
+		{-483, 5, -485},
+	}
+	for _, tt := range tests {
+		if got := roundCents(tt.cents, tt.step); got != tt.want {
+			t.Errorf("roundCents(%d, %d) = %d, want %d", tt.cents, tt.step, got, tt.want)
+		}
+	}
+}
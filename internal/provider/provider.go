@@ -2,16 +2,23 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"maps"
 	"math/big"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 // Ensure hwProvider satisfies various provider interfaces.
@@ -26,17 +33,100 @@ type hwProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+	// records are put in the registry of every configuration, standing in
+	// for resources the configuration doesn't manage; see NewWithRecords
+	records map[string]any
 }
 
 // hwProviderModel describes the provider data model.
 type hwProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	Upcharge types.Number `tfsdk:"upcharge"`
+	Endpoint            types.String `tfsdk:"endpoint"`
+	Upcharge            types.Number `tfsdk:"upcharge"`
+	PriceOverrides      types.Map    `tfsdk:"price_overrides"`
+	AsOf                types.String `tfsdk:"as_of"`
+	PriceYear           types.Int64  `tfsdk:"price_year"`
+	TaxRate             types.Number `tfsdk:"tax_rate"`
+	Seed                types.Int64  `tfsdk:"seed"`
+	MetricsFile         types.String `tfsdk:"metrics_file"`
+	MenuCsvPath         types.String `tfsdk:"menu_csv_path"`
+	EventLogPath        types.String `tfsdk:"event_log_path"`
+	IdFormat            types.String `tfsdk:"id_format"`
+	TrashRetention      types.String `tfsdk:"trash_retention"`
+	HappyHour           types.Object `tfsdk:"happy_hour"`
+	DefaultTags         types.Map    `tfsdk:"default_tags"`
+	StrictCatalog       types.Bool   `tfsdk:"strict_catalog"`
+	ReplaceOnKindChange types.Bool   `tfsdk:"replace_on_kind_change"`
+	AllowDuplicateNames types.Bool   `tfsdk:"allow_duplicate_names"`
+	Rounding            types.String `tfsdk:"rounding"`
+	EmitHaikus          types.Bool   `tfsdk:"emit_haikus"`
+	Drift               types.Set    `tfsdk:"drift"`
+	BackendPath         types.String `tfsdk:"backend_path"`
+}
+
+// happyHourModel describes the happy_hour attribute data model.
+type happyHourModel struct {
+	Days     types.List   `tfsdk:"days"`
+	Start    types.String `tfsdk:"start"`
+	End      types.String `tfsdk:"end"`
+	Discount types.Number `tfsdk:"discount"`
+	At       types.String `tfsdk:"at"`
 }
 
 // ProviderConfig holds the provider configuration data passed to resources
 type ProviderConfig struct {
 	Upcharge *big.Float
+	// PriceOverrides replaces base prices in the pricing engine, keyed by
+	// the same item keys as basePrices; it includes the menu_csv_path rows
+	PriceOverrides map[string]*big.Float
+	// AsOf is the date time-based attributes (such as book_value) are
+	// computed for; the zero value means today
+	AsOf time.Time
+	// PricePercent is the inflation-table price level for price_year as a
+	// whole-number percentage; zero means the base year (100)
+	PricePercent int64
+	// TaxRate is the default sales tax percentage; nil means
+	// defaultTaxPercent
+	TaxRate *big.Float
+	// Seed seeds the random number generators from Rand; unset means 0
+	Seed int64
+	// Metrics counts and times the CRUD operations of every resource
+	Metrics *Metrics
+	// Catalogs caches the values catalog data sources build, for reuse
+	// across data blocks
+	Catalogs *CatalogCache
+	// Events logs every resource Create, Update and Delete; nil when no
+	// event_log_path is set
+	Events *EventLog
+	// IdFormat generates resource IDs from the id_format template; nil
+	// means defaultIdFormat
+	IdFormat *IdFormat
+	// TrashRetention is how long the records of deleted resources stay in
+	// the registry's trash; zero deletes them outright
+	TrashRetention time.Duration
+	// HappyHour discounts drinks and desserts in its window; nil when no
+	// happy_hour is set
+	HappyHour *HappyHour
+	// DefaultTags are merged under every resource's tags into its tags_all
+	DefaultTags map[string]string
+	// StrictCatalog requires catalog-backed values, such as hw_meat kinds,
+	// to name an entry of their catalog
+	StrictCatalog bool
+	// ReplaceOnKindChange plans a replacement, instead of an in-place
+	// update, when a resource's kind, size or style changes
+	ReplaceOnKindChange bool
+	// AllowDuplicateNames lets several hw_store resources share a name
+	AllowDuplicateNames bool
+	// Rounding is the rounding policy Price applies to every price, one of
+	// roundingPolicies; empty means defaultRounding
+	Rounding string
+	// EmitHaikus fills in every resource's haiku attribute
+	EmitHaikus bool
+	// Drift lists the resource types whose noise attributes change on every
+	// refresh
+	Drift []string
+	// Registry holds the records of resources managed by this provider
+	// process, for lookups across resource references
+	Registry *Registry
 }
 
 // ApplyUpcharge applies the upcharge flat amount to a base price
@@ -68,6 +158,108 @@ func (p *hwProvider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				MarkdownDescription: "Flat dollar amount to add to all resource prices (e.g., 0.50 adds $0.50 to each item, 1.00 adds $1.00)",
 				Optional:            true,
 			},
+			"price_overrides": schema.MapAttribute{
+				ElementType:         types.NumberType,
+				MarkdownDescription: "Map of item key to base price that overrides the built-in price list (e.g., `{ sandwich = 6.50, cook_expert = 250 }`). Keys are menu items (`sandwich`, `napkin`, `dogtreat_large`, ...), equipment and staff variants (`oven_commercial`, `cook_junior`, `tables_medium`, ...), and the `store_*` component estimates. The upcharge is still added on top.",
+				Optional:            true,
+			},
+			"price_year": schema.Int64Attribute{
+				MarkdownDescription: "Year to quote prices in. Built-in prices are scaled by the inflation table (2020-2030, base year 2024) so the same configuration can be compared across years; `price_overrides` are used as given. Defaults to 2024.",
+				Optional:            true,
+			},
+			"as_of": schema.StringAttribute{
+				MarkdownDescription: "Date (`YYYY-MM-DD`) to compute time-based attributes such as equipment `book_value` for. Defaults to today; set it to keep plans deterministic.",
+				Optional:            true,
+			},
+			"tax_rate": schema.NumberAttribute{
+				MarkdownDescription: "Default sales tax percentage, from 0 to 100, for resources that charge tax such as `hw_receipt` (e.g., `data.hw_tax_rates.ca.rate`). Defaults to 8.",
+				Optional:            true,
+			},
+			"seed": schema.Int64Attribute{
+				MarkdownDescription: "Seed for everything the provider randomizes. The same seed gives the same results across plan and apply and between runs; change it for a different, equally reproducible outcome. Defaults to 0.",
+				Optional:            true,
+			},
+			"metrics_file": schema.StringAttribute{
+				MarkdownDescription: "Path to write a JSON summary of the provider's operation counts and timings to when it shuts down (the same figures `hw_provider_stats` reports).",
+				Optional:            true,
+			},
+			"menu_csv_path": schema.StringAttribute{
+				MarkdownDescription: "Path to a CSV custom menu of `item,price` rows (an `item,price` header row is optional) overlaid on the built-in price list. Items are the keys `price_overrides` accepts; `price_overrides` wins when both price an item. Every invalid row is reported with its line number.",
+				Optional:            true,
+			},
+			"event_log_path": schema.StringAttribute{
+				MarkdownDescription: "Path to a file to append a JSON line to for every resource Create, Update and Delete (`time`, `resource_type`, `action` and `id`), for auditing and out-of-band integrations. The file is created if needed and never truncated.",
+				Optional:            true,
+			},
+			"backend_path": schema.StringAttribute{
+				MarkdownDescription: "Path to a JSON file to keep the provider's records in between runs, standing in for a backend API. The file is created if needed. Unset, records only live for one Terraform command, so checks against referenced resources and actions only see the resources that command reads or changes. With it set they see every resource in the file, and a resource whose record has been removed from the file is dropped from state on refresh, so the next plan creates it again. Set it before the first apply: resources already in state without a record count as deleted, and importing needs the record.",
+				Optional:            true,
+			},
+			"id_format": schema.StringAttribute{
+				MarkdownDescription: "Template for the IDs resources generate (e.g., `\"{type}-{name}-{random}\"`). Placeholders: `{type}` is the resource's ID prefix (`bread`, `camera`, ...), `{name}` is the rest of the built-in ID (`rye-3` for a rye hw_bread), and `{random}` is 8 hex digits drawn from `seed`. `{type}` and `{name}` are required, and each placeholder may appear once. Defaults to `\"{type}-{name}\"`, the built-in IDs. Existing resources keep their IDs until a change makes them generate a new one.",
+				Optional:            true,
+			},
+			"trash_retention": schema.StringAttribute{
+				MarkdownDescription: "How long to keep the records of deleted resources in the provider's trash, as a Go duration (e.g., `\"1h\"`). Trashed records are listed by `hw_trash` and can be brought back with the `hw_restore` action. Requires `backend_path`, where the trash is kept between commands. Unset deletes records outright.",
+				Optional:            true,
+			},
+			"default_tags": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags to apply to every resource, like the AWS provider's `default_tags`. Each resource's `tags_all` merges them with its own `tags`, which win on a shared key.",
+				Optional:            true,
+			},
+			"replace_on_kind_change": schema.BoolAttribute{
+				MarkdownDescription: "Whether changing a resource's `kind`, `size` or `style` replaces it instead of updating it in place. Defaults to false: the resource is updated and, where the ID is built from that attribute, gets a new ID. Set it to compare the two update strategies in a plan.",
+				Optional:            true,
+			},
+			"allow_duplicate_names": schema.BoolAttribute{
+				MarkdownDescription: "Whether several `hw_store` resources may share a `name`. Defaults to false: like a real API, creating or renaming a store to a name another store has fails with a conflict error. The provider only knows every store's name with `backend_path` set; without it, a store the apply leaves unchanged isn't checked. Stores sharing a name also share an ID, even with `{random}` in `id_format`, since it is drawn from `seed` for the store's name.",
+				Optional:            true,
+			},
+			"rounding": schema.StringAttribute{
+				MarkdownDescription: "How prices are rounded, as the last step of every price and cost computation: `none` keeps fractions of a cent, `nearest_cent` rounds to the cent, `nearest_nickel` to 5 cents, and `swedish` to 10 cents, for a till without small coins. Halves round up. Defaults to `nearest_cent`. `hw_receipt` rounds its total the same way and reports the difference as `rounding_adjustment`.",
+				Optional:            true,
+			},
+			"emit_haikus": schema.BoolAttribute{
+				MarkdownDescription: "Whether to write every resource a haiku in its computed `haiku` attribute. Each haiku is picked from the resource's type and attributes, so it changes only when they do. Defaults to false, which leaves `haiku` null.",
+				Optional:            true,
+			},
+			"drift": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Resource types whose noise attributes change on every refresh, for practicing `lifecycle { ignore_changes }`. Only `hw_store` has one so far: its `last_synced_at`. Unset, nothing drifts.",
+				Optional:            true,
+			},
+			"strict_catalog": schema.BoolAttribute{
+				MarkdownDescription: "Whether to require catalog-backed values to come from their catalog: `hw_meat` kinds must be listed by `hw_deli_meats`. Near misses get a did-you-mean suggestion. Defaults to false, which accepts any value.",
+				Optional:            true,
+			},
+			"happy_hour": schema.SingleNestedAttribute{
+				MarkdownDescription: "A weekly window in which `hw_drink`, `hw_cookie`, `hw_brownie` and `hw_stroopwafel` prices are discounted; their `happy_hour_active` tells whether it applied. The window is checked whenever prices are computed, against the current local time unless `at` pins it.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"days": schema.ListAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "Lowercase days of the week the window repeats on (e.g., `[\"thursday\", \"friday\"]`)",
+						Required:            true,
+					},
+					"start": schema.StringAttribute{
+						MarkdownDescription: "Start of the window in 24-hour `HH:MM` format (e.g., `16:00`)",
+						Required:            true,
+					},
+					"end": schema.StringAttribute{
+						MarkdownDescription: "End of the window in 24-hour `HH:MM` format, after `start` (`24:00` ends at midnight)",
+						Required:            true,
+					},
+					"discount": schema.NumberAttribute{
+						MarkdownDescription: "Percentage taken off the price, upcharge included, from 0 to 100",
+						Required:            true,
+					},
+					"at": schema.StringAttribute{
+						MarkdownDescription: "RFC 3339 time to check the window at instead of now (e.g., `2024-06-07T17:30:00-07:00`), to keep plans deterministic",
+						Optional:            true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -89,18 +281,287 @@ func (p *hwProvider) Configure(ctx context.Context, req provider.ConfigureReques
 		upcharge = data.Upcharge.ValueBigFloat()
 	}
 
-	// Create provider config with upcharge
+	// Overlay the custom menu CSV, if any, on the built-in prices
+	priceOverrides := map[string]*big.Float{}
+	if !data.MenuCsvPath.IsNull() && !data.MenuCsvPath.IsUnknown() {
+		menuPrices, diags := loadMenuCSV(data.MenuCsvPath.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		maps.Copy(priceOverrides, menuPrices)
+	}
+
+	// Extract price overrides, rejecting keys the pricing engine doesn't know
+	if !data.PriceOverrides.IsNull() && !data.PriceOverrides.IsUnknown() {
+		var overrides map[string]types.Number
+		resp.Diagnostics.Append(data.PriceOverrides.ElementsAs(ctx, &overrides, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for key, price := range overrides {
+			if _, ok := basePrices[key]; !ok {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("price_overrides").AtMapKey(key),
+					"Unknown Price Override",
+					fmt.Sprintf("%q is not a priced item. Valid keys are: %s", key, strings.Join(PriceKeys(), ", ")),
+				)
+				continue
+			}
+			if price.IsNull() || price.IsUnknown() {
+				continue
+			}
+			if price.ValueBigFloat().Sign() < 0 {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("price_overrides").AtMapKey(key),
+					"Invalid Price Override",
+					fmt.Sprintf("The price for %q must not be negative, got %s.", key, price.ValueBigFloat().String()),
+				)
+				continue
+			}
+			priceOverrides[key] = price.ValueBigFloat()
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Extract the as_of date (default to today, resolved when used)
+	var asOf time.Time
+	if !data.AsOf.IsNull() && !data.AsOf.IsUnknown() {
+		parsed, err := time.Parse(dateLayout, data.AsOf.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("as_of"),
+				"Invalid As Of Date",
+				fmt.Sprintf("as_of must be a date in YYYY-MM-DD format, got %q.", data.AsOf.ValueString()),
+			)
+			return
+		}
+		asOf = parsed
+	}
+
+	// Look up the price level for price_year (default to the base year)
+	pricePercent := inflationTable[priceBaseYear]
+	if !data.PriceYear.IsNull() && !data.PriceYear.IsUnknown() {
+		percent, ok := inflationTable[data.PriceYear.ValueInt64()]
+		if !ok {
+			years := PriceYears()
+			resp.Diagnostics.AddAttributeError(
+				path.Root("price_year"),
+				"Unsupported Price Year",
+				fmt.Sprintf("The inflation table covers %d through %d, got %d.", years[0], years[len(years)-1], data.PriceYear.ValueInt64()),
+			)
+			return
+		}
+		pricePercent = percent
+	}
+
+	// Extract the default tax rate (nil falls back to defaultTaxPercent)
+	var taxRate *big.Float
+	if !data.TaxRate.IsNull() && !data.TaxRate.IsUnknown() {
+		taxRate = data.TaxRate.ValueBigFloat()
+		if taxRate.Sign() < 0 || taxRate.Cmp(big.NewFloat(100)) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("tax_rate"),
+				"Invalid Tax Rate",
+				fmt.Sprintf("tax_rate must be from 0 to 100, got %s.", taxRate.String()),
+			)
+			return
+		}
+	}
+
+	// Extract the seed (default to 0 so randomness is reproducible)
+	var seed int64
+	if !data.Seed.IsNull() && !data.Seed.IsUnknown() {
+		seed = data.Seed.ValueInt64()
+	}
+
+	// Parse the ID template (nil falls back to defaultIdFormat)
+	var idFormat *IdFormat
+	if !data.IdFormat.IsNull() && !data.IdFormat.IsUnknown() {
+		var err error
+		idFormat, err = ParseIdFormat(data.IdFormat.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id_format"),
+				"Invalid ID Format",
+				fmt.Sprintf("id_format %q is not a valid template: %s.", data.IdFormat.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	// Parse the trash retention (zero turns soft delete off)
+	var trashRetention time.Duration
+	if !data.TrashRetention.IsNull() && !data.TrashRetention.IsUnknown() {
+		retention, err := time.ParseDuration(data.TrashRetention.ValueString())
+		if err != nil || retention <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("trash_retention"),
+				"Invalid Trash Retention",
+				fmt.Sprintf("trash_retention must be a positive duration such as 30m or 24h, got %q.", data.TrashRetention.ValueString()),
+			)
+			return
+		}
+		trashRetention = retention
+
+		// Resources are destroyed and restored by different commands, so
+		// the trash has to outlive the provider process
+		if data.BackendPath.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("trash_retention"),
+				"Trash Retention Needs a Backend",
+				"trash_retention keeps deleted records for a later terraform command to restore, so it requires backend_path: without it, records only live for one command.",
+			)
+			return
+		}
+	}
+
+	// Validate the happy hour window, if any
+	var happyHour *HappyHour
+	if !data.HappyHour.IsNull() && !data.HappyHour.IsUnknown() {
+		var window happyHourModel
+		resp.Diagnostics.Append(data.HappyHour.As(ctx, &window, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var days []string
+		resp.Diagnostics.Append(window.Days.ElementsAs(ctx, &days, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var err error
+		happyHour, err = NewHappyHour(days, window.Start.ValueString(), window.End.ValueString(), window.Discount.ValueBigFloat(), window.At.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("happy_hour"),
+				"Invalid Happy Hour",
+				fmt.Sprintf("The happy_hour window is invalid: %s.", err),
+			)
+			return
+		}
+	}
+
+	// Extract the default tags
+	var defaultTags map[string]string
+	if !data.DefaultTags.IsNull() && !data.DefaultTags.IsUnknown() {
+		resp.Diagnostics.Append(data.DefaultTags.ElementsAs(ctx, &defaultTags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Validate the rounding policy (empty falls back to defaultRounding)
+	rounding := data.Rounding.ValueString()
+	if !data.Rounding.IsNull() && !data.Rounding.IsUnknown() && !slices.Contains(roundingPolicies, rounding) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rounding"),
+			"Invalid Rounding",
+			fmt.Sprintf("rounding must be one of %s, got %q.%s", strings.Join(roundingPolicies, ", "), rounding, didYouMean(rounding, roundingPolicies)),
+		)
+		return
+	}
+
+	// Validate the resource types set to drift
+	var drift []string
+	if !data.Drift.IsNull() && !data.Drift.IsUnknown() {
+		resp.Diagnostics.Append(data.Drift.ElementsAs(ctx, &drift, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, typeName := range drift {
+			if !slices.Contains(driftingResources, typeName) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("drift"),
+					"Invalid Drift Resource",
+					fmt.Sprintf("%q has no attributes that drift. Resource types that can drift: %s.%s", typeName, strings.Join(driftingResources, ", "), didYouMean(typeName, driftingResources)),
+				)
+			}
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Open the event log, if any, so a bad path fails early
+	var events *EventLog
+	if !data.EventLogPath.IsNull() && !data.EventLogPath.IsUnknown() {
+		var err error
+		events, err = NewEventLog(data.EventLogPath.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("event_log_path"),
+				"Unable to Open Event Log",
+				fmt.Sprintf("The event log could not be opened for appending: %s", err),
+			)
+			return
+		}
+	}
+
+	// Open the backend, if any, so an unreadable file fails early
+	registry := NewRegistry()
+	if !data.BackendPath.IsNull() && !data.BackendPath.IsUnknown() {
+		var err error
+		registry, err = OpenRegistry(data.BackendPath.ValueString(), resourceSchemas(ctx, p.Resources(ctx)))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("backend_path"),
+				"Unable to Open Backend",
+				fmt.Sprintf("The backend file could not be read: %s", err),
+			)
+			return
+		}
+	}
+
+	// Create provider config with upcharge, price overrides, price level,
+	// as_of date, tax rate, seed, metrics, catalog cache, event log, ID
+	// format, trash retention, happy hour, default tags, strict catalog,
+	// replacement strategy, duplicate names, rounding, haikus, drift and
+	// registry
 	config := &ProviderConfig{
-		Upcharge: upcharge,
+		Upcharge:            upcharge,
+		PriceOverrides:      priceOverrides,
+		PricePercent:        pricePercent,
+		AsOf:                asOf,
+		TaxRate:             taxRate,
+		Seed:                seed,
+		Metrics:             NewMetrics(),
+		Catalogs:            NewCatalogCache(),
+		Events:              events,
+		IdFormat:            idFormat,
+		TrashRetention:      trashRetention,
+		HappyHour:           happyHour,
+		DefaultTags:         defaultTags,
+		StrictCatalog:       data.StrictCatalog.ValueBool(),
+		ReplaceOnKindChange: data.ReplaceOnKindChange.ValueBool(),
+		AllowDuplicateNames: data.AllowDuplicateNames.ValueBool(),
+		Rounding:            rounding,
+		EmitHaikus:          data.EmitHaikus.ValueBool(),
+		Drift:               drift,
+		Registry:            registry,
+	}
+	for id, record := range p.records {
+		config.Registry.Put(id, record)
 	}
 
-	// Pass config to both resources and data sources (for menu pricing with upcharge)
+	// Write the metrics out when the provider shuts down
+	if path := data.MetricsFile.ValueString(); path != "" {
+		dumpMetricsOnShutdown(config.Metrics, path)
+	}
+
+	// Pass config to resources, data sources (for menu pricing with upcharge)
+	// and actions
 	resp.DataSourceData = config
 	resp.ResourceData = config
+	resp.ActionData = config
 }
 
 func (p *hwProvider) Resources(ctx context.Context) []func() resource.Resource {
-	return []func() resource.Resource{
+	resources := []func() resource.Resource{
 		NewBreadResource,
 		NewMeatResource,
 		NewSandwichResource,
@@ -120,8 +581,48 @@ func (p *hwProvider) Resources(ctx context.Context) []func() resource.Resource {
 		NewTablesResource,
 		NewChairsResource,
 		NewFridgeResource,
+		NewAmenityResource,
 		NewStoreResource,
+		NewCustomerResource,
+		NewOrderResource,
+		NewReservationResource,
+		NewWaitlistResource,
+		NewReviewResource,
+		NewEmployeeResource,
+		NewSecurityCameraResource,
+		NewMusicPlaylistResource,
+		NewDecorResource,
+		NewParkingLotResource,
+		NewDumpsterResource,
+		NewCompostBinResource,
+		NewRecyclingBinResource,
+		NewIceMachineResource,
+		NewPantryResource,
+		NewSpiceRackResource,
+		NewToGoBoxResource,
+		NewCupResource,
+		NewStrawResource,
+		NewReceiptResource,
+		NewManagerResource,
+		NewJanitorResource,
+		NewWifiResource,
+		NewStickerResource,
+		NewDessertCaseResource,
+		NewFireExtinguisherResource,
+		NewExitSignResource,
+		NewOccupancyPermitResource,
+		NewSeasonalMenuResource,
+		NewTipJarResource,
+		NewInsurancePolicyResource,
+		NewMarketingCampaignResource,
+		NewSupplyOrderResource,
+	}
+
+	// Count and time every resource's CRUD operations
+	for i, newResource := range resources {
+		resources[i] = metered(newResource)
 	}
+	return resources
 }
 
 func (p *hwProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
@@ -134,15 +635,42 @@ func (p *hwProvider) DataSources(ctx context.Context) []func() datasource.DataSo
 		NewCondimentsDataSource,
 		NewOrderDataSource,
 		NewMenuDataSource,
+		NewFranchiseReportDataSource,
+		NewBreakEvenDataSource,
+		NewMusicCatalogDataSource,
+		NewSpiceCatalogDataSource,
+		NewSoupsDataSource,
+		NewDessertsDataSource,
+		NewCatalogDataSource,
+		NewTaxRatesDataSource,
+		NewStoreStatsDataSource,
+		NewIngredientSubstitutionsDataSource,
+		NewProviderStatsDataSource,
+		NewTrashDataSource,
+		NewSimulationDataSource,
+		NewOrderQueueDataSource,
+		NewCondimentPairingsDataSource,
 	}
 }
 
 func (p *hwProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewSlugifyFunction,
+		NewSplitBillFunction,
+		NewLoyaltyPointsFunction,
+		NewConvertUnitsFunction,
+		NewTranslateItemFunction,
+	}
 }
 
 func (p *hwProvider) Actions(ctx context.Context) []func() action.Action {
-	return []func() action.Action{}
+	return []func() action.Action{
+		NewRestoreAction,
+		NewFulfillOrdersAction,
+		NewDailySalesReportAction,
+		NewFireDrillAction,
+		NewRefundOrderAction,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -152,3 +680,15 @@ func New(version string) func() provider.Provider {
 		}
 	}
 }
+
+// NewWithRecords is New with a registry seeded with records, keyed by ID,
+// as if the resources they belong to had already been read. Tests use it to
+// reference records (such as an expert hw_cook) without managing them.
+func NewWithRecords(version string, records map[string]any) func() provider.Provider {
+	return func() provider.Provider {
+		return &hwProvider{
+			version: version,
+			records: records,
+		}
+	}
+}
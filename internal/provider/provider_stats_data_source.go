@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProviderStatsDataSource{}
+
+func NewProviderStatsDataSource() datasource.DataSource {
+	return &ProviderStatsDataSource{}
+}
+
+// ProviderStatsDataSource defines the data source implementation.
+type ProviderStatsDataSource struct {
+	client *ProviderConfig
+}
+
+// ProviderStatsDataSourceModel describes the data source data model.
+type ProviderStatsDataSourceModel struct {
+	Operations      types.List   `tfsdk:"operations"`
+	TotalOperations types.Int64  `tfsdk:"total_operations"`
+	Id              types.String `tfsdk:"id"`
+}
+
+// operationStatsAttrTypes are the attribute types of an operations list
+// element.
+var operationStatsAttrTypes = map[string]attr.Type{
+	"resource_type": types.StringType,
+	"operation":     types.StringType,
+	"count":         types.Int64Type,
+	"total_ms":      types.Float64Type,
+	"average_ms":    types.Float64Type,
+	"max_ms":        types.Float64Type,
+}
+
+// operationStatsModel is an element of the operations list.
+type operationStatsModel struct {
+	ResourceType string  `tfsdk:"resource_type"`
+	Operation    string  `tfsdk:"operation"`
+	Count        int64   `tfsdk:"count"`
+	TotalMs      float64 `tfsdk:"total_ms"`
+	AverageMs    float64 `tfsdk:"average_ms"`
+	MaxMs        float64 `tfsdk:"max_ms"`
+}
+
+func (d *ProviderStatsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_provider_stats"
+}
+
+func (d *ProviderStatsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `How much work the provider has done so far in this run: the creates, reads, updates, and deletes of every resource type, with how long they took. Handy for seeing where time goes in a large configuration.
+
+**Example Usage:**
+
+` + "```hcl" + `
+provider "hw" {
+  # Also write the final figures out when the provider exits
+  metrics_file = "${path.root}/hw-metrics.json"
+}
+
+data "hw_provider_stats" "after_stores" {
+  depends_on = [hw_store.main, hw_store.uptown]
+}
+
+output "slowest_operation" {
+  value = one([
+    for op in data.hw_provider_stats.after_stores.operations : op
+    if op.max_ms == max(data.hw_provider_stats.after_stores.operations[*].max_ms...)
+  ])
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates **provider-wide instrumentation**: every resource is wrapped so its operations are counted and timed
+- Each plan or apply runs a fresh provider process, so figures cover the current run up to the moment the data source is read
+- Use ` + "`depends_on`" + ` to read the stats after the resources you want counted
+- Set the provider's ` + "`metrics_file`" + ` for the complete figures once the run finishes
+
+*Stopwatch in the wings,*
+*Every order timed and logged,*
+*The rush, in numbers.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"operations": schema.ListNestedAttribute{
+				MarkdownDescription: "Stats per resource type and operation, sorted by resource type and then create, read, update, delete",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_type": schema.StringAttribute{
+							MarkdownDescription: "Resource type, such as `hw_store`",
+							Computed:            true,
+						},
+						"operation": schema.StringAttribute{
+							MarkdownDescription: "Operation: create, read, update, or delete",
+							Computed:            true,
+						},
+						"count": schema.Int64Attribute{
+							MarkdownDescription: "Number of times the operation ran",
+							Computed:            true,
+						},
+						"total_ms": schema.Float64Attribute{
+							MarkdownDescription: "Total time spent in the operation, in milliseconds",
+							Computed:            true,
+						},
+						"average_ms": schema.Float64Attribute{
+							MarkdownDescription: "Average time per operation, in milliseconds",
+							Computed:            true,
+						},
+						"max_ms": schema.Float64Attribute{
+							MarkdownDescription: "Longest single operation, in milliseconds",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"total_operations": schema.Int64Attribute{
+				MarkdownDescription: "Number of operations across all resource types",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ProviderStatsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	d.client = config
+}
+
+func (d *ProviderStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProviderStatsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var metrics *Metrics
+	if d.client != nil {
+		metrics = d.client.Metrics
+	}
+
+	var total int64
+	operations := []operationStatsModel{}
+	for _, stats := range metrics.Snapshot() {
+		total += stats.Count
+		operations = append(operations, operationStatsModel(stats))
+	}
+
+	operationsValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: operationStatsAttrTypes}, operations)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Operations = operationsValue
+	data.TotalOperations = types.Int64Value(total)
+	data.Id = types.StringValue("provider-stats")
+
+	tflog.Trace(ctx, "read provider stats data source", map[string]any{
+		"total_operations": total,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// int64RangeValidator checks that a whole-number attribute such as a
+// quantity lies between min and max, reporting summary when it doesn't.
+// Attributes without an upper bound use math.MaxInt64 as max.
+type int64RangeValidator struct {
+	summary  string
+	min, max int64
+}
+
+var _ validator.Int64 = int64RangeValidator{}
+
+func (v int64RangeValidator) Description(ctx context.Context) string {
+	if v.max == math.MaxInt64 {
+		return fmt.Sprintf("must be at least %d", v.min)
+	}
+	return fmt.Sprintf("must be from %d to %d", v.min, v.max)
+}
+
+func (v int64RangeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v int64RangeValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueInt64()
+	if value >= v.min && value <= v.max {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		v.summary,
+		fmt.Sprintf("%s %s, got %d.", req.Path, v.Description(ctx), value),
+	)
+}
@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"hash/fnv"
+	"math/rand/v2"
+)
+
+// Rand returns the random number generator for key, seeded from the
+// provider's seed. Every stochastic feature draws from its own key (such as
+// the ID of the resource it randomizes), so the same seed and key always give
+// the same sequence: plan and apply agree, and so do repeated test runs,
+// whatever order resources are processed in. It is safe to call on a nil
+// config.
+func (c *ProviderConfig) Rand(key string) *rand.Rand {
+	var seed int64
+	if c != nil {
+		seed = c.Seed
+	}
+
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	return rand.New(rand.NewPCG(uint64(seed), h.Sum64()))
+}
@@ -0,0 +1,460 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ReceiptResource{}
+var _ resource.ResourceWithImportState = &ReceiptResource{}
+
+func NewReceiptResource() resource.Resource {
+	return &ReceiptResource{}
+}
+
+type ReceiptResource struct {
+	client *ProviderConfig
+}
+
+type ReceiptResourceModel struct {
+	OrderId            types.String `tfsdk:"order_id"`
+	BagId              types.String `tfsdk:"bag_id"`
+	TaxPercent         types.Number `tfsdk:"tax_percent"`
+	Subtotal           MoneyValue   `tfsdk:"subtotal"`
+	Tax                MoneyValue   `tfsdk:"tax"`
+	Total              MoneyValue   `tfsdk:"total"`
+	RoundingAdjustment MoneyValue   `tfsdk:"rounding_adjustment"`
+	Text               types.String `tfsdk:"text"`
+	Json               types.String `tfsdk:"json"`
+	Tags               types.Map    `tfsdk:"tags"`
+	TagsAll            types.Map    `tfsdk:"tags_all"`
+	Haiku              types.String `tfsdk:"haiku"`
+	Id                 types.String `tfsdk:"id"`
+}
+
+// defaultTaxPercent is the sales tax of receipts that don't set tax_percent,
+// when the provider doesn't set a tax_rate either.
+const defaultTaxPercent = 8.0
+
+// TaxPercent returns the provider's tax_rate, or defaultTaxPercent when it
+// isn't set. It is safe to call on a nil config.
+func (c *ProviderConfig) TaxPercent() float64 {
+	if c == nil || c.TaxRate == nil {
+		return defaultTaxPercent
+	}
+	percent, _ := c.TaxRate.Float64()
+	return percent
+}
+
+// receiptWidth is the width in characters of a rendered receipt.
+const receiptWidth = 32
+
+// receiptLine is an itemized line on a receipt.
+type receiptLine struct {
+	Name  string      `json:"name"`
+	Price json.Number `json:"price"`
+}
+
+// receiptDocument is the JSON form of a receipt. Amounts are dollars with two
+// decimals.
+type receiptDocument struct {
+	Source             string        `json:"source"`
+	Items              []receiptLine `json:"items"`
+	Upcharge           json.Number   `json:"upcharge"`
+	Subtotal           json.Number   `json:"subtotal"`
+	TaxPercent         json.Number   `json:"tax_percent"`
+	Tax                json.Number   `json:"tax"`
+	RoundingAdjustment json.Number   `json:"rounding_adjustment"`
+	Total              json.Number   `json:"total"`
+}
+
+func (r *ReceiptResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_receipt"
+}
+
+func (r *ReceiptResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The slip of paper that comes with the food. A receipt itemizes an ` + "`hw_order`" + ` or ` + "`hw_bag`" + ` at menu prices, adds the provider upcharge and sales tax, and renders the result both as printable text and as JSON.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_receipt" "lunch" {
+  order_id    = hw_order.lunch.id
+  tax_percent = 8
+}
+
+output "receipt" {
+  value = hw_receipt.lunch.text
+}
+
+output "receipt_total" {
+  value = jsondecode(hw_receipt.lunch.json).total
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates **text generated from provider data**, no ` + "`templatefile`" + ` needed
+- Set exactly one of ` + "`order_id`" + ` or ` + "`bag_id`" + `; each order item or bagged sandwich is a line at its base menu price
+- The upcharge line is the provider ` + "`upcharge`" + ` once per item, and is left off when there is none
+- Tax is ` + "`tax_percent`" + ` (default: the provider's ` + "`tax_rate`" + `, or 8) of the subtotal, rounded to the cent
+- Item prices and the total follow the provider's ` + "`rounding`" + ` policy; ` + "`rounding_adjustment`" + ` is what rounding the total added or took off, and gets its own line when it isn't zero
+- ` + "`json`" + ` carries the same lines for ` + "`jsondecode`" + `
+- The amounts, ` + "`text`" + ` and ` + "`json`" + ` need the order or bag to have been read by the provider; when an apply leaves it untouched they are null until the next refresh prints them
+
+*Thermal paper curls,*
+*Turkey, cola, tax, and total,*
+*Crumpled in the bag.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"order_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_order to itemize. Exactly one of `order_id` or `bag_id` must be set",
+				Optional:            true,
+			},
+			"bag_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_bag to itemize. Exactly one of `order_id` or `bag_id` must be set",
+				Optional:            true,
+			},
+			"tax_percent": schema.NumberAttribute{
+				MarkdownDescription: "Sales tax as a percentage of the subtotal, from 0 to 100 (defaults to the provider's `tax_rate`, or 8)",
+				Optional:            true,
+			},
+			"subtotal": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The items plus the upcharge, in dollars",
+			},
+			"tax": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Sales tax in dollars, rounded to the cent",
+			},
+			"total": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Subtotal plus tax, plus the rounding adjustment, in dollars",
+			},
+			"rounding_adjustment": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Amount in dollars rounding the total by the provider's `rounding` policy added (positive) or took off (negative). Zero under `none` and `nearest_cent`",
+			},
+			"text": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The receipt rendered as fixed-width, multi-line text",
+			},
+			"json": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The receipt as a JSON document with `source`, `items` (`name` and `price`), `upcharge`, `subtotal`, `tax_percent`, `tax`, `rounding_adjustment` and `total`",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Receipt identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ReceiptResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *ReceiptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ReceiptResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	source, diags := r.render(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := r.client.NewId("receipt", fmt.Sprintf("%s-%d", source, len(source)))
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created a receipt resource", map[string]any{
+		"id":    data.Id.ValueString(),
+		"total": data.Total.String(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReceiptResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ReceiptResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Re-render while the order or bag is known; otherwise keep the
+	// receipt as printed
+	if r.sourceKnown(data) {
+		_, diags := r.render(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReceiptResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ReceiptResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	source, diags := r.render(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ReceiptResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.OrderId.Equal(state.OrderId) || !data.BagId.Equal(state.BagId) {
+		id := r.client.NewId("receipt", fmt.Sprintf("%s-%d", source, len(source)))
+		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReceiptResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ReceiptResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted a receipt resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *ReceiptResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// sourceKnown reports whether the receipt's order or bag is in the registry.
+func (r *ReceiptResource) sourceKnown(data ReceiptResourceModel) bool {
+	if _, ok := LookupRecord[OrderResourceModel](r.client.Registry, data.OrderId.ValueString()); ok {
+		return true
+	}
+	_, ok := LookupRecord[BagResourceModel](r.client.Registry, data.BagId.ValueString())
+	return ok
+}
+
+// render itemizes the receipt's order or bag and sets the amounts, text and
+// JSON, which stay null while the order or bag isn't in the registry. It
+// returns the ID of the order or bag.
+func (r *ReceiptResource) render(ctx context.Context, data *ReceiptResourceModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	hasOrder := !data.OrderId.IsNull()
+	hasBag := !data.BagId.IsNull()
+	switch {
+	case hasOrder && hasBag:
+		diags.AddAttributeError(
+			path.Root("bag_id"),
+			"Conflicting Receipt Source",
+			"Set either order_id or bag_id, not both.",
+		)
+		return "", diags
+	case !hasOrder && !hasBag:
+		diags.AddAttributeError(
+			path.Root("order_id"),
+			"Missing Receipt Source",
+			"A receipt needs something to itemize. Set order_id to an hw_order ID or bag_id to an hw_bag ID.",
+		)
+		return "", diags
+	}
+
+	taxPercent := r.client.TaxPercent()
+	if !data.TaxPercent.IsNull() && !data.TaxPercent.IsUnknown() {
+		taxPercent, _ = data.TaxPercent.ValueBigFloat().Float64()
+	}
+	if taxPercent < 0 || taxPercent > 100 {
+		diags.AddAttributeError(
+			path.Root("tax_percent"),
+			"Invalid Tax Percent",
+			fmt.Sprintf("tax_percent must be from 0 to 100, got %s.", data.TaxPercent.ValueBigFloat().String()),
+		)
+		return "", diags
+	}
+
+	// Collect the priced items: order items by the menu item type in their
+	// ID, bagged items as sandwiches
+	var source string
+	var items []string
+	var known bool
+	if hasOrder {
+		source = data.OrderId.ValueString()
+		order, ok, refDiags := lookupReference[OrderResourceModel](r.client, path.Root("order_id"), source, "order")
+		diags.Append(refDiags...)
+		known = ok
+		if ok {
+			diags.Append(order.ItemIds.ElementsAs(ctx, &items, false)...)
+		}
+	} else {
+		source = data.BagId.ValueString()
+		bag, ok, refDiags := lookupReference[BagResourceModel](r.client, path.Root("bag_id"), source, "bag")
+		diags.Append(refDiags...)
+		known = ok
+		if ok {
+			diags.Append(bag.Sandwiches.ElementsAs(ctx, &items, false)...)
+		}
+	}
+	if diags.HasError() {
+		return "", diags
+	}
+
+	// Without the order or bag there is nothing to itemize yet; Read prints
+	// the receipt once it is known
+	if !known {
+		data.Subtotal = NewMoneyNull()
+		data.Tax = NewMoneyNull()
+		data.RoundingAdjustment = NewMoneyNull()
+		data.Total = NewMoneyNull()
+		data.Text = types.StringNull()
+		data.Json = types.StringNull()
+		return source, diags
+	}
+
+	// Amounts are kept in cents so the lines always add up
+	doc := receiptDocument{Source: source, Items: []receiptLine{}}
+	var itemsCents int64
+	for _, item := range items {
+		key := "sandwich"
+		if hasOrder {
+			key, _ = r.client.TypeOfId(item, ticketItems...)
+		}
+		price := r.client.RoundCents(toCents(r.client.BasePrice(key)))
+		itemsCents += price
+		doc.Items = append(doc.Items, receiptLine{Name: item, Price: json.Number(formatCents(price))})
+	}
+	var upchargeCents int64
+	if r.client.Upcharge != nil {
+		upchargeCents = toCents(r.client.Upcharge) * int64(len(items))
+	}
+	subtotalCents := itemsCents + upchargeCents
+	taxCents := int64(math.Round(float64(subtotalCents) * taxPercent / 100))
+	// Rounding the total is the last step, so the adjustment makes up the
+	// difference on its own line
+	totalCents := r.client.RoundCents(subtotalCents + taxCents)
+	roundingCents := totalCents - subtotalCents - taxCents
+
+	doc.Upcharge = json.Number(formatCents(upchargeCents))
+	doc.Subtotal = json.Number(formatCents(subtotalCents))
+	doc.TaxPercent = json.Number(big.NewFloat(taxPercent).Text('f', -1))
+	doc.Tax = json.Number(formatCents(taxCents))
+	doc.RoundingAdjustment = json.Number(formatCents(roundingCents))
+	doc.Total = json.Number(formatCents(totalCents))
+
+	encoded, err := json.Marshal(doc)
+	if err != nil {
+		diags.AddError("Unable to Render Receipt", "The receipt could not be encoded as JSON: "+err.Error())
+		return "", diags
+	}
+
+	data.Subtotal = NewMoneyCents(subtotalCents)
+	data.Tax = NewMoneyCents(taxCents)
+	data.RoundingAdjustment = NewMoneyCents(roundingCents)
+	data.Total = NewMoneyCents(totalCents)
+	data.Text = types.StringValue(formatReceipt(doc, upchargeCents, roundingCents))
+	data.Json = types.StringValue(string(encoded))
+	return source, diags
+}
+
+// formatReceipt renders a receipt as fixed-width text: a header, one line per
+// item, the upcharge when there is one, then subtotal, tax, the rounding
+// adjustment when there is one, and total.
+func formatReceipt(doc receiptDocument, upchargeCents, roundingCents int64) string {
+	rule := strings.Repeat("-", receiptWidth)
+	line := func(name, amount string) string {
+		width := receiptWidth - len(amount) - 1
+		if len(name) > width {
+			name = name[:width]
+		}
+		return fmt.Sprintf("%-*s %s", width, name, amount)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%*s\n", (receiptWidth+len("HASHIWICH"))/2, "HASHIWICH")
+	fmt.Fprintln(&b, doc.Source)
+	fmt.Fprintln(&b, rule)
+	for _, item := range doc.Items {
+		fmt.Fprintln(&b, line(item.Name, item.Price.String()))
+	}
+	if upchargeCents > 0 {
+		fmt.Fprintln(&b, line("Upcharge", doc.Upcharge.String()))
+	}
+	fmt.Fprintln(&b, rule)
+	fmt.Fprintln(&b, line("Subtotal", doc.Subtotal.String()))
+	fmt.Fprintln(&b, line(fmt.Sprintf("Tax (%s%%)", doc.TaxPercent), doc.Tax.String()))
+	if roundingCents != 0 {
+		fmt.Fprintln(&b, line("Rounding", doc.RoundingAdjustment.String()))
+	}
+	fmt.Fprintln(&b, line("Total", doc.Total.String()))
+	return b.String()
+}
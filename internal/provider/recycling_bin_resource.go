@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &RecyclingBinResource{}
+var _ resource.ResourceWithImportState = &RecyclingBinResource{}
+
+func NewRecyclingBinResource() resource.Resource {
+	return &RecyclingBinResource{}
+}
+
+type RecyclingBinResource struct {
+	client *ProviderConfig
+}
+
+type RecyclingBinResourceModel struct {
+	Materials       types.Set    `tfsdk:"materials"`
+	Description     types.String `tfsdk:"description"`
+	Cost            MoneyValue   `tfsdk:"cost"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
+}
+
+// recyclableMaterials are the accepted values of the recycling bin materials
+// attribute.
+var recyclableMaterials = []string{"aluminum", "glass", "paper", "plastic"}
+
+func (r *RecyclingBinResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_recycling_bin"
+}
+
+func (r *RecyclingBinResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Cans, bottles, cups, and napkins sorted out of the trash. Attach recycling bins to a store with ` + "`bin_ids`" + ` to raise its ` + "`sustainability_score`" + `; the more materials they take, the higher it goes.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_recycling_bin" "front" {
+  materials = ["aluminum", "plastic"]
+  # cost computed as $45
+}
+
+resource "hw_store" "main" {
+  # ...
+  bin_ids = [hw_compost_bin.kitchen.id, hw_recycling_bin.front.id]
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates a **set attribute** of materials where order doesn't matter
+- Materials: aluminum, glass, paper, plastic
+- Each distinct material across a store's recycling bins adds 10 points to its ` + "`sustainability_score`" + `
+- Every bin costs $45, whatever it takes
+
+*Blue lid, sorted cans,*
+*A bottle clinks on paper,*
+*Tuesday takes it all.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"materials": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Materials the bin takes: aluminum, glass, paper, or plastic. At least one",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the recycling bin",
+				Optional:            true,
+			},
+			"cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Cost in dollars ($45)",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Recycling bin identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RecyclingBinResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *RecyclingBinResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RecyclingBinResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	materials, diags := r.setCost(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kind := strings.Join(materials, "_")
+	id := r.client.NewId("recycling", fmt.Sprintf("%s-%d", kind, len(kind)))
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created a recycling bin resource", map[string]any{
+		"id":        data.Id.ValueString(),
+		"materials": kind,
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecyclingBinResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RecyclingBinResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost
+	_, diags := r.setCost(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecyclingBinResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RecyclingBinResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost
+	materials, diags := r.setCost(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state RecyclingBinResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Materials.Equal(state.Materials) {
+		kind := strings.Join(materials, "_")
+		id := r.client.NewId("recycling", fmt.Sprintf("%s-%d", kind, len(kind)))
+		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecyclingBinResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RecyclingBinResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted a recycling bin resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *RecyclingBinResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setCost validates the bin's materials and computes its cost. It returns the
+// materials sorted, for building the ID.
+func (r *RecyclingBinResource) setCost(ctx context.Context, data *RecyclingBinResourceModel) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var materials []string
+	diags.Append(data.Materials.ElementsAs(ctx, &materials, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if len(materials) == 0 {
+		diags.AddAttributeError(
+			path.Root("materials"),
+			"Missing Materials",
+			"A recycling bin needs at least one material to take.",
+		)
+	}
+	for _, material := range materials {
+		if !slices.Contains(recyclableMaterials, material) {
+			diags.AddAttributeError(
+				path.Root("materials").AtSetValue(types.StringValue(material)),
+				"Invalid Material",
+				fmt.Sprintf("Material must be one of %s, got %q.", strings.Join(recyclableMaterials, ", "), material),
+			)
+		}
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+	slices.Sort(materials)
+
+	data.Cost = r.client.Price(ApplyUpcharge(r.client.BasePrice("recycling_bin"), r.client.Upcharge))
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+	return materials, diags
+}
+
+// recycledMaterialsOf resolves a recycling bin ID to the materials it takes,
+// from the registry when the bin's record is known and from the ID otherwise.
+// It reports false for IDs that aren't recycling bins.
+func recycledMaterialsOf(client *ProviderConfig, id string) ([]string, bool) {
+	materials := strings.Split(client.KindFromId(id, "recycling"), "_")
+	if bin, ok := LookupRecord[RecyclingBinResourceModel](client.Registry, id); ok {
+		materials = nil
+		for _, material := range bin.Materials.Elements() {
+			if s, ok := material.(types.String); ok {
+				materials = append(materials, s.ValueString())
+			}
+		}
+	}
+	for _, material := range materials {
+		if !slices.Contains(recyclableMaterials, material) {
+			return nil, false
+		}
+	}
+	return materials, len(materials) > 0
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// lookupReference returns the registry record of type T that the ID in the
+// reference attribute at p names, where typ is the ID prefix of the
+// referenced resource type, such as store or dessert-case.
+//
+// A missing record is not an error: without a backend_path the registry only
+// holds what this provider process has read or written, and Terraform applies
+// changed resources without reading the unchanged ones they reference. The
+// lookup then reports false, and the reference is only checked against the
+// form of typ's IDs. Callers skip the checks that need the record, and
+// compute what depends on it once it is known, usually on the next refresh.
+func lookupReference[T any](c *ProviderConfig, p path.Path, id, typ string) (T, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var registry *Registry
+	if c != nil {
+		registry = c.Registry
+	}
+	if record, ok := LookupRecord[T](registry, id); ok {
+		return record, true, diags
+	}
+
+	if !c.IsIdOf(id, typ) {
+		words := strings.Split(typ, "-")
+		for i, word := range words {
+			words[i] = strings.ToUpper(word[:1]) + word[1:]
+		}
+		diags.AddAttributeError(
+			p,
+			"Unknown "+strings.Join(words, " "),
+			fmt.Sprintf("%q is not the ID of an hw_%s resource.", id, strings.ReplaceAll(typ, "-", "_")),
+		)
+	}
+	var zero T
+	return zero, false, diags
+}
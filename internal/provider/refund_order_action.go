@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &RefundOrderAction{}
+var _ action.ActionWithConfigure = &RefundOrderAction{}
+
+func NewRefundOrderAction() action.Action {
+	return &RefundOrderAction{}
+}
+
+// RefundOrderAction defines the action implementation.
+type RefundOrderAction struct {
+	client *ProviderConfig
+}
+
+// RefundOrderActionModel describes the action data model.
+type RefundOrderActionModel struct {
+	OrderId types.String `tfsdk:"order_id"`
+	Reason  types.String `tfsdk:"reason"`
+}
+
+func (a *RefundOrderAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_refund_order"
+}
+
+func (a *RefundOrderAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Gives a customer their money back for an ` + "`hw_order`" + `, undoing the sale: the order is marked refunded, its menu items go back into inventory, and the store's numbers no longer count it.
+
+**Example Usage:**
+
+` + "```hcl" + `
+action "hw_refund_order" "cold_soup" {
+  config {
+    order_id = hw_order.lunch.id
+    reason   = "soup arrived cold"
+  }
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates a **compensating action** that reverses an earlier operation instead of creating anything new
+- The order's ` + "`status`" + ` becomes refunded on its next refresh and stays there; it no longer advances, and ` + "`hw_fulfill_orders`" + ` skips it
+- ` + "`hw_store_stats`" + ` leaves refunded orders out of ` + "`open_orders`" + ` and ` + "`inventory_consumed`" + ` and counts them in ` + "`orders_refunded`" + ` and ` + "`refunded_total`" + `; ` + "`hw_daily_sales_report`" + ` leaves them out of revenue
+- The last event reports the amount refunded, the order's ` + "`total`" + `
+- Fails when the order has already been refunded, so a refund is never paid twice
+- Refunds the orders the provider has records of: every order with the provider's ` + "`backend_path`" + ` set, otherwise only those read or changed in the same run. Other orders are left alone with a warning
+
+*Soup came to the table cold,*
+*The till drawer slides back open,*
+*Coins return to hand.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"order_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_order to refund",
+				Required:            true,
+			},
+			"reason": schema.StringAttribute{
+				MarkdownDescription: "Why the order is refunded, included in the action's events",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *RefundOrderAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	a.client = config
+}
+
+func (a *RefundOrderAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data RefundOrderActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var registry *Registry
+	if a.client != nil {
+		registry = a.client.Registry
+	}
+
+	orderId := data.OrderId.ValueString()
+	order, orderKnown, diags := lookupReference[OrderResourceModel](a.client, path.Root("order_id"), orderId, "order")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The refund is recorded on the order's record, so there is nothing to
+	// refund without it
+	if !orderKnown {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("order_id"),
+			"Order Not Read",
+			fmt.Sprintf("The provider has no record of %s in this run, so it was not refunded. Set the provider's backend_path for actions to see every order.", orderId),
+		)
+		return
+	}
+	if order.Status.ValueString() == "refunded" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("order_id"),
+			"Order Already Refunded",
+			fmt.Sprintf("%s has already been refunded $%s.", orderId, formatCents(order.Total.Cents())),
+		)
+		return
+	}
+
+	message := fmt.Sprintf("Refunding %s, %s at %s", orderId, order.Status.ValueString(), order.StoreId.ValueString())
+	if reason := data.Reason.ValueString(); reason != "" {
+		message += ": " + reason
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: message + "."})
+
+	// Refunded orders drop out of the store's open orders, inventory and
+	// revenue wherever they are counted
+	previous := order.Status.ValueString()
+	order.Status = types.StringValue("refunded")
+	registry.Put(orderId, order)
+	flushActionChanges(registry, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Refunded $%s for %s, returning %d menu items to inventory.", formatCents(order.Total.Cents()), orderId, len(order.ItemIds.Elements())),
+	})
+
+	tflog.Trace(ctx, "invoked refund order action", map[string]any{
+		"order_id": orderId,
+		"from":     previous,
+		"refunded": formatCents(order.Total.Cents()),
+	})
+}
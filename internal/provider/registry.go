@@ -0,0 +1,339 @@
+package provider
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Registry is an in-memory record store standing in for the backend API.
+// Resources publish their current model on Create, Read, and Update and
+// remove it on Delete, so resources that reference others by ID (such as
+// hw_store referencing hw_cook) can look up the referenced attributes.
+//
+// The registry lives as long as the provider process, and Terraform starts a
+// new process for each command: a plan refreshes every resource, but an
+// apply only creates, updates and deletes the changed ones, and an invoked
+// action reads none. A missing record therefore usually means the resource
+// wasn't touched by this command, not that it doesn't exist. Callers skip
+// the checks that need the record (see lookupReference) or fall back to
+// estimates, and never fail on a miss alone.
+//
+// With the provider's backend_path set, the registry is backed by a Backend
+// file instead: it starts with every record earlier processes stored, and
+// changes are written back on Flush. A record missing from the backend then
+// means the resource is gone, see Missing.
+//
+// The registry also tracks a version for records of resources with optimistic
+// locking, advanced on every change to the record, so an update can detect
+// that something else changed it first.
+//
+// With the provider's trash_retention set, the records of deleted resources
+// are moved to a trash, listed by hw_trash and brought back by the
+// hw_restore action, until the retention window ends. The trash is kept in
+// the backend, which trash_retention requires, since the resource is
+// destroyed and restored by different Terraform commands.
+type Registry struct {
+	mu       sync.RWMutex
+	records  map[string]any
+	versions map[string]int64
+	trash    map[string]TrashedRecord
+	// backend persists the records and versions; nil keeps them in memory
+	backend *Backend
+}
+
+// TrashedRecord is a deleted record kept in the registry's trash until its
+// retention window ends, so it can be restored.
+type TrashedRecord struct {
+	Id           string
+	ResourceType string
+	DeletedAt    time.Time
+	ExpiresAt    time.Time
+	record       any
+}
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		records:  map[string]any{},
+		versions: map[string]int64{},
+		trash:    map[string]TrashedRecord{},
+	}
+}
+
+// Put stores record under id, replacing any previous record and dropping any
+// trashed record of the same ID.
+func (r *Registry) Put(id string, record any) {
+	if r == nil || id == "" {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.records[id] = record
+	delete(r.trash, id)
+	if r.backend != nil {
+		r.backend.put(id, record)
+	}
+}
+
+// PutState stores the state of a resource of the given type under id in the
+// backend, if any, so a later process finds the resource even when its own
+// code doesn't publish a record. The state replaces what Put stored under id.
+func (r *Registry) PutState(id, resourceType string, state tftypes.Value) {
+	if r == nil || r.backend == nil || id == "" || state.IsNull() {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.backend.putState(id, resourceType, state)
+}
+
+// Missing reports whether the registry's backend has no record under id,
+// because the resource was deleted outside Terraform. Without a backend it
+// is always false: the registry starts empty in every process, so a missing
+// record says nothing.
+func (r *Registry) Missing(id string) bool {
+	if r == nil || r.backend == nil {
+		return false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, ok := r.backend.file.Records[id]
+	return !ok
+}
+
+// Persistent reports whether the registry has a backend, so that a record it
+// doesn't find doesn't exist, rather than having gone unread.
+func (r *Registry) Persistent() bool {
+	return r != nil && r.backend != nil
+}
+
+// Flush writes the changes since the last Flush to the registry's backend,
+// if any. Resources flush after every operation and actions once they are
+// done.
+func (r *Registry) Flush() error {
+	if r == nil || r.backend == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.backend.dirty {
+		return nil
+	}
+	if err := r.backend.file.write(r.backend.path); err != nil {
+		return err
+	}
+	r.backend.dirty = false
+	return nil
+}
+
+// Delete removes the record stored under id, if any, and its version.
+func (r *Registry) Delete(id string) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.records, id)
+	delete(r.versions, id)
+	if r.backend != nil {
+		r.backend.delete(id)
+	}
+}
+
+// Version returns the version of the record stored under id, and whether the
+// registry tracks one.
+func (r *Registry) Version(id string) (int64, bool) {
+	if r == nil {
+		return 0, false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	version, ok := r.versions[id]
+	return version, ok
+}
+
+// SetVersion records that the record stored under id is at version.
+func (r *Registry) SetVersion(id string, version int64) {
+	if r == nil || id == "" {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.versions[id] = version
+	if r.backend != nil {
+		r.backend.setVersion(id, version)
+	}
+}
+
+// Bump advances the version of the record stored under id, for a change made
+// outside its resource's own Create or Update, such as by an action or drift,
+// and returns the new version.
+func (r *Registry) Bump(id string) int64 {
+	if r == nil || id == "" {
+		return 0
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.versions[id]++
+	if r.backend != nil {
+		r.backend.setVersion(id, r.versions[id])
+	}
+	return r.versions[id]
+}
+
+// LookupRecord returns the record stored under id if it exists and is a T.
+func LookupRecord[T any](r *Registry, id string) (T, bool) {
+	var zero T
+	if r == nil {
+		return zero, false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.load(reflect.TypeFor[T](), id)
+	record, ok := r.records[id].(T)
+	if !ok {
+		return zero, false
+	}
+	return record, true
+}
+
+// ListRecords returns every record of type T, sorted by ID so callers see a
+// stable order.
+func ListRecords[T any](r *Registry) []T {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.backend != nil {
+		for id := range r.backend.file.Records {
+			r.load(reflect.TypeFor[T](), id)
+		}
+	}
+	ids := make([]string, 0, len(r.records))
+	for id, record := range r.records {
+		if _, ok := record.(T); ok {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+
+	records := make([]T, 0, len(ids))
+	for _, id := range ids {
+		records = append(records, r.records[id].(T))
+	}
+	return records
+}
+
+// load decodes the backend's record under id into the records, as a t, when
+// the registry hasn't got it yet. The caller must hold the write lock.
+func (r *Registry) load(t reflect.Type, id string) {
+	if r.backend == nil || t.Kind() != reflect.Struct {
+		return
+	}
+	if _, ok := r.records[id]; ok {
+		return
+	}
+	if record, ok := r.backend.get(id, t); ok {
+		r.records[id] = record
+	}
+}
+
+// Trash keeps the deleted record of a resource of the given type for
+// retention, for Restore to bring back. The record must already have been
+// removed with Delete.
+func (r *Registry) Trash(id, resourceType string, record any, retention time.Duration) {
+	if r == nil || id == "" {
+		return
+	}
+
+	now := time.Now()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	trashed := TrashedRecord{
+		Id:           id,
+		ResourceType: resourceType,
+		DeletedAt:    now,
+		ExpiresAt:    now.Add(retention),
+		record:       record,
+	}
+	r.trash[id] = trashed
+	if r.backend != nil {
+		r.backend.trash(trashed)
+	}
+}
+
+// ListTrash returns the trashed records whose retention window is still
+// open, sorted by ID, purging the rest.
+func (r *Registry) ListTrash() []TrashedRecord {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.purgeTrash(time.Now())
+	trashed := make([]TrashedRecord, 0, len(r.trash))
+	for _, record := range r.trash {
+		trashed = append(trashed, record)
+	}
+	slices.SortFunc(trashed, func(a, b TrashedRecord) int {
+		return strings.Compare(a.Id, b.Id)
+	})
+	return trashed
+}
+
+// Restore moves the trashed record stored under id back into the registry.
+// It reports false when no such record is in the trash or its retention
+// window has ended.
+func (r *Registry) Restore(id string) (TrashedRecord, bool) {
+	if r == nil {
+		return TrashedRecord{}, false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.purgeTrash(time.Now())
+	trashed, ok := r.trash[id]
+	if !ok {
+		return TrashedRecord{}, false
+	}
+	delete(r.trash, id)
+	// Records trashed by an earlier process are decoded from the backend
+	// when they are looked up
+	if trashed.record != nil {
+		r.records[id] = trashed.record
+	}
+	if r.backend != nil {
+		r.backend.restore(id)
+	}
+	return trashed, true
+}
+
+// purgeTrash drops the trashed records expired at now. The caller must hold
+// the write lock.
+func (r *Registry) purgeTrash(now time.Time) {
+	for id, trashed := range r.trash {
+		if !now.Before(trashed.ExpiresAt) {
+			delete(r.trash, id)
+		}
+	}
+	if r.backend != nil {
+		r.backend.purgeTrash(now)
+	}
+}
@@ -0,0 +1,336 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ReservationResource{}
+var _ resource.ResourceWithImportState = &ReservationResource{}
+
+func NewReservationResource() resource.Resource {
+	return &ReservationResource{}
+}
+
+type ReservationResource struct {
+	client *ProviderConfig
+}
+
+type ReservationResourceModel struct {
+	StoreId        types.String `tfsdk:"store_id"`
+	PartySize      types.Int64  `tfsdk:"party_size"`
+	Time           types.String `tfsdk:"time"`
+	TablesAssigned types.Int64  `tfsdk:"tables_assigned"`
+	Tags           types.Map    `tfsdk:"tags"`
+	TagsAll        types.Map    `tfsdk:"tags_all"`
+	Haiku          types.String `tfsdk:"haiku"`
+	Id             types.String `tfsdk:"id"`
+}
+
+// reservationMinutes is how long a reservation holds its tables. Two
+// reservations at the same store contend for tables when they start less
+// than this far apart.
+const reservationMinutes = 90
+
+// Stores whose hw_tables record is not known to the provider are assumed to
+// have 5 medium tables, the 20 seats the store's capacity estimate assumes.
+const (
+	defaultStoreTables   = 5
+	defaultSeatsPerTable = 4
+)
+
+func (r *ReservationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reservation"
+}
+
+func (r *ReservationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `A table booked ahead for a party. Each reservation takes whole tables from its store's ` + "`hw_tables`" + ` for 90 minutes, and a reservation that doesn't fit alongside the others is refused, the way two resources competing for the same backend capacity would be.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_reservation" "birthday" {
+  store_id   = hw_store.main.id
+  party_size = 9
+  time       = "18:30"
+  # with medium tables (4 seats), tables_assigned computed as 3
+}
+
+resource "hw_reservation" "date_night" {
+  store_id   = hw_store.main.id
+  party_size = 2
+  time       = "19:00"
+  # overlaps the birthday, so it takes one of the remaining tables
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates **resource-level contention**: reservations share the store's tables
+- A party is seated at whole tables: party_size ÷ seats per table, rounded up
+- A reservation holds its tables for 90 minutes from ` + "`time`" + `
+- When a reservation doesn't fit, the error says how many tables are free at that time and which reservations hold the rest
+- Stores whose tables are not known to the provider are assumed to have 5 medium tables
+
+*Name on the ledger,*
+*Candles wait on table nine,*
+*Full house, come back soon.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_store to reserve at",
+				Required:            true,
+			},
+			"party_size": schema.Int64Attribute{
+				MarkdownDescription: "Number of guests (at least 1)",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Party Size", min: 1, max: math.MaxInt64},
+				},
+			},
+			"time": schema.StringAttribute{
+				MarkdownDescription: "Arrival time in 24-hour `HH:MM` format (e.g., `18:30`). The tables are held for 90 minutes",
+				Required:            true,
+			},
+			"tables_assigned": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of the store's tables held for the party",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Reservation identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ReservationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *ReservationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ReservationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := reservationId(r.client, &data)
+
+	resp.Diagnostics.Append(r.allocate(&data, id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created a reservation resource", map[string]any{
+		"id":              data.Id.ValueString(),
+		"tables_assigned": data.TablesAssigned.ValueInt64(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReservationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ReservationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReservationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ReservationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ReservationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Re-seat the party, leaving its current tables out of the count
+	resp.Diagnostics.Append(r.allocate(&data, state.Id.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(reservationId(r.client, &data))
+	if !data.Id.Equal(state.Id) {
+		r.client.Registry.Delete(state.Id.ValueString())
+	}
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReservationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ReservationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted a reservation resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *ReservationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// reservationId builds the reservation's ID from its store, arrival time and
+// party size.
+func reservationId(client *ProviderConfig, data *ReservationResourceModel) string {
+	store := client.KindFromId(data.StoreId.ValueString(), "store")
+	clock := strings.ReplaceAll(data.Time.ValueString(), ":", "")
+	return client.NewId("reservation", fmt.Sprintf("%s-%s-%d", store, clock, data.PartySize.ValueInt64()))
+}
+
+// allocate validates the reservation and assigns it tables from its store,
+// failing when the tables free at that time can't seat the party. id is the
+// reservation's own ID, whose tables are left out of the count. Without the
+// store's record, the store is assumed open with the default tables.
+func (r *ReservationResource) allocate(data *ReservationResourceModel, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	store, ok, storeDiags := lookupReference[StoreResourceModel](r.client, path.Root("store_id"), data.StoreId.ValueString(), "store")
+	diags.Append(storeDiags...)
+	if ok && storeStatus(store) == "closed" {
+		diags.AddAttributeError(
+			path.Root("store_id"),
+			"Store Closed",
+			fmt.Sprintf("%s is closed and takes no reservations. Set the store's status to open first.", data.StoreId.ValueString()),
+		)
+	}
+
+	start, ok := parseClock(data.Time.ValueString())
+	if !ok {
+		diags.AddAttributeError(
+			path.Root("time"),
+			"Invalid Reservation Time",
+			fmt.Sprintf("Time must be in 24-hour HH:MM format (e.g., 18:30), got %q.", data.Time.ValueString()),
+		)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	// The store's tables, from its hw_tables record when known
+	tableCount, seatsPerTable := int64(defaultStoreTables), int64(defaultSeatsPerTable)
+	if tables, ok := LookupRecord[TablesResourceModel](r.client.Registry, store.TablesId.ValueString()); ok {
+		quantity, capacity := tables.Quantity.ValueInt64(), tables.Capacity.ValueInt64()
+		if quantity > 0 {
+			tableCount, seatsPerTable = quantity, capacity/quantity
+		} else {
+			tableCount = 0
+		}
+	}
+
+	// Seat the party at whole tables
+	partySize := data.PartySize.ValueInt64()
+	needed := (partySize + seatsPerTable - 1) / seatsPerTable
+
+	// Collect the other reservations overlapping this one
+	type booking struct {
+		start  int
+		tables int64
+	}
+	var overlapping []booking
+	var holders []string
+	for _, other := range ListRecords[ReservationResourceModel](r.client.Registry) {
+		if other.Id.ValueString() == id || !other.StoreId.Equal(data.StoreId) {
+			continue
+		}
+		otherStart, ok := parseClock(other.Time.ValueString())
+		if !ok || otherStart-start >= reservationMinutes || start-otherStart >= reservationMinutes {
+			continue
+		}
+		tables := other.TablesAssigned.ValueInt64()
+		overlapping = append(overlapping, booking{otherStart, tables})
+		holders = append(holders, fmt.Sprintf("%s (%d at %s)", other.Id.ValueString(), tables, other.Time.ValueString()))
+	}
+
+	// The tables held peak when a reservation starts, so check the busiest
+	// of those moments during this reservation
+	var held int64
+	for _, moment := range append(overlapping, booking{start: start}) {
+		if moment.start < start {
+			continue
+		}
+		var busy int64
+		for _, b := range overlapping {
+			if b.start <= moment.start && moment.start < b.start+reservationMinutes {
+				busy += b.tables
+			}
+		}
+		held = max(held, busy)
+	}
+
+	if free := tableCount - held; needed > free {
+		detail := "No other reservations overlap it."
+		if len(holders) > 0 {
+			detail = "Tables are held by " + strings.Join(holders, ", ") + "."
+		}
+		diags.AddAttributeError(
+			path.Root("time"),
+			"Store Overbooked",
+			fmt.Sprintf("A party of %d needs %d tables of %d seats at %s, but %s has only %d of %d tables free then. %s Choose a time at least %d minutes away from those reservations, or add tables to the store.",
+				partySize, needed, seatsPerTable, data.Time.ValueString(), data.StoreId.ValueString(), max(free, 0), tableCount, detail, reservationMinutes),
+		)
+		return diags
+	}
+
+	data.TablesAssigned = types.Int64Value(needed)
+	return diags
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &RestoreAction{}
+var _ action.ActionWithConfigure = &RestoreAction{}
+
+func NewRestoreAction() action.Action {
+	return &RestoreAction{}
+}
+
+// RestoreAction defines the action implementation.
+type RestoreAction struct {
+	client *ProviderConfig
+}
+
+// RestoreActionModel describes the action data model.
+type RestoreActionModel struct {
+	Id types.String `tfsdk:"id"`
+}
+
+func (a *RestoreAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_restore"
+}
+
+func (a *RestoreAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Takes a deleted resource's record back out of the provider's trash (see ` + "`hw_trash`" + `), while its ` + "`trash_retention`" + ` window is open.
+
+**Example Usage:**
+
+` + "```hcl" + `
+action "hw_restore" "old_store" {
+  config {
+    id = "store-downtown-8"
+  }
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates **actions**: run it with ` + "`terraform apply -invoke=action.hw_restore.old_store`" + ` or from a resource's ` + "`action_trigger`" + `
+- Restores the backend record only; ` + "`terraform import`" + ` the resource to manage it with Terraform again
+- Fails when the ID isn't in the trash, for instance after its retention window has ended`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the deleted resource to restore",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (a *RestoreAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	a.client = config
+}
+
+func (a *RestoreAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data RestoreActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var registry *Registry
+	if a.client != nil {
+		registry = a.client.Registry
+	}
+
+	id := data.Id.ValueString()
+	trashed, ok := registry.Restore(id)
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Not in Trash",
+			fmt.Sprintf("%q is not in the trash. It may never have been deleted, or its trash_retention window may have ended.", id),
+		)
+		return
+	}
+	flushActionChanges(registry, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Restored %s %s; import it to manage it with Terraform again.", trashed.ResourceType, id),
+	})
+
+	tflog.Trace(ctx, "invoked restore action", map[string]any{
+		"id":            id,
+		"resource_type": trashed.ResourceType,
+	})
+}
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ReviewResource{}
+var _ resource.ResourceWithImportState = &ReviewResource{}
+
+func NewReviewResource() resource.Resource {
+	return &ReviewResource{}
+}
+
+type ReviewResource struct {
+	client *ProviderConfig
+}
+
+type ReviewResourceModel struct {
+	StoreId types.String `tfsdk:"store_id"`
+	Rating  types.Int64  `tfsdk:"rating"`
+	Text    types.String `tfsdk:"text"`
+	Tags    types.Map    `tfsdk:"tags"`
+	TagsAll types.Map    `tfsdk:"tags_all"`
+	Haiku   types.String `tfsdk:"haiku"`
+	Id      types.String `tfsdk:"id"`
+}
+
+func (r *ReviewResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_review"
+}
+
+func (r *ReviewResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `A customer's verdict on a store, from one star to five. Reviews are child resources that feed their parent: each store's ` + "`average_rating`" + ` is the mean of the reviews written about it.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_review" "glowing" {
+  store_id = hw_store.main.id
+  rating   = 5
+  text     = "Best Reuben in town."
+}
+
+resource "hw_review" "grumpy" {
+  store_id = hw_store.main.id
+  rating   = 2
+  text     = "Waited forty minutes for a sandwich."
+}
+
+# average_rating computed as 3.5
+output "main_rating" {
+  value = hw_store.main.average_rating
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates **child resources feeding parent computed values** through the provider's registry
+- ` + "`rating`" + ` is a whole number from 1 to 5
+- A store's ` + "`average_rating`" + ` is the mean rating of its reviews, rounded to one decimal
+- Because reviews depend on their store, Terraform reads the store first; the store only learns of reviews from the provider's ` + "`backend_path`" + `, and with one set it picks up new or changed reviews on the next refresh
+
+*Five stars, then just two,*
+*Crumbs and praise on the same page,*
+*The average speaks.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_store being reviewed",
+				Required:            true,
+			},
+			"rating": schema.Int64Attribute{
+				MarkdownDescription: "Star rating from 1 to 5",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Rating", min: 1, max: 5},
+				},
+			},
+			"text": schema.StringAttribute{
+				MarkdownDescription: "What the customer had to say",
+				Required:            true,
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Review identifier, unique to the review even when another of the store's reviews has the same rating or text",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ReviewResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *ReviewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ReviewResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := r.client.NewUniqueId("review", fmt.Sprintf("%s-%d", r.client.KindFromId(data.StoreId.ValueString(), "store"), data.Rating.ValueInt64()))
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created a review resource", map[string]any{
+		"id":     data.Id.ValueString(),
+		"rating": data.Rating.ValueInt64(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReviewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ReviewResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReviewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ReviewResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ReviewResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = state.Id
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ReviewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ReviewResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted a review resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *ReviewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// storeAverageRating returns the mean rating of the store's reviews known to
+// the registry, rounded to one decimal. It reports false when none are known.
+func storeAverageRating(registry *Registry, storeId string) (*big.Float, bool) {
+	var total float64
+	var count int
+	for _, review := range ListRecords[ReviewResourceModel](registry) {
+		if review.StoreId.ValueString() != storeId {
+			continue
+		}
+		total += float64(review.Rating.ValueInt64())
+		count++
+	}
+	if count == 0 {
+		return nil, false
+	}
+	return big.NewFloat(math.Round(total/float64(count)*10) / 10), true
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// RoyaltyTierModel describes the royalty_tier block data model.
+type RoyaltyTierModel struct {
+	Threshold types.Number `tfsdk:"threshold"`
+	Percent   types.Number `tfsdk:"percent"`
+}
+
+// royaltyTiers reads and validates the royalty_tier blocks: percents run from
+// 0 to 100 and thresholds are non-negative and strictly increasing, so each
+// tier covers a band of revenue.
+func royaltyTiers(ctx context.Context, blocks types.List) ([]RoyaltyTierModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if blocks.IsNull() || blocks.IsUnknown() {
+		return nil, diags
+	}
+
+	var tiers []RoyaltyTierModel
+	diags.Append(blocks.ElementsAs(ctx, &tiers, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	zero, hundred := new(big.Float), big.NewFloat(100)
+	for i, tier := range tiers {
+		blockPath := path.Root("royalty_tier").AtListIndex(i)
+		threshold, percent := tier.Threshold.ValueBigFloat(), tier.Percent.ValueBigFloat()
+		if percent.Cmp(zero) < 0 || percent.Cmp(hundred) > 0 {
+			diags.AddAttributeError(
+				blockPath.AtName("percent"),
+				"Invalid Royalty Tier",
+				fmt.Sprintf("percent must be between 0 and 100, got %s.", percent.Text('f', -1)),
+			)
+		}
+		if threshold.Cmp(zero) < 0 {
+			diags.AddAttributeError(
+				blockPath.AtName("threshold"),
+				"Invalid Royalty Tier",
+				fmt.Sprintf("threshold must not be negative, got %s.", threshold.Text('f', -1)),
+			)
+			continue
+		}
+		if i > 0 {
+			if previous := tiers[i-1].Threshold.ValueBigFloat(); threshold.Cmp(previous) <= 0 {
+				diags.AddAttributeError(
+					blockPath.AtName("threshold"),
+					"Invalid Royalty Tier",
+					fmt.Sprintf("Royalty tier thresholds must be strictly increasing, but tier %d starts at %s, not above tier %d's %s.", i+1, threshold.Text('f', -1), i, previous.Text('f', -1)),
+				)
+			}
+		}
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+	return tiers, diags
+}
+
+// tieredRoyalty returns the royalty on revenue under tiers, which must be
+// valid: like tax brackets, each tier's percent applies only to the revenue
+// between its threshold and the next tier's, and revenue below the first
+// threshold owes nothing.
+func tieredRoyalty(revenue *big.Float, tiers []RoyaltyTierModel) *big.Float {
+	royalty := new(big.Float)
+	for i, tier := range tiers {
+		threshold := tier.Threshold.ValueBigFloat()
+		if revenue.Cmp(threshold) <= 0 {
+			break
+		}
+
+		top := revenue
+		if i+1 < len(tiers) {
+			if next := tiers[i+1].Threshold.ValueBigFloat(); next.Cmp(revenue) < 0 {
+				top = next
+			}
+		}
+		band := new(big.Float).Sub(top, threshold)
+		band.Mul(band, tier.Percent.ValueBigFloat())
+		royalty.Add(royalty, band.Quo(band, big.NewFloat(100)))
+	}
+	return royalty
+}
+
+// effectiveRoyaltyPercent returns royalty as a percent of revenue, to two
+// decimal places, or 0 without revenue.
+func effectiveRoyaltyPercent(royalty, revenue *big.Float) float64 {
+	if revenue.Sign() <= 0 {
+		return 0
+	}
+	share, _ := new(big.Float).Quo(royalty, revenue).Float64()
+	return math.Round(share*100*100) / 100
+}
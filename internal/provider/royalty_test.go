@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTieredRoyalty(t *testing.T) {
+	tier := func(threshold, percent float64) RoyaltyTierModel {
+		return RoyaltyTierModel{
+			Threshold: types.NumberValue(big.NewFloat(threshold)),
+			Percent:   types.NumberValue(big.NewFloat(percent)),
+		}
+	}
+	tiers := []RoyaltyTierModel{tier(0, 4), tier(10000, 6), tier(50000, 8)}
+
+	tests := []struct {
+		revenue     float64
+		wantRoyalty string
+		wantPercent float64
+	}{
+		{0, "0", 0},
+		{5000, "200", 4},
+		{10000, "400", 4},
+		{20000, "1000", 5},
+		{60000, "3600", 6},
+	}
+
+	for _, tt := range tests {
+		revenue := big.NewFloat(tt.revenue)
+		royalty := tieredRoyalty(revenue, tiers)
+		if got := royalty.Text('f', -1); got != tt.wantRoyalty {
+			t.Errorf("tieredRoyalty(%v) = %s, want %s", tt.revenue, got, tt.wantRoyalty)
+		}
+		if got := effectiveRoyaltyPercent(royalty, revenue); got != tt.wantPercent {
+			t.Errorf("effectiveRoyaltyPercent(%v) = %v, want %v", tt.revenue, got, tt.wantPercent)
+		}
+	}
+
+	if got := tieredRoyalty(big.NewFloat(500), []RoyaltyTierModel{tier(1000, 5)}).Sign(); got != 0 {
+		t.Errorf("revenue below the first threshold owes a royalty")
+	}
+}
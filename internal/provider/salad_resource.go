@@ -29,12 +29,16 @@ type SaladResource struct {
 
 // SaladResourceModel describes the resource data model.
 type SaladResourceModel struct {
-	Description types.String `tfsdk:"description"`
-	Kind        types.String `tfsdk:"kind"`
-	Dressing    types.String `tfsdk:"dressing"`
-	Size        types.String `tfsdk:"size"`
-	Price       types.Number `tfsdk:"price"`
-	Id          types.String `tfsdk:"id"`
+	Description     types.String `tfsdk:"description"`
+	Kind            types.String `tfsdk:"kind"`
+	Dressing        types.String `tfsdk:"dressing"`
+	Size            types.String `tfsdk:"size"`
+	Price           MoneyValue   `tfsdk:"price"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
 }
 
 func (r *SaladResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -114,9 +118,17 @@ resource "hw_salad" "custom" {
 				Required:            true,
 			},
 			"price": schema.NumberAttribute{
+				CustomType:          MoneyType{},
 				Computed:            true,
 				MarkdownDescription: "The price of the salad in dollars (hardcoded to $4.00)",
 			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Salad identifier",
@@ -159,12 +171,11 @@ func (r *SaladResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Simulate API delay
 
 	// Set base price: $4.00, then apply upcharge
-	basePrice := big.NewFloat(4.00)
-	finalPrice := ApplyUpcharge(basePrice, r.client.Upcharge)
-	data.Price = types.NumberValue(finalPrice)
+	data.Price = r.client.Price(r.calculatePrice())
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
 
 	// Mock resource creation - generate a fake ID based on the kind
-	id := fmt.Sprintf("salad-%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString()))
+	id := r.client.NewId("salad", fmt.Sprintf("%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString())))
 	data.Id = types.StringValue(id)
 
 	tflog.Trace(ctx, "created a salad resource", map[string]any{
@@ -174,6 +185,8 @@ func (r *SaladResource) Create(ctx context.Context, req resource.CreateRequest,
 		"size":     data.Size.ValueString(),
 	})
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -191,11 +204,14 @@ func (r *SaladResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// Simulate API delay
 
 	// Ensure price is set (in case it wasn't in state)
-	data.Price = types.NumberValue(big.NewFloat(4.00))
+	data.Price = r.client.Price(r.calculatePrice())
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
 
 	// Mock resource read - just return the existing state
 	// In a real implementation, this would fetch from an API
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -212,8 +228,9 @@ func (r *SaladResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	// Simulate API delay
 
-	// Ensure price is always set to $4.00
-	data.Price = types.NumberValue(big.NewFloat(4.00))
+	// Ensure price is always set to $4.00 + upcharge
+	data.Price = r.client.Price(r.calculatePrice())
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
 
 	// Mock resource update - regenerate ID if kind changed
 	var state SaladResourceModel
@@ -224,13 +241,15 @@ func (r *SaladResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	// If kind changed, regenerate ID
 	if !data.Kind.Equal(state.Kind) {
-		id := fmt.Sprintf("salad-%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString()))
+		id := r.client.NewId("salad", fmt.Sprintf("%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString())))
 		data.Id = types.StringValue(id)
 	} else {
 		// Keep existing ID
 		data.Id = state.Id
 	}
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -256,3 +275,9 @@ func (r *SaladResource) Delete(ctx context.Context, req resource.DeleteRequest,
 func (r *SaladResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// calculatePrice returns the salad price from the pricing engine (4.00 by
+// default) plus the provider upcharge.
+func (r *SaladResource) calculatePrice() *big.Float {
+	return ApplyUpcharge(r.client.BasePrice("salad"), r.client.Upcharge)
+}
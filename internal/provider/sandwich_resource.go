@@ -4,8 +4,8 @@ import (
 	"context"
 	"fmt"
 	"math/big"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -30,12 +30,17 @@ type SandwichResource struct {
 
 // SandwichResourceModel describes the resource data model.
 type SandwichResourceModel struct {
-	Description types.String `tfsdk:"description"`
-	BreadId     types.String `tfsdk:"bread_id"`
-	MeatId      types.String `tfsdk:"meat_id"`
-	Name        types.String `tfsdk:"name"`
-	Price       types.Number `tfsdk:"price"`
-	Id          types.String `tfsdk:"id"`
+	Description     types.String `tfsdk:"description"`
+	BreadId         types.String `tfsdk:"bread_id"`
+	MeatId          types.String `tfsdk:"meat_id"`
+	Name            types.String `tfsdk:"name"`
+	Price           MoneyValue   `tfsdk:"price"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Allergens       types.Set    `tfsdk:"allergens"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
 }
 
 func (r *SandwichResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -69,12 +74,14 @@ resource "hw_sandwich" "turkey_on_rye" {
 
 **Resource Dependencies:**
 - This resource **depends on** ` + "`hw_bread`" + ` and ` + "`hw_meat`" + ` resources
+- Each sandwich takes slices from its meat; when the ` + "`hw_meat`" + ` sets ` + "`slices`" + `, a sandwich that would need more than are left fails to create
 - Terraform will automatically create bread and meat resources before creating the sandwich
 - If bread_id or meat_id changes, the sandwich will be recreated with a new ID
 
 **Computed Attributes:**
 - ` + "`name`" + `: Automatically generated as "{meat} on {bread}" (e.g., "turkey on rye")
 - ` + "`price`" + `: Fixed at $5.00 (plus any provider-level upcharge)
+- ` + "`allergens`" + `: The bread's and meat's allergens from the shared allergen table (see ` + "`hw_ingredient_substitutions`" + `)
 - ` + "`id`" + `: Automatically generated unique identifier
 
 *Bread and meat unite,*
@@ -169,6 +176,7 @@ meat_id = "meat-turkey-6"  # Direct ID reference (not recommended)
 				},
 			},
 			"price": schema.NumberAttribute{
+				CustomType: MoneyType{},
 				Computed:            true,
 				MarkdownDescription: `The price of the sandwich in dollars. This is a computed value that includes the base price plus any provider-level upcharge.
 
@@ -191,6 +199,18 @@ meat_id = "meat-turkey-6"  # Direct ID reference (not recommended)
 - The price is the same for all sandwiches regardless of bread or meat type
 - Use this in outputs or calculations for total order costs`,
 			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"allergens": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Allergens the sandwich contains: those of its bread and meat kinds, from the allergen table `hw_ingredient_substitutions` uses. Kinds the table doesn't list add none",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: `Automatically generated unique identifier for this sandwich resource.
@@ -244,23 +264,27 @@ func (r *SandwichResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	resp.Diagnostics.Append(r.checkMeatSlices(data, "")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Simulate API delay
 
 	// Extract meat and bread kinds from their IDs
-	meatKind := extractKindFromId(data.MeatId.ValueString(), "meat")
-	breadKind := extractKindFromId(data.BreadId.ValueString(), "bread")
+	meatKind := r.client.KindFromId(data.MeatId.ValueString(), "meat")
+	breadKind := r.client.KindFromId(data.BreadId.ValueString(), "bread")
 
 	// Generate name in format "{meat} on {bread}"
 	name := fmt.Sprintf("%s on %s", meatKind, breadKind)
 	data.Name = types.StringValue(name)
 
 	// Set base price: $5.00, then apply upcharge
-	basePrice := big.NewFloat(5.00)
-	finalPrice := ApplyUpcharge(basePrice, r.client.Upcharge)
-	data.Price = types.NumberValue(finalPrice)
+	data.Price = r.client.Price(r.calculatePrice())
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
 
 	// Mock resource creation - generate a fake ID based on bread and meat IDs
-	id := fmt.Sprintf("sandwich-%s-%s", data.BreadId.ValueString(), data.MeatId.ValueString())
+	id := r.client.NewId("sandwich", fmt.Sprintf("%s-%s", data.BreadId.ValueString(), data.MeatId.ValueString()))
 	data.Id = types.StringValue(id)
 
 	tflog.Trace(ctx, "created a sandwich resource", map[string]any{
@@ -269,6 +293,14 @@ func (r *SandwichResource) Create(ctx context.Context, req resource.CreateReques
 		"meat_id":  data.MeatId.ValueString(),
 	})
 
+	resp.Diagnostics.Append(r.setAllergens(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -286,17 +318,26 @@ func (r *SandwichResource) Read(ctx context.Context, req resource.ReadRequest, r
 	// Simulate API delay
 
 	// Regenerate name from IDs in case bread_id or meat_id changed externally
-	meatKind := extractKindFromId(data.MeatId.ValueString(), "meat")
-	breadKind := extractKindFromId(data.BreadId.ValueString(), "bread")
+	meatKind := r.client.KindFromId(data.MeatId.ValueString(), "meat")
+	breadKind := r.client.KindFromId(data.BreadId.ValueString(), "bread")
 	name := fmt.Sprintf("%s on %s", meatKind, breadKind)
 	data.Name = types.StringValue(name)
 
 	// Ensure price is set (in case it wasn't in state)
-	data.Price = types.NumberValue(big.NewFloat(5.00))
+	data.Price = r.client.Price(r.calculatePrice())
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+
+	resp.Diagnostics.Append(r.setAllergens(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Mock resource read - just return the existing state
 	// In a real implementation, this would fetch from an API
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -320,24 +361,39 @@ func (r *SandwichResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	resp.Diagnostics.Append(r.checkMeatSlices(data, state.Id.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// If bread_id or meat_id changed, regenerate ID and name
 	if !data.BreadId.Equal(state.BreadId) || !data.MeatId.Equal(state.MeatId) {
 		// Extract meat and bread kinds from their IDs
-		meatKind := extractKindFromId(data.MeatId.ValueString(), "meat")
-		breadKind := extractKindFromId(data.BreadId.ValueString(), "bread")
+		meatKind := r.client.KindFromId(data.MeatId.ValueString(), "meat")
+		breadKind := r.client.KindFromId(data.BreadId.ValueString(), "bread")
 		name := fmt.Sprintf("%s on %s", meatKind, breadKind)
 		data.Name = types.StringValue(name)
 
-		id := fmt.Sprintf("sandwich-%s-%s", data.BreadId.ValueString(), data.MeatId.ValueString())
+		id := r.client.NewId("sandwich", fmt.Sprintf("%s-%s", data.BreadId.ValueString(), data.MeatId.ValueString()))
 		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
 	} else {
 		// Keep existing ID and name
 		data.Id = state.Id
 		data.Name = state.Name
 	}
 
-	// Ensure price is always set to $5.00
-	data.Price = types.NumberValue(big.NewFloat(5.00))
+	// Ensure price is always set to $5.00 + upcharge
+	data.Price = r.client.Price(r.calculatePrice())
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+
+	resp.Diagnostics.Append(r.setAllergens(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -355,7 +411,9 @@ func (r *SandwichResource) Delete(ctx context.Context, req resource.DeleteReques
 
 	// Simulate API delay
 
-	// Mock resource deletion - nothing to do
+	// Mock resource deletion - forget the sandwich's record
+	r.client.Registry.Delete(data.Id.ValueString())
+
 	tflog.Trace(ctx, "deleted a sandwich resource", map[string]any{
 		"id": data.Id.ValueString(),
 	})
@@ -365,24 +423,43 @@ func (r *SandwichResource) ImportState(ctx context.Context, req resource.ImportS
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-// extractKindFromId extracts the kind from a resource ID
-// IDs are in format "{type}-{kind}-{length}" where kind may contain dashes
-// Example: "bread-rye-3" or "meat-roast-beef-10"
-func extractKindFromId(id, prefix string) string {
-	// Remove the prefix (e.g., "bread-" or "meat-")
-	if !strings.HasPrefix(id, prefix+"-") {
-		return "unknown"
+// calculatePrice returns the sandwich price from the pricing engine plus the
+// provider upcharge.
+func (r *SandwichResource) calculatePrice() *big.Float {
+	return ApplyUpcharge(r.client.BasePrice("sandwich"), r.client.Upcharge)
+}
+
+// setAllergens sets the sandwich's allergens from its bread and meat kinds.
+func (r *SandwichResource) setAllergens(ctx context.Context, data *SandwichResourceModel) diag.Diagnostics {
+	breadKind := r.client.KindFromId(data.BreadId.ValueString(), "bread")
+	meatKind := r.client.KindFromId(data.MeatId.ValueString(), "meat")
+
+	allergens, diags := types.SetValueFrom(ctx, types.StringType, allergensOf(breadKind, meatKind))
+	data.Allergens = allergens
+	return diags
+}
+
+// checkMeatSlices fails when the sandwich's meat sets slices and the
+// sandwiches using it, this one included, would take more than that. id is
+// the sandwich's own ID, whose slices are left out of the count. Meat not in
+// the registry isn't checked.
+func (r *SandwichResource) checkMeatSlices(data SandwichResourceModel, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	meat, ok := LookupRecord[MeatResourceModel](r.client.Registry, data.MeatId.ValueString())
+	if !ok || meat.Slices.IsNull() {
+		return diags
 	}
-	
-	// Remove prefix and get the rest
-	rest := strings.TrimPrefix(id, prefix+"-")
-	
-	// Find the last dash (which separates kind from length)
-	lastDash := strings.LastIndex(rest, "-")
-	if lastDash == -1 {
-		return rest
+
+	perSandwich := meatThicknesses[meatThickness(meat)].PerSandwich
+	used := meatSlicesUsed(r.client.Registry, meat, id)
+	if used+perSandwich > meat.Slices.ValueInt64() {
+		diags.AddAttributeError(
+			path.Root("meat_id"),
+			"Out of Meat",
+			fmt.Sprintf("A sandwich takes %d %s slices of %s, but only %d of its %d slices are left. Raise the meat's slices or use another meat.",
+				perSandwich, meatThickness(meat), data.MeatId.ValueString(), max(meat.Slices.ValueInt64()-used, 0), meat.Slices.ValueInt64()),
+		)
 	}
-	
-	// Return everything before the last dash (the kind)
-	return rest[:lastDash]
+	return diags
 }
@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &SeasonalMenuResource{}
+var _ resource.ResourceWithImportState = &SeasonalMenuResource{}
+
+func NewSeasonalMenuResource() resource.Resource {
+	return &SeasonalMenuResource{}
+}
+
+type SeasonalMenuResource struct {
+	client *ProviderConfig
+}
+
+type SeasonalMenuResourceModel struct {
+	StoreId     types.String `tfsdk:"store_id"`
+	Season      types.String `tfsdk:"season"`
+	MenuItemIds types.Set    `tfsdk:"menu_item_ids"`
+	Active      types.Bool   `tfsdk:"active"`
+	Tags        types.Map    `tfsdk:"tags"`
+	TagsAll     types.Map    `tfsdk:"tags_all"`
+	Haiku       types.String `tfsdk:"haiku"`
+	Id          types.String `tfsdk:"id"`
+}
+
+// seasons are the accepted seasons, in calendar order from January. Each
+// covers three whole months: winter is December to February.
+var seasons = []string{"winter", "spring", "summer", "fall"}
+
+// seasonOf returns the season t falls in.
+func seasonOf(t time.Time) string {
+	return seasons[int(t.Month())%12/3]
+}
+
+func (r *SeasonalMenuResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_seasonal_menu"
+}
+
+func (r *SeasonalMenuResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `A store's menu for one season: soup when it's cold, salad when it's not. The menu is ` + "`active`" + ` while its season lasts, and each store has at most one menu per season.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_seasonal_menu" "winter" {
+  store_id      = hw_store.main.id
+  season        = "winter"
+  menu_item_ids = [hw_soup.tomato.id, hw_sandwich.blt.id]
+}
+
+resource "hw_seasonal_menu" "summer" {
+  store_id      = hw_store.main.id
+  season        = "summer"
+  menu_item_ids = [hw_salad.caesar.id, hw_drink.lemonade.id]
+}
+
+output "on_the_menu" {
+  value = [for menu in [hw_seasonal_menu.winter, hw_seasonal_menu.summer] : menu.menu_item_ids if menu.active]
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates a **uniqueness constraint** enforced through the provider's registry: the ID is built from the store and season, and a second menu with the same ID fails to create, as a real API would refuse a duplicate. The provider only sees every menu with its ` + "`backend_path`" + ` set; without it, a menu the apply leaves unchanged isn't checked
+- Seasons are three whole months: winter (December to February), spring (March to May), summer (June to August) and fall (September to November)
+- ` + "`active`" + ` is recomputed on every refresh for the provider's ` + "`as_of`" + ` date, or today, so a menu goes inactive when its season ends without a change to the configuration
+
+*Soup in the window,*
+*Then lemonade, then soup again,*
+*The year turns the board.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_store the menu is for",
+				Required:            true,
+			},
+			"season": schema.StringAttribute{
+				MarkdownDescription: "Season the menu is served in: winter, spring, summer, or fall. Each store may have one menu per season",
+				Required:            true,
+			},
+			"menu_item_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "IDs of the menu items on the seasonal menu (e.g. hw_soup or hw_salad resources)",
+				Required:            true,
+			},
+			"active": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the provider's `as_of` date, or today, falls in the menu's season",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Seasonal menu identifier, built from the store and season, such as `seasonal-menu-main-winter`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SeasonalMenuResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *SeasonalMenuResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SeasonalMenuResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.validate(ctx, &data, "")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(r.newId(data))
+	data.Active = types.BoolValue(seasonOf(r.client.Today()) == data.Season.ValueString())
+
+	tflog.Trace(ctx, "created a seasonal menu resource", map[string]any{
+		"id":     data.Id.ValueString(),
+		"active": data.Active.ValueBool(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SeasonalMenuResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SeasonalMenuResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The season may have turned since the last refresh
+	data.Active = types.BoolValue(seasonOf(r.client.Today()) == data.Season.ValueString())
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SeasonalMenuResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SeasonalMenuResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state SeasonalMenuResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.validate(ctx, &data, state.Id.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.StoreId.Equal(state.StoreId) || !data.Season.Equal(state.Season) {
+		data.Id = types.StringValue(r.newId(data))
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+	data.Active = types.BoolValue(seasonOf(r.client.Today()) == data.Season.ValueString())
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SeasonalMenuResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SeasonalMenuResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted a seasonal menu resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *SeasonalMenuResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// newId returns the ID of a store's menu for a season. A store has one menu
+// per season, so the ID is the menu's key: two menus for the same store and
+// season have the same ID.
+func (r *SeasonalMenuResource) newId(data SeasonalMenuResourceModel) string {
+	store := r.client.KindFromId(data.StoreId.ValueString(), "store")
+	return r.client.NewId("seasonal-menu", fmt.Sprintf("%s-%s", store, data.Season.ValueString()))
+}
+
+// validate checks the menu's season and items, and that the registry has no
+// menu, other than the one with ID self, under the menu's ID, which would be
+// for the same store and season. Without a backend the registry misses the
+// menus an apply leaves unchanged, so only a backend catches every
+// duplicate.
+func (r *SeasonalMenuResource) validate(ctx context.Context, data *SeasonalMenuResourceModel, self string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	season := data.Season.ValueString()
+	if !slices.Contains(seasons, season) {
+		diags.AddAttributeError(
+			path.Root("season"),
+			"Invalid Season",
+			fmt.Sprintf("season must be one of %s, got %q.%s", strings.Join(seasons, ", "), season, didYouMean(season, seasons)),
+		)
+	}
+
+	var itemIds []string
+	diags.Append(data.MenuItemIds.ElementsAs(ctx, &itemIds, false)...)
+	for _, itemId := range itemIds {
+		if _, ok := r.client.TypeOfId(itemId, ticketItems...); !ok {
+			diags.AddAttributeError(
+				path.Root("menu_item_ids").AtSetValue(types.StringValue(itemId)),
+				"Unknown Menu Item",
+				fmt.Sprintf("%q is not the ID of a menu item. Menu items are: %s.", itemId, strings.Join(ticketItems, ", ")),
+			)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if id := r.newId(*data); id != self {
+		if _, ok := LookupRecord[SeasonalMenuResourceModel](r.client.Registry, id); ok {
+			diags.AddAttributeError(
+				path.Root("season"),
+				"Duplicate Seasonal Menu",
+				fmt.Sprintf("%s already has a %s menu, %s. A store has one menu per season; add the items to that menu or remove it first.",
+					data.StoreId.ValueString(), season, id),
+			)
+		}
+	}
+	return diags
+}
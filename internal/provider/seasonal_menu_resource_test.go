@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSeasonOf(t *testing.T) {
+	tests := map[time.Month]string{
+		time.January:   "winter",
+		time.February:  "winter",
+		time.March:     "spring",
+		time.May:       "spring",
+		time.June:      "summer",
+		time.August:    "summer",
+		time.September: "fall",
+		time.November:  "fall",
+		time.December:  "winter",
+	}
+	for month, want := range tests {
+		if got := seasonOf(time.Date(2026, month, 15, 0, 0, 0, 0, time.UTC)); got != want {
+			t.Errorf("seasonOf(%s) = %s, want %s", month, got, want)
+		}
+	}
+}
+
+func TestDuplicateSeasonalMenu(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "backend.json")
+	menu := func(season string) SeasonalMenuResourceModel {
+		itemIds, _ := types.SetValueFrom(ctx, types.StringType, []string{"soup-tomato-6"})
+		return SeasonalMenuResourceModel{
+			StoreId:     types.StringValue("store-main-4"),
+			Season:      types.StringValue(season),
+			MenuItemIds: itemIds,
+		}
+	}
+
+	r := &SeasonalMenuResource{client: &ProviderConfig{Registry: openTestRegistry(t, path)}}
+	winter := menu("winter")
+	winter.Id = types.StringValue(r.newId(winter))
+	if got, want := winter.Id.ValueString(), "seasonal-menu-main-winter"; got != want {
+		t.Errorf("newId = %q, want %q", got, want)
+	}
+	r.client.Registry.Put(winter.Id.ValueString(), winter)
+	if err := r.client.Registry.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+
+	// A later apply that leaves the winter menu unchanged finds it in the
+	// backend
+	r = &SeasonalMenuResource{client: &ProviderConfig{Registry: openTestRegistry(t, path)}}
+	duplicate := menu("winter")
+	if diags := r.validate(ctx, &duplicate, ""); !diags.HasError() {
+		t.Error("second winter menu: want Duplicate Seasonal Menu")
+	}
+	if diags := r.validate(ctx, &duplicate, winter.Id.ValueString()); diags.HasError() {
+		t.Errorf("updating the winter menu: %v", diags)
+	}
+	summer := menu("summer")
+	if diags := r.validate(ctx, &summer, ""); diags.HasError() {
+		t.Errorf("summer menu: %v", diags)
+	}
+}
@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &SecurityCameraResource{}
+var _ resource.ResourceWithImportState = &SecurityCameraResource{}
+
+func NewSecurityCameraResource() resource.Resource {
+	return &SecurityCameraResource{}
+}
+
+type SecurityCameraResource struct {
+	client *ProviderConfig
+}
+
+type SecurityCameraResourceModel struct {
+	Quantity        types.Int64  `tfsdk:"quantity"`
+	Resolution      types.String `tfsdk:"resolution"`
+	StoreId         types.String `tfsdk:"store_id"`
+	Cost            MoneyValue   `tfsdk:"cost"`
+	CoveragePercent types.Number `tfsdk:"coverage_percent"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
+}
+
+// cameraCoverage is the floor area in square feet one camera of each
+// resolution watches. The keys are also the accepted resolutions.
+var cameraCoverage = map[string]float64{
+	"720p":  400,
+	"1080p": 600,
+	"4k":    900,
+}
+
+func (r *SecurityCameraResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_security_camera"
+}
+
+func (r *SecurityCameraResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Eyes on the dining room after closing time. The provider's first non-food equipment outside the kitchen: cameras are priced by resolution, and when linked to a store they report how much of its floor they cover.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_store" "main" {
+  # ...
+  square_feet = 2400
+}
+
+resource "hw_security_camera" "dining" {
+  quantity   = 3
+  resolution = "1080p"
+  store_id   = hw_store.main.id
+  # cost computed as $450 (3 × $150)
+  # coverage_percent computed as 75 (3 × 600 sq ft of 2400)
+}
+` + "```" + `
+
+**Key Concepts:**
+- Resolutions: 720p ($80, 400 sq ft), 1080p ($150, 600 sq ft), 4k ($300, 900 sq ft) per camera
+- ` + "`coverage_percent`" + ` is the cameras' combined coverage over the store's ` + "`square_feet`" + `, capped at 100
+- Coverage is null when the camera isn't linked to a store or the store has no ` + "`square_feet`" + `
+
+*Red light blinking slow,*
+*Empty booths in black and white,*
+*Nothing stirs but crumbs.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"quantity": schema.Int64Attribute{
+				MarkdownDescription: "Number of cameras (at least 1)",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Camera Quantity", min: 1, max: math.MaxInt64},
+				},
+			},
+			"resolution": schema.StringAttribute{
+				MarkdownDescription: "Camera resolution: 720p, 1080p, or 4k. Higher resolutions cost more and cover more floor",
+				Required:            true,
+			},
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_store the cameras watch. Needed for `coverage_percent`",
+				Optional:            true,
+			},
+			"cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Total cost in dollars (quantity × the per-camera price: 720p=$80, 1080p=$150, 4k=$300)",
+			},
+			"coverage_percent": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Percentage of the store's `square_feet` the cameras cover, capped at 100 (null without a linked store that sets `square_feet`)",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Security camera identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SecurityCameraResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *SecurityCameraResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SecurityCameraResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setCostAndCoverage(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resolution := data.Resolution.ValueString()
+	id := r.client.NewId("camera", fmt.Sprintf("%s-%d", resolution, len(resolution)))
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created a security camera resource", map[string]any{
+		"id":   data.Id.ValueString(),
+		"cost": data.Cost.ValueBigFloat().String(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecurityCameraResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SecurityCameraResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost and coverage
+	resp.Diagnostics.Append(r.setCostAndCoverage(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecurityCameraResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SecurityCameraResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost and coverage
+	resp.Diagnostics.Append(r.setCostAndCoverage(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state SecurityCameraResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Resolution.Equal(state.Resolution) {
+		resolution := data.Resolution.ValueString()
+		id := r.client.NewId("camera", fmt.Sprintf("%s-%d", resolution, len(resolution)))
+		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecurityCameraResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SecurityCameraResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted a security camera resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *SecurityCameraResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setCostAndCoverage validates the cameras' resolution and computes their cost
+// and, when linked to a store with a known floor area, the share of it they
+// cover.
+func (r *SecurityCameraResource) setCostAndCoverage(data *SecurityCameraResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	resolution := data.Resolution.ValueString()
+	perCamera, ok := cameraCoverage[resolution]
+	if !ok {
+		diags.AddAttributeError(
+			path.Root("resolution"),
+			"Invalid Camera Resolution",
+			fmt.Sprintf("Resolution %q is not supported. Supported resolutions: %s.", resolution, strings.Join([]string{"720p", "1080p", "4k"}, ", ")),
+		)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	quantity := data.Quantity.ValueInt64()
+	var totalCost big.Float
+	totalCost.Mul(big.NewFloat(float64(quantity)), r.client.BasePrice("camera_"+resolution))
+	data.Cost = r.client.Price(ApplyUpcharge(&totalCost, r.client.Upcharge))
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+
+	data.CoveragePercent = types.NumberNull()
+	store, ok := LookupRecord[StoreResourceModel](r.client.Registry, data.StoreId.ValueString())
+	if !ok || store.SquareFeet.IsNull() || store.SquareFeet.IsUnknown() {
+		return diags
+	}
+	squareFeet, _ := store.SquareFeet.ValueBigFloat().Float64()
+	if squareFeet > 0 {
+		coverage := math.Min(100, math.Round(float64(quantity)*perCamera/squareFeet*100))
+		data.CoveragePercent = types.NumberValue(big.NewFloat(coverage))
+	}
+	return diags
+}
@@ -3,13 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
-	"math/big"
+	"math"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -17,6 +18,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &SilverwareResource{}
 var _ resource.ResourceWithImportState = &SilverwareResource{}
+var _ resource.ResourceWithUpgradeState = &SilverwareResource{}
 
 func NewSilverwareResource() resource.Resource {
 	return &SilverwareResource{}
@@ -29,10 +31,18 @@ type SilverwareResource struct {
 
 // SilverwareResourceModel describes the resource data model.
 type SilverwareResourceModel struct {
-	Description types.String `tfsdk:"description"`
-	Quantity    types.Number `tfsdk:"quantity"`
-	Price       types.Number `tfsdk:"price"`
-	Id          types.String `tfsdk:"id"`
+	Description     types.String `tfsdk:"description"`
+	Quantity        types.Int64  `tfsdk:"quantity"`
+	Price           MoneyValue   `tfsdk:"price"`
+	UnitPrice       MoneyValue   `tfsdk:"unit_price"`
+	Subtotal        MoneyValue   `tfsdk:"subtotal"`
+	Discount        MoneyValue   `tfsdk:"discount"`
+	Total           MoneyValue   `tfsdk:"total"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
 }
 
 func (r *SilverwareResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -41,6 +51,8 @@ func (r *SilverwareResource) Metadata(ctx context.Context, req resource.Metadata
 
 func (r *SilverwareResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		// Version 1 made quantity a whole number
+		Version: 1,
 		MarkdownDescription: `Essential dining tools bundled together, demonstrating quantity-based resources and bulk pricing. Learn to manage sets and packs while setting the table for your Terraform journey.
 
 **Example Usage:**
@@ -74,7 +86,8 @@ resource "hw_silverware" "variable_order" {
 - Demonstrates **quantity-based resources**
 - Shows **computed pricing** ($1.00 per pack)
 - Simple numeric attribute example
-- Price = quantity × $1.00
+- Itemizes ` + "`unit_price`" + `, ` + "`subtotal`" + `, ` + "`discount`" + ` and ` + "`total`" + `
+- Bulk discount: 5% from 25 packs, 10% from 50, 15% from 100
 
 *Fork, knife, and spoon,*
 *Shining in the light,*
@@ -85,14 +98,45 @@ resource "hw_silverware" "variable_order" {
 				MarkdownDescription: "A description of the silverware pack resource",
 				Optional:            true,
 			},
-			"quantity": schema.NumberAttribute{
+			"quantity": schema.Int64Attribute{
 				MarkdownDescription: "The number of silverware packs",
 				Required:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Quantity", min: 1, max: math.MaxInt64},
+				},
 			},
 			"price": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The total price of the silverware packs in dollars (same as `total`)",
+			},
+			"unit_price": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The base price per pack in dollars ($1.00 unless overridden)",
+			},
+			"subtotal": schema.NumberAttribute{
+				CustomType:          MoneyType{},
 				Computed:            true,
-				MarkdownDescription: "The price of the silverware packs in dollars (hardcoded to $1.00 per pack)",
+				MarkdownDescription: "The undiscounted price in dollars (quantity × unit_price)",
 			},
+			"discount": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The bulk discount in dollars (5% from 25 units, 10% from 50, 15% from 100)",
+			},
+			"total": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The final price in dollars (subtotal - discount + upcharge)",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Silverware identifier",
@@ -134,23 +178,21 @@ func (r *SilverwareResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// Simulate API delay
 
-	// Calculate base price: $1.00 per pack, then apply upcharge
-	quantity := data.Quantity.ValueBigFloat()
-	pricePerPack := big.NewFloat(1.00)
-	var basePrice big.Float
-	basePrice.Mul(quantity, pricePerPack)
-	finalPrice := ApplyUpcharge(&basePrice, r.client.Upcharge)
-	data.Price = types.NumberValue(finalPrice)
+	// Itemize price: $1.00 per pack, less any bulk discount, then apply upcharge
+	quantity := data.Quantity.ValueInt64()
+	r.setPrices(&data)
 
 	// Mock resource creation - generate a fake ID
-	id := fmt.Sprintf("silverware-qty-%s", quantity.Text('f', 0))
+	id := r.client.NewId("silverware", fmt.Sprintf("qty-%d", quantity))
 	data.Id = types.StringValue(id)
 
 	tflog.Trace(ctx, "created a silverware resource", map[string]any{
 		"id":       data.Id.ValueString(),
-		"quantity": data.Quantity.ValueBigFloat().String(),
+		"quantity": data.Quantity.ValueInt64(),
 	})
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -168,15 +210,13 @@ func (r *SilverwareResource) Read(ctx context.Context, req resource.ReadRequest,
 	// Simulate API delay
 
 	// Recalculate price based on quantity
-	quantity := data.Quantity.ValueBigFloat()
-	pricePerPack := big.NewFloat(1.00)
-	var totalPrice big.Float
-	totalPrice.Mul(quantity, pricePerPack)
-	data.Price = types.NumberValue(&totalPrice)
+	r.setPrices(&data)
 
 	// Mock resource read - just return the existing state
 	// In a real implementation, this would fetch from an API
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -194,11 +234,8 @@ func (r *SilverwareResource) Update(ctx context.Context, req resource.UpdateRequ
 	// Simulate API delay
 
 	// Recalculate price based on quantity
-	quantity := data.Quantity.ValueBigFloat()
-	pricePerPack := big.NewFloat(1.00)
-	var totalPrice big.Float
-	totalPrice.Mul(quantity, pricePerPack)
-	data.Price = types.NumberValue(&totalPrice)
+	quantity := data.Quantity.ValueInt64()
+	r.setPrices(&data)
 
 	// Mock resource update
 	var state SilverwareResourceModel
@@ -209,12 +246,14 @@ func (r *SilverwareResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	// Keep existing ID unless quantity changed significantly
 	if !data.Quantity.Equal(state.Quantity) {
-		id := fmt.Sprintf("silverware-qty-%s", quantity.Text('f', 0))
+		id := r.client.NewId("silverware", fmt.Sprintf("qty-%d", quantity))
 		data.Id = types.StringValue(id)
 	} else {
 		data.Id = state.Id
 	}
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -237,6 +276,25 @@ func (r *SilverwareResource) Delete(ctx context.Context, req resource.DeleteRequ
 	})
 }
 
+func (r *SilverwareResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: numberToInt64Upgrader("quantity"),
+	}
+}
+
 func (r *SilverwareResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// setPrices itemizes the silverware price for the configured quantity: the
+// per-pack price from the pricing engine ($1.00 by default), the subtotal,
+// the bulk discount and the total including upcharge. Price mirrors the total.
+func (r *SilverwareResource) setPrices(data *SilverwareResourceModel) {
+	bulk := r.client.BulkPriceFor("silverware", data.Quantity.ValueInt64())
+	data.UnitPrice = NewMoneyValue(bulk.UnitPrice)
+	data.Subtotal = NewMoneyValue(bulk.Subtotal)
+	data.Discount = NewMoneyValue(bulk.Discount)
+	data.Total = r.client.Price(bulk.Total)
+	data.Price = r.client.Price(bulk.Total)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+}
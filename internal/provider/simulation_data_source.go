@@ -0,0 +1,339 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SimulationDataSource{}
+
+func NewSimulationDataSource() datasource.DataSource {
+	return &SimulationDataSource{}
+}
+
+// SimulationDataSource defines the data source implementation.
+type SimulationDataSource struct {
+	client *ProviderConfig
+}
+
+// SimulationDataSourceModel describes the data source data model.
+type SimulationDataSourceModel struct {
+	StoreId       types.String `tfsdk:"store_id"`
+	Days          types.Int64  `tfsdk:"days"`
+	AverageTicket MoneyValue   `tfsdk:"average_ticket"`
+	Series        types.List   `tfsdk:"series"`
+	TotalRevenue  MoneyValue   `tfsdk:"total_revenue"`
+	TotalCost     MoneyValue   `tfsdk:"total_cost"`
+	TotalProfit   MoneyValue   `tfsdk:"total_profit"`
+	Id            types.String `tfsdk:"id"`
+}
+
+// simulationDayAttrTypes are the attribute types of a series element.
+var simulationDayAttrTypes = map[string]attr.Type{
+	"day":       types.Int64Type,
+	"weekday":   types.StringType,
+	"customers": types.Int64Type,
+	"revenue":   MoneyType{},
+	"cost":      MoneyType{},
+	"profit":    MoneyType{},
+}
+
+// simulationDayModel is an element of the series list.
+type simulationDayModel struct {
+	Day       int64      `tfsdk:"day"`
+	Weekday   string     `tfsdk:"weekday"`
+	Customers int64      `tfsdk:"customers"`
+	Revenue   MoneyValue `tfsdk:"revenue"`
+	Cost      MoneyValue `tfsdk:"cost"`
+	Profit    MoneyValue `tfsdk:"profit"`
+}
+
+// maxSimulationDays caps days at a year of trading.
+const maxSimulationDays = 365
+
+// Each day's demand fills between minDemand and maxDemand of the store's
+// capacity for the hours it is open.
+const (
+	minDemand = 0.6
+	maxDemand = 1.0
+)
+
+func (d *SimulationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_simulation"
+}
+
+func (d *SimulationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `A day-by-day trading simulation of a sandwich shop. Each day, demand fills a random share of the store's capacity for the hours it is open, and the day's revenue, cost and profit follow. Demonstrates a data source returning a list of nested objects built from another resource's computed values.
+
+**Example Usage:**
+
+` + "```hcl" + `
+data "hw_simulation" "first_month" {
+  store_id = hw_store.main.id
+  days     = 28
+}
+
+output "first_month" {
+  value = {
+    profit    = data.hw_simulation.first_month.total_profit
+    best_day  = max(data.hw_simulation.first_month.series[*].revenue...)
+    loss_days = length([for day in data.hw_simulation.first_month.series : day if day.profit < 0])
+  }
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates **structured output**: ` + "`series`" + ` is a list of objects to filter, aggregate and chart
+- Day 1 is a Monday; each day's capacity is the store's ` + "`customers_per_hour`" + ` times the hours it is open that weekday, from its ` + "`operating_hours`" + `
+- Demand fills 60% to 100% of capacity, drawn from the provider's ` + "`seed`" + `: the same seed and store give the same series
+- The daily cost is the store's cooks' daily wages, paid on closed days too
+- A store the provider has no record of, such as one managed in another configuration without a shared ` + "`backend_path`" + `, gives a warning and a null simulation
+
+*Busy Monday noon,*
+*Quiet Sunday, ovens cool,*
+*Sums roll into weeks.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_store to simulate",
+				Required:            true,
+			},
+			"days": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Number of days to simulate, from 1 to %d", maxSimulationDays),
+				Required:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Days", min: 1, max: maxSimulationDays},
+				},
+			},
+			"average_ticket": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				MarkdownDescription: "Dollars each customer spends (defaults to the ticket behind the store's `estimated_weekly_revenue`, or the menu's average ticket when the store has no operating hours)",
+				Optional:            true,
+				Computed:            true,
+			},
+			"series": schema.ListNestedAttribute{
+				MarkdownDescription: "One entry per simulated day, in order",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"day": schema.Int64Attribute{
+							MarkdownDescription: "Day number, starting at 1",
+							Computed:            true,
+						},
+						"weekday": schema.StringAttribute{
+							MarkdownDescription: "Day of the week, lowercase",
+							Computed:            true,
+						},
+						"customers": schema.Int64Attribute{
+							MarkdownDescription: "Customers served that day",
+							Computed:            true,
+						},
+						"revenue": schema.NumberAttribute{
+							CustomType:          MoneyType{},
+							MarkdownDescription: "Customers times the average ticket, in dollars",
+							Computed:            true,
+						},
+						"cost": schema.NumberAttribute{
+							CustomType:          MoneyType{},
+							MarkdownDescription: "Running cost of the day in dollars",
+							Computed:            true,
+						},
+						"profit": schema.NumberAttribute{
+							CustomType:          MoneyType{},
+							MarkdownDescription: "Revenue minus cost in dollars; negative on a loss",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"total_revenue": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				MarkdownDescription: "Revenue over all simulated days in dollars",
+				Computed:            true,
+			},
+			"total_cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				MarkdownDescription: "Cost over all simulated days in dollars",
+				Computed:            true,
+			},
+			"total_profit": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				MarkdownDescription: "Profit over all simulated days in dollars",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SimulationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	d.client = config
+}
+
+func (d *SimulationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SimulationDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storeId := data.StoreId.ValueString()
+	store, known, diags := lookupReference[StoreResourceModel](d.client, path.Root("store_id"), storeId, "store")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Id = types.StringValue(fmt.Sprintf("simulation-%s-%d", storeId, data.Days.ValueInt64()))
+
+	// Without the store's record there is nothing to simulate
+	if !known {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("store_id"),
+			"Store Not Read",
+			fmt.Sprintf("The provider has no record of %s in this run, so the simulation is null. Set the provider's backend_path for data sources to see every store.", storeId),
+		)
+		if !isSet(data.AverageTicket.NumberValue) {
+			data.AverageTicket = NewMoneyNull()
+		}
+		data.Series = types.ListNull(types.ObjectType{AttrTypes: simulationDayAttrTypes})
+		data.TotalRevenue = NewMoneyNull()
+		data.TotalCost = NewMoneyNull()
+		data.TotalProfit = NewMoneyNull()
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	hours := dailyOperatingHours(ctx, store.OperatingHours)
+	customersPerHour, _ := store.CustomersPerHour.ValueBigFloat().Float64()
+
+	// The store's ticket is what its weekly revenue estimate was priced at
+	averageTicket := d.client.AverageTicket()
+	var weeklyHours float64
+	for _, dayHours := range hours {
+		weeklyHours += dayHours
+	}
+	if weeklyCustomers := customersPerHour * weeklyHours; weeklyCustomers > 0 {
+		averageTicket = new(big.Float).Quo(store.EstimatedWeeklyRevenue.ValueBigFloat(), big.NewFloat(weeklyCustomers))
+	}
+	if isSet(data.AverageTicket.NumberValue) {
+		averageTicket = data.AverageTicket.ValueBigFloat()
+	}
+	if averageTicket.Sign() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("average_ticket"),
+			"Invalid Average Ticket",
+			fmt.Sprintf("average_ticket must not be negative, got %s.", averageTicket.String()),
+		)
+		return
+	}
+	ticketCents := toCents(averageTicket)
+
+	var dailyCostCents int64
+	if cooks, ok := store.CostBreakdown.Attributes()["cooks"].(MoneyValue); ok && isSet(cooks.NumberValue) {
+		dailyCostCents = cooks.Cents()
+	}
+
+	if weeklyHours == 0 {
+		resp.Diagnostics.AddWarning(
+			"Store Never Open",
+			fmt.Sprintf("%s has no operating_hours, so it serves no customers and every simulated day is a loss. Add operating_hours blocks to the store.", storeId),
+		)
+	}
+
+	rng := d.client.Rand("simulation/" + storeId)
+	days := make([]simulationDayModel, 0, data.Days.ValueInt64())
+	var revenueCents, costCents int64
+	for day := range data.Days.ValueInt64() {
+		weekday := weekdays[day%7]
+		demand := minDemand + (maxDemand-minDemand)*rng.Float64()
+		customers := int64(customersPerHour * hours[weekday] * demand)
+
+		dayRevenue := customers * ticketCents
+		revenueCents += dayRevenue
+		costCents += dailyCostCents
+
+		days = append(days, simulationDayModel{
+			Day:       day + 1,
+			Weekday:   weekday,
+			Customers: customers,
+			Revenue:   NewMoneyCents(dayRevenue),
+			Cost:      NewMoneyCents(dailyCostCents),
+			Profit:    NewMoneyCents(dayRevenue - dailyCostCents),
+		})
+	}
+
+	series, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: simulationDayAttrTypes}, days)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.AverageTicket = NewMoneyCents(ticketCents)
+	data.Series = series
+	data.TotalRevenue = NewMoneyCents(revenueCents)
+	data.TotalCost = NewMoneyCents(costCents)
+	data.TotalProfit = NewMoneyCents(revenueCents - costCents)
+
+	tflog.Trace(ctx, "read simulation data source", map[string]any{
+		"store_id":     storeId,
+		"days":         data.Days.ValueInt64(),
+		"total_profit": formatCents(revenueCents - costCents),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// dailyOperatingHours returns the hours a store is open on each weekday, from
+// its operating_hours blocks. Days without a block are closed. The blocks were
+// validated when the store was created, so invalid ones are skipped.
+func dailyOperatingHours(ctx context.Context, hours types.List) map[string]float64 {
+	daily := make(map[string]float64, len(weekdays))
+	if hours.IsNull() || hours.IsUnknown() {
+		return daily
+	}
+
+	var days []OperatingHoursModel
+	if diags := hours.ElementsAs(ctx, &days, false); diags.HasError() {
+		return daily
+	}
+	for _, day := range days {
+		open, okOpen := parseClock(day.Open.ValueString())
+		closing, okClose := parseClock(day.Close.ValueString())
+		if okOpen && okClose && closing > open {
+			daily[day.Day.ValueString()] = float64(closing-open) / 60
+		}
+	}
+	return daily
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"strings"
+	"unicode"
+)
+
+// slugFolds spells accented letters without their accents, so catalog names
+// like "pâté" slugify to plain ASCII.
+var slugFolds = strings.NewReplacer(
+	"à", "a", "á", "a", "â", "a", "ä", "a", "ã", "a", "å", "a",
+	"ç", "c",
+	"è", "e", "é", "e", "ê", "e", "ë", "e",
+	"ì", "i", "í", "i", "î", "i", "ï", "i",
+	"ñ", "n",
+	"ò", "o", "ó", "o", "ô", "o", "ö", "o", "õ", "o",
+	"ù", "u", "ú", "u", "û", "u", "ü", "u",
+)
+
+// slugify turns a name into a lowercase, hyphenated identifier: accents are
+// dropped, and every run of characters other than ASCII letters, digits and
+// underscores becomes a single hyphen, with none at either end. For example,
+// "Roast Beef" becomes "roast-beef" and "Pâté" becomes "pate". Underscores
+// are kept because IDs already join words with them, as in
+// amenity-coffee_machine-14. It backs the slugify provider function, the
+// hw_catalog keys and resource IDs, so they all agree.
+func slugify(name string) string {
+	var slug strings.Builder
+	pendingHyphen := false
+	for _, r := range slugFolds.Replace(strings.ToLower(name)) {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_') {
+			pendingHyphen = slug.Len() > 0
+			continue
+		}
+		if pendingHyphen {
+			slug.WriteByte('-')
+			pendingHyphen = false
+		}
+		slug.WriteRune(r)
+	}
+	return slug.String()
+}
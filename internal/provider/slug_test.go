@@ -0,0 +1,22 @@
+package provider
+
+import "testing"
+
+func TestSlugify(t *testing.T) {
+	tests := map[string]string{
+		"ham":                "ham",
+		"Roast Beef":         "roast-beef",
+		"pâté":               "pate",
+		"oven_high-capacity": "oven_high-capacity",
+		"  oil and vinegar ": "oil-and-vinegar",
+		"Bob's   Deli!":      "bob-s-deli",
+		"--":                 "",
+		"":                   "",
+	}
+
+	for name, want := range tests {
+		if got := slugify(name); got != want {
+			t.Errorf("slugify(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
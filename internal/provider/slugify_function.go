@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &SlugifyFunction{}
+
+func NewSlugifyFunction() function.Function {
+	return &SlugifyFunction{}
+}
+
+// SlugifyFunction defines the function implementation.
+type SlugifyFunction struct{}
+
+func (f *SlugifyFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "slugify"
+}
+
+func (f *SlugifyFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Turns a name into the lowercase, hyphenated key the provider uses",
+		MarkdownDescription: `Turns a name into a lowercase, hyphenated identifier: accents are dropped, and every run of characters other than letters, digits and underscores becomes a single hyphen. The provider slugifies the same way for ` + "`hw_catalog`" + ` keys and the names in resource IDs, so keys built in configuration line up with what the provider returns.
+
+**Example Usage:**
+
+` + "```hcl" + `
+locals {
+  meats = ["Roast Beef", "Pâté", "turkey"]
+}
+
+# Keyed like data.hw_catalog.meats.items: roast-beef, pate, turkey
+resource "hw_meat" "lunch" {
+  for_each = { for meat in local.meats : provider::hw::slugify(meat) => lower(meat) }
+
+  kind = each.value
+}
+
+output "roast_beef_id" {
+  # meat-roast-beef-10
+  value = hw_meat.lunch[provider::hw::slugify("Roast Beef")].id
+}
+` + "```" + `
+
+*Spaces turn to dashes,*
+*Capitals bow to lowercase,*
+*One name, one true key.*`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "Name to slugify, such as a catalog entry or a resource's `kind`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SlugifyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, slugify(name)))
+}
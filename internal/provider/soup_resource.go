@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -29,11 +31,15 @@ type SoupResource struct {
 
 // SoupResourceModel describes the resource data model.
 type SoupResourceModel struct {
-	Description types.String `tfsdk:"description"`
-	Kind        types.String `tfsdk:"kind"`
-	Temperature types.String `tfsdk:"temperature"`
-	Price       types.Number `tfsdk:"price"`
-	Id          types.String `tfsdk:"id"`
+	Description     types.String `tfsdk:"description"`
+	Kind            types.String `tfsdk:"kind"`
+	Temperature     types.String `tfsdk:"temperature"`
+	Price           MoneyValue   `tfsdk:"price"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
 }
 
 func (r *SoupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -96,6 +102,7 @@ resource "hw_soup" "menu" {
 - Demonstrates **string attributes** for kind and temperature
 - Shows **computed price** attribute (always $2.50)
 - Useful for learning basic resource structure
+- Kind must be in the ` + "`hw_soups`" + ` catalog, which also supplies the default temperature
 - Temperature must be "hot" or "cold"
 
 *Steam rises gently,*
@@ -108,17 +115,29 @@ resource "hw_soup" "menu" {
 				Optional:            true,
 			},
 			"kind": schema.StringAttribute{
-				MarkdownDescription: "The kind of soup (e.g., tomato, chicken noodle, vegetable)",
+				MarkdownDescription: "The kind of soup from the `hw_soups` catalog (e.g., tomato, chicken noodle, vegetable)",
 				Required:            true,
+				Validators: []validator.String{
+					soupCatalogValidator{},
+				},
 			},
 			"temperature": schema.StringAttribute{
-				MarkdownDescription: "The temperature of the soup (hot or cold)",
-				Required:            true,
+				MarkdownDescription: "The temperature of the soup (hot or cold). Defaults to the kind's temperature in the `hw_soups` catalog",
+				Optional:            true,
+				Computed:            true,
 			},
 			"price": schema.NumberAttribute{
+				CustomType:          MoneyType{},
 				Computed:            true,
 				MarkdownDescription: "The price of the soup in dollars (hardcoded to $2.50)",
 			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Soup identifier",
@@ -160,13 +179,15 @@ func (r *SoupResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	// Simulate API delay
 
+	// Serve at the catalog temperature unless configured
+	data.Temperature = soupTemperature(data)
+
 	// Set base price: $2.50, then apply upcharge
-	basePrice := big.NewFloat(2.50)
-	finalPrice := ApplyUpcharge(basePrice, r.client.Upcharge)
-	data.Price = types.NumberValue(finalPrice)
+	data.Price = r.client.Price(r.calculatePrice())
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
 
 	// Mock resource creation - generate a fake ID based on the kind
-	id := fmt.Sprintf("soup-%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString()))
+	id := r.client.NewId("soup", fmt.Sprintf("%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString())))
 	data.Id = types.StringValue(id)
 
 	tflog.Trace(ctx, "created a soup resource", map[string]any{
@@ -175,6 +196,8 @@ func (r *SoupResource) Create(ctx context.Context, req resource.CreateRequest, r
 		"temperature": data.Temperature.ValueString(),
 	})
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -192,11 +215,14 @@ func (r *SoupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// Simulate API delay
 
 	// Ensure price is set (in case it wasn't in state)
-	data.Price = types.NumberValue(big.NewFloat(2.50))
+	data.Price = r.client.Price(r.calculatePrice())
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
 
 	// Mock resource read - just return the existing state
 	// In a real implementation, this would fetch from an API
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -213,8 +239,12 @@ func (r *SoupResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	// Simulate API delay
 
-	// Ensure price is always set to $2.50
-	data.Price = types.NumberValue(big.NewFloat(2.50))
+	// Serve at the catalog temperature unless configured
+	data.Temperature = soupTemperature(data)
+
+	// Ensure price is always set to $2.50 + upcharge
+	data.Price = r.client.Price(r.calculatePrice())
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
 
 	// Mock resource update - regenerate ID if kind changed
 	var state SoupResourceModel
@@ -225,13 +255,15 @@ func (r *SoupResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	// If kind changed, regenerate ID
 	if !data.Kind.Equal(state.Kind) {
-		id := fmt.Sprintf("soup-%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString()))
+		id := r.client.NewId("soup", fmt.Sprintf("%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString())))
 		data.Id = types.StringValue(id)
 	} else {
 		// Keep existing ID
 		data.Id = state.Id
 	}
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -257,3 +289,45 @@ func (r *SoupResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 func (r *SoupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// calculatePrice returns the soup price from the pricing engine (2.50 by
+// default) plus the provider upcharge.
+func (r *SoupResource) calculatePrice() *big.Float {
+	return ApplyUpcharge(r.client.BasePrice("soup"), r.client.Upcharge)
+}
+
+// soupTemperature returns the soup's configured temperature, or the default
+// temperature of its kind in the soup catalog when unset.
+func soupTemperature(data SoupResourceModel) types.String {
+	if !data.Temperature.IsNull() && !data.Temperature.IsUnknown() {
+		return data.Temperature
+	}
+	return types.StringValue(soupCatalog[data.Kind.ValueString()].Temperature)
+}
+
+// soupCatalogValidator checks that a soup kind is in the soup catalog.
+type soupCatalogValidator struct{}
+
+var _ validator.String = soupCatalogValidator{}
+
+func (v soupCatalogValidator) Description(ctx context.Context) string {
+	return "value must be a soup kind from the soup catalog"
+}
+
+func (v soupCatalogValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a soup kind from the `hw_soups` catalog"
+}
+
+func (v soupCatalogValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, ok := soupCatalog[req.ConfigValue.ValueString()]; !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Unknown Soup",
+			fmt.Sprintf("%q is not in the soup catalog. Catalog soups: %s.", req.ConfigValue.ValueString(), strings.Join(SoupKinds(), ", ")),
+		)
+	}
+}
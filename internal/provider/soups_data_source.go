@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SoupsDataSource{}
+
+func NewSoupsDataSource() datasource.DataSource {
+	return &SoupsDataSource{}
+}
+
+// SoupsDataSource defines the data source implementation.
+type SoupsDataSource struct {
+	client any
+}
+
+// SoupsDataSourceModel describes the data source data model.
+type SoupsDataSourceModel struct {
+	Season types.String `tfsdk:"season"`
+	Kinds  types.List   `tfsdk:"kinds"`
+	Soups  types.Map    `tfsdk:"soups"`
+	Id     types.String `tfsdk:"id"`
+}
+
+// soupInfo describes a soup in the soup catalog.
+type soupInfo struct {
+	// Temperature is the temperature the soup is served at unless an hw_soup
+	// sets one
+	Temperature string `tfsdk:"temperature"`
+	// Seasons are the seasons the soup is on the menu, in calendar order
+	Seasons []string `tfsdk:"seasons"`
+}
+
+// soupSeasons are the seasons of the year, in calendar order.
+var soupSeasons = []string{"spring", "summer", "autumn", "winter"}
+
+// soupCatalog is the soup catalog: each soup kind the kitchen makes, with its
+// default temperature and the seasons it's available.
+var soupCatalog = map[string]soupInfo{
+	"butternut squash": {Temperature: "hot", Seasons: []string{"autumn"}},
+	"chicken noodle":   {Temperature: "hot", Seasons: []string{"spring", "autumn", "winter"}},
+	"clam chowder":     {Temperature: "hot", Seasons: []string{"spring", "winter"}},
+	"cucumber":         {Temperature: "cold", Seasons: []string{"summer"}},
+	"french onion":     {Temperature: "hot", Seasons: []string{"autumn", "winter"}},
+	"gazpacho":         {Temperature: "cold", Seasons: []string{"summer"}},
+	"minestrone":       {Temperature: "hot", Seasons: []string{"autumn", "winter"}},
+	"split pea":        {Temperature: "hot", Seasons: []string{"winter"}},
+	"tomato":           {Temperature: "hot", Seasons: soupSeasons},
+	"vegetable":        {Temperature: "hot", Seasons: soupSeasons},
+	"vichyssoise":      {Temperature: "cold", Seasons: []string{"spring", "summer"}},
+}
+
+// soupInfoAttrTypes are the attribute types of a soups map element.
+var soupInfoAttrTypes = map[string]attr.Type{
+	"temperature": types.StringType,
+	"seasons":     types.ListType{ElemType: types.StringType},
+}
+
+// SoupKinds returns the sorted soup kinds in the soup catalog.
+func SoupKinds() []string {
+	return slices.Sorted(maps.Keys(soupCatalog))
+}
+
+func (d *SoupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_soups"
+}
+
+func (d *SoupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The soups the kitchen makes, each with the temperature it's served at and the seasons it's on the menu. ` + "`hw_soup`" + ` only accepts kinds from this catalog.
+
+**Example Usage:**
+
+` + "```hcl" + `
+data "hw_soups" "winter" {
+  season = "winter"
+}
+
+# Put every winter soup on the menu at its usual temperature
+resource "hw_soup" "winter" {
+  for_each = data.hw_soups.winter.soups
+
+  kind        = each.key
+  temperature = each.value.temperature
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates a **catalog data source** with a **map nested attribute** that drives ` + "`for_each`" + `
+- Optional ` + "`season`" + ` filter: spring, summer, autumn, or winter
+- ` + "`hw_soup`" + ` validates its ` + "`kind`" + ` against the catalog and defaults its ` + "`temperature`" + ` from it
+
+*First frost on the glass,*
+*Split pea returns to the board,*
+*Gazpacho sleeps now.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"season": schema.StringAttribute{
+				MarkdownDescription: "Only list soups available in this season: spring, summer, autumn, or winter",
+				Optional:            true,
+			},
+			"kinds": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "List of soup kinds, in alphabetical order",
+				Computed:            true,
+			},
+			"soups": schema.MapNestedAttribute{
+				MarkdownDescription: "Map of soup kind to its details",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"temperature": schema.StringAttribute{
+							MarkdownDescription: "Temperature the soup is served at by default: hot or cold",
+							Computed:            true,
+						},
+						"seasons": schema.ListAttribute{
+							ElementType:         types.StringType,
+							MarkdownDescription: "Seasons the soup is available, in calendar order",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SoupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData
+}
+
+func (d *SoupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SoupsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	season := data.Season.ValueString()
+	if season != "" && !slices.Contains(soupSeasons, season) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("season"),
+			"Invalid Season",
+			fmt.Sprintf("Season must be one of %s, got %q.", strings.Join(soupSeasons, ", "), season),
+		)
+		return
+	}
+
+	kinds := []string{}
+	soups := make(map[string]soupInfo)
+	for _, kind := range SoupKinds() {
+		soup := soupCatalog[kind]
+		if season != "" && !slices.Contains(soup.Seasons, season) {
+			continue
+		}
+		kinds = append(kinds, kind)
+		soups[kind] = soup
+	}
+
+	catalogs := catalogsOf(d.client)
+	kindsValue, diags := cachedValue(catalogs, "soups/kinds/"+season, func() (types.List, diag.Diagnostics) {
+		return types.ListValueFrom(ctx, types.StringType, kinds)
+	})
+	resp.Diagnostics.Append(diags...)
+	soupsValue, diags := cachedValue(catalogs, "soups/soups/"+season, func() (types.Map, diag.Diagnostics) {
+		return types.MapValueFrom(ctx, types.ObjectType{AttrTypes: soupInfoAttrTypes}, soups)
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Kinds = kindsValue
+	data.Soups = soupsValue
+	data.Id = types.StringValue("soups")
+	if season != "" {
+		data.Id = types.StringValue("soups-" + season)
+	}
+
+	tflog.Trace(ctx, "read soups data source", map[string]any{
+		"season": season,
+		"count":  len(kinds),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
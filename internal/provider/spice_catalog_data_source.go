@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SpiceCatalogDataSource{}
+
+func NewSpiceCatalogDataSource() datasource.DataSource {
+	return &SpiceCatalogDataSource{}
+}
+
+// SpiceCatalogDataSource defines the data source implementation.
+type SpiceCatalogDataSource struct {
+	client any
+}
+
+// SpiceCatalogDataSourceModel describes the data source data model.
+type SpiceCatalogDataSourceModel struct {
+	Spices  types.List   `tfsdk:"spices"`
+	Flavors types.List   `tfsdk:"flavors"`
+	Id      types.String `tfsdk:"id"`
+}
+
+// spiceFlavors is the spice catalog: each spice the kitchen stocks, with the
+// flavor it brings. Each spice's jar price is the "spice_<name>" base price.
+var spiceFlavors = map[string]string{
+	"basil":    "herbal",
+	"chili":    "heat",
+	"cinnamon": "sweet",
+	"cumin":    "earthy",
+	"nutmeg":   "sweet",
+	"oregano":  "herbal",
+	"paprika":  "smoky",
+	"pepper":   "heat",
+	"salt":     "savory",
+	"turmeric": "earthy",
+}
+
+// Spices returns the sorted spices in the spice catalog.
+func Spices() []string {
+	return slices.Sorted(maps.Keys(spiceFlavors))
+}
+
+// SpiceFlavors returns the sorted, distinct flavors the spice catalog covers.
+func SpiceFlavors() []string {
+	return slices.Compact(slices.Sorted(maps.Values(spiceFlavors)))
+}
+
+func (d *SpiceCatalogDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_spice_catalog"
+}
+
+func (d *SpiceCatalogDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The spices the kitchen stocks and the flavors they bring. ` + "`hw_spice_rack`" + ` only accepts spices from this catalog.
+
+**Example Usage:**
+
+` + "```hcl" + `
+data "hw_spice_catalog" "all" {}
+
+# One of everything
+resource "hw_spice_rack" "full" {
+  spices = data.hw_spice_catalog.all.spices
+}
+
+output "flavors" {
+  value = data.hw_spice_catalog.all.flavors
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates a **catalog data source** that a resource's set validator checks against
+- Flavors: earthy, heat, herbal, savory, smoky, sweet
+- Returns both lists in alphabetical order
+
+*Tins in tidy rows,*
+*Paprika, cumin, and salt,*
+*Every dish finds one.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"spices": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "List of spices in the catalog, in alphabetical order",
+				Computed:            true,
+			},
+			"flavors": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "List of the distinct flavors the catalog's spices bring, in alphabetical order",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SpiceCatalogDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData
+}
+
+func (d *SpiceCatalogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SpiceCatalogDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalogs := catalogsOf(d.client)
+	spices, diags := cachedValue(catalogs, "spice_catalog/spices", func() (types.List, diag.Diagnostics) {
+		return types.ListValueFrom(ctx, types.StringType, Spices())
+	})
+	resp.Diagnostics.Append(diags...)
+	flavors, diags := cachedValue(catalogs, "spice_catalog/flavors", func() (types.List, diag.Diagnostics) {
+		return types.ListValueFrom(ctx, types.StringType, SpiceFlavors())
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Spices = spices
+	data.Flavors = flavors
+	data.Id = types.StringValue("spice-catalog")
+
+	tflog.Trace(ctx, "read spice catalog data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
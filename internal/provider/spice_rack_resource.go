@@ -0,0 +1,311 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &SpiceRackResource{}
+var _ resource.ResourceWithImportState = &SpiceRackResource{}
+
+func NewSpiceRackResource() resource.Resource {
+	return &SpiceRackResource{}
+}
+
+type SpiceRackResource struct {
+	client *ProviderConfig
+}
+
+type SpiceRackResourceModel struct {
+	Spices          types.Set    `tfsdk:"spices"`
+	Description     types.String `tfsdk:"description"`
+	Cost            MoneyValue   `tfsdk:"cost"`
+	FlavorCoverage  types.Number `tfsdk:"flavor_coverage"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
+}
+
+func (r *SpiceRackResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_spice_rack"
+}
+
+func (r *SpiceRackResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `A rack of spice jars by the grill. Each spice from the ` + "`hw_spice_catalog`" + ` is priced by the jar and brings one flavor, and the rack reports how much of the catalog's flavor range it covers.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_spice_rack" "grill" {
+  spices = ["salt", "pepper", "paprika", "oregano"]
+  # cost computed as $11.00 (1.00 + 2.50 + 4.50 + 3.00)
+  # flavor_coverage computed as 67 (savory, heat, smoky, herbal: 4 of 6 flavors)
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates a **set attribute** with a **set validator** that checks every element against the ` + "`hw_spice_catalog`" + `
+- Validation runs at plan time, before any resource is created
+- Each spice is priced by the jar: salt $1.00, pepper $2.50, basil and oregano $3.00, chili and cumin $3.50, cinnamon and turmeric $4.00, paprika $4.50, nutmeg $5.00
+- ` + "`flavor_coverage`" + ` is the share of the catalog's 6 flavors the rack's spices bring, rounded to a whole percent
+
+*Jars along the shelf,*
+*A pinch of this, a dash there,*
+*The soup tastes like home.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"spices": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Spices on the rack, from the `hw_spice_catalog` data source (at least one)",
+				Required:            true,
+				Validators: []validator.Set{
+					spiceCatalogValidator{},
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the spice rack",
+				Optional:            true,
+			},
+			"cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Cost in dollars: the jar prices of the spices, plus any provider upcharge",
+			},
+			"flavor_coverage": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Percentage of the catalog's flavors the rack's spices bring, from 0 to 100",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Spice rack identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SpiceRackResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *SpiceRackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SpiceRackResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spices, diags := r.setCostAndCoverage(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kind := strings.Join(spices, "_")
+	id := r.client.NewId("spices", fmt.Sprintf("%s-%d", kind, len(kind)))
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created a spice rack resource", map[string]any{
+		"id":              data.Id.ValueString(),
+		"flavor_coverage": data.FlavorCoverage.ValueBigFloat().String(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SpiceRackResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SpiceRackResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost and coverage
+	_, diags := r.setCostAndCoverage(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SpiceRackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SpiceRackResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost and coverage
+	spices, diags := r.setCostAndCoverage(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state SpiceRackResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The set is compared by value, so reordering spices keeps the ID
+	if !data.Spices.Equal(state.Spices) {
+		kind := strings.Join(spices, "_")
+		id := r.client.NewId("spices", fmt.Sprintf("%s-%d", kind, len(kind)))
+		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SpiceRackResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SpiceRackResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted a spice rack resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *SpiceRackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setCostAndCoverage prices the rack's spices by the jar and computes the
+// share of the catalog's flavors they bring. The spices themselves were
+// checked against the catalog by spiceCatalogValidator. It returns the
+// spices sorted, for building the ID.
+func (r *SpiceRackResource) setCostAndCoverage(ctx context.Context, data *SpiceRackResourceModel) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var spices []string
+	diags.Append(data.Spices.ElementsAs(ctx, &spices, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	slices.Sort(spices)
+
+	totalCost := new(big.Float)
+	var flavors []string
+	for _, spice := range spices {
+		flavor, ok := spiceFlavors[spice]
+		if !ok {
+			continue
+		}
+		totalCost.Add(totalCost, r.client.BasePrice("spice_"+spice))
+		if !slices.Contains(flavors, flavor) {
+			flavors = append(flavors, flavor)
+		}
+	}
+
+	coverage := math.Round(float64(len(flavors)) * 100 / float64(len(SpiceFlavors())))
+	data.Cost = r.client.Price(ApplyUpcharge(totalCost, r.client.Upcharge))
+	data.FlavorCoverage = types.NumberValue(big.NewFloat(coverage))
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+	return spices, diags
+}
+
+// spiceCatalogValidator checks that a set of spices is not empty and that
+// every spice is in the spice catalog.
+type spiceCatalogValidator struct{}
+
+var _ validator.Set = spiceCatalogValidator{}
+
+func (v spiceCatalogValidator) Description(ctx context.Context) string {
+	return "set must contain at least one spice, each from the spice catalog"
+}
+
+func (v spiceCatalogValidator) MarkdownDescription(ctx context.Context) string {
+	return "set must contain at least one spice, each from the `hw_spice_catalog`"
+}
+
+func (v spiceCatalogValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if len(req.ConfigValue.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Empty Spice Rack",
+			"A spice rack needs at least one spice from the hw_spice_catalog data source.",
+		)
+		return
+	}
+
+	for _, element := range req.ConfigValue.Elements() {
+		spice, ok := element.(types.String)
+		if !ok || spice.IsNull() || spice.IsUnknown() {
+			continue
+		}
+		if _, ok := spiceFlavors[spice.ValueString()]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtSetValue(spice),
+				"Unknown Spice",
+				fmt.Sprintf("%q is not in the spice catalog. Catalog spices: %s.", spice.ValueString(), strings.Join(Spices(), ", ")),
+			)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &SplitBillFunction{}
+
+// splitBillMaxParties is the most parties split_bill splits a bill between,
+// which keeps a typo from building a list of millions of shares.
+const splitBillMaxParties = 1000
+
+func NewSplitBillFunction() function.Function {
+	return &SplitBillFunction{}
+}
+
+// SplitBillFunction defines the function implementation.
+type SplitBillFunction struct{}
+
+func (f *SplitBillFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "split_bill"
+}
+
+func (f *SplitBillFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Splits a bill into per-person amounts that add up to the total",
+		MarkdownDescription: `Splits a bill between parties, returning what each one pays. The amounts always add up to the total to the cent, however it divides.
+
+**Example Usage:**
+
+` + "```hcl" + `
+# [16.67, 16.67, 16.66]: the leftover cent goes to the first parties
+output "even_split" {
+  value = provider::hw::split_bill(50, 3, false)
+}
+
+# [17, 17, 16]: whole dollars, with the last party covering what's left
+output "cash_split" {
+  value = provider::hw::split_bill(50, 3, true)
+}
+
+output "per_guest" {
+  value = provider::hw::split_bill(hw_order.party.total, var.guests, false)
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates **numeric edge cases** in a provider function: the total is worked in whole cents, so nothing is lost to rounding
+- Without ` + "`round_up`" + `, shares differ by at most a cent
+- With ` + "`round_up`" + `, every party pays the share rounded up to a whole dollar until the total is covered, so the last parties pay less, possibly nothing
+- Fails with an argument error for a negative total or fewer than 1 or more than 1000 parties
+
+*Three friends, one receipt,*
+*A stubborn cent left over,*
+*The first friend pays it.*`,
+		Parameters: []function.Parameter{
+			function.NumberParameter{
+				Name:                "total",
+				MarkdownDescription: "Bill total in dollars, rounded to the cent; may not be negative",
+			},
+			function.Int64Parameter{
+				Name:                "parties",
+				MarkdownDescription: "Number of parties splitting the bill, from 1 to 1000",
+			},
+			function.BoolParameter{
+				Name:                "round_up",
+				MarkdownDescription: "Whether to round shares up to whole dollars, leaving the remainder to the last parties",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.NumberType,
+		},
+	}
+}
+
+func (f *SplitBillFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var total *big.Float
+	var parties int64
+	var roundUp bool
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &total, &parties, &roundUp))
+	if resp.Error != nil {
+		return
+	}
+
+	totalCents := toCents(total)
+	if totalCents < 0 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("total may not be negative, got %s.", total.Text('f', -1)))
+		return
+	}
+	if parties < 1 || parties > splitBillMaxParties {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("parties must be between 1 and %d, got %d.", splitBillMaxParties, parties))
+		return
+	}
+
+	shares := splitBill(totalCents, parties, roundUp)
+	amounts := make([]*big.Float, len(shares))
+	for i, share := range shares {
+		amounts[i] = centsValue(share)
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, amounts))
+}
+
+// splitBill splits totalCents between parties, which must be at least 1, into
+// shares that add up to it exactly. Shares are the total divided evenly, with
+// the leftover cents going one each to the first parties, or, with roundUp,
+// that even share rounded up to a whole dollar until the total is covered.
+func splitBill(totalCents, parties int64, roundUp bool) []int64 {
+	shares := make([]int64, parties)
+	share, leftover := totalCents/parties, totalCents%parties
+
+	if !roundUp {
+		for i := range shares {
+			shares[i] = share
+			if int64(i) < leftover {
+				shares[i]++
+			}
+		}
+		return shares
+	}
+
+	if leftover > 0 {
+		share++
+	}
+	share = (share + 99) / 100 * 100
+	remaining := totalCents
+	for i := range shares {
+		shares[i] = min(share, remaining)
+		remaining -= shares[i]
+	}
+	return shares
+}
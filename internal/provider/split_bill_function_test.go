@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSplitBill(t *testing.T) {
+	tests := []struct {
+		totalCents int64
+		parties    int64
+		roundUp    bool
+		want       []int64
+	}{
+		{5000, 3, false, []int64{1667, 1667, 1666}},
+		{5000, 3, true, []int64{1700, 1700, 1600}},
+		{1000, 4, false, []int64{250, 250, 250, 250}},
+		{1000, 4, true, []int64{300, 300, 300, 100}},
+		{100, 3, true, []int64{100, 0, 0}},
+		{2, 3, false, []int64{1, 1, 0}},
+		{0, 2, true, []int64{0, 0}},
+		{1234, 1, true, []int64{1234}},
+	}
+
+	for _, tt := range tests {
+		got := splitBill(tt.totalCents, tt.parties, tt.roundUp)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("splitBill(%d, %d, %t) = %v, want %v", tt.totalCents, tt.parties, tt.roundUp, got, tt.want)
+		}
+
+		var sum int64
+		for _, share := range got {
+			sum += share
+		}
+		if sum != tt.totalCents {
+			t.Errorf("splitBill(%d, %d, %t) adds up to %d", tt.totalCents, tt.parties, tt.roundUp, sum)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// listToSetUpgrader returns a state upgrader for a schema version that turned
+// the named list attributes into sets. Lists and sets share a JSON encoding,
+// so the prior state only needs duplicate elements dropped; everything else
+// is passed through untouched.
+func listToSetUpgrader(attributes ...string) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			if req.RawState == nil {
+				resp.Diagnostics.AddError("Unable to Upgrade State", "The prior state is missing.")
+				return
+			}
+
+			// Decode numbers as json.Number so costs keep their exact value
+			var state map[string]any
+			decoder := json.NewDecoder(bytes.NewReader(req.RawState.JSON))
+			decoder.UseNumber()
+			if err := decoder.Decode(&state); err != nil {
+				resp.Diagnostics.AddError("Unable to Upgrade State", "The prior state could not be decoded: "+err.Error())
+				return
+			}
+
+			for _, name := range attributes {
+				elements, ok := state[name].([]any)
+				if !ok {
+					continue
+				}
+				unique := make([]any, 0, len(elements))
+				for _, element := range elements {
+					if !slices.Contains(unique, element) {
+						unique = append(unique, element)
+					}
+				}
+				state[name] = unique
+			}
+
+			upgraded, err := json.Marshal(state)
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to Upgrade State", "The upgraded state could not be encoded: "+err.Error())
+				return
+			}
+			resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+		},
+	}
+}
+
+// numberToInt64Upgrader returns a state upgrader for a schema version that
+// turned the named number attributes into whole numbers. Fractional values
+// in the prior state are rounded to the nearest whole number; everything
+// else is passed through untouched.
+func numberToInt64Upgrader(attributes ...string) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			if req.RawState == nil {
+				resp.Diagnostics.AddError("Unable to Upgrade State", "The prior state is missing.")
+				return
+			}
+
+			// Decode numbers as json.Number so costs keep their exact value
+			var state map[string]any
+			decoder := json.NewDecoder(bytes.NewReader(req.RawState.JSON))
+			decoder.UseNumber()
+			if err := decoder.Decode(&state); err != nil {
+				resp.Diagnostics.AddError("Unable to Upgrade State", "The prior state could not be decoded: "+err.Error())
+				return
+			}
+
+			for _, name := range attributes {
+				number, ok := state[name].(json.Number)
+				if !ok {
+					continue
+				}
+				value, err := number.Float64()
+				if err != nil {
+					resp.Diagnostics.AddError("Unable to Upgrade State", fmt.Sprintf("The prior %s %s is not a number: %s", name, number, err))
+					return
+				}
+				state[name] = int64(math.Round(value))
+			}
+
+			upgraded, err := json.Marshal(state)
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to Upgrade State", "The upgraded state could not be encoded: "+err.Error())
+				return
+			}
+			resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+		},
+	}
+}
@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &StickerResource{}
+var _ resource.ResourceWithImportState = &StickerResource{}
+
+func NewStickerResource() resource.Resource {
+	return &StickerResource{}
+}
+
+// StickerResource defines the resource implementation.
+type StickerResource struct {
+	client *ProviderConfig
+}
+
+// StickerResourceModel describes the resource data model.
+type StickerResourceModel struct {
+	Design             types.String `tfsdk:"design"`
+	Description        types.String `tfsdk:"description"`
+	Quantity           types.Int64  `tfsdk:"quantity"`
+	Price              MoneyValue   `tfsdk:"price"`
+	UnitPrice          MoneyValue   `tfsdk:"unit_price"`
+	Subtotal           MoneyValue   `tfsdk:"subtotal"`
+	Discount           MoneyValue   `tfsdk:"discount"`
+	Total              MoneyValue   `tfsdk:"total"`
+	LoyaltySignupBoost types.Number `tfsdk:"loyalty_signup_boost"`
+	PriceMultiplier    types.Number `tfsdk:"price_multiplier"`
+	Tags               types.Map    `tfsdk:"tags"`
+	TagsAll            types.Map    `tfsdk:"tags_all"`
+	Haiku              types.String `tfsdk:"haiku"`
+	Id                 types.String `tfsdk:"id"`
+}
+
+// stickerAppeal is how many percentage points each hundred stickers of a
+// design add to loyalty signups. The keys are also the accepted designs.
+var stickerAppeal = map[string]float64{
+	"logo":        1,
+	"mascot":      2,
+	"holographic": 3,
+}
+
+// maxLoyaltySignupBoost caps the projected loyalty-signup boost in percent:
+// past it, everyone who wanted a sticker has one.
+const maxLoyaltySignupBoost = 25
+
+func (r *StickerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sticker"
+}
+
+func (r *StickerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Stickers handed out at the register, the cheapest way to get customers to sign up for a loyalty card. The flashier the design, the more signups each hundred stickers bring in.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_sticker" "mascot" {
+  design   = "mascot"
+  quantity = 200
+  # total computed as $25.50 ($30.00 less the 15% bulk discount)
+  # loyalty_signup_boost computed as 4 (percent)
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates **quantity-based resources** sharing the bulk-discount engine with ` + "`hw_napkin`" + `
+- Designs: logo ($0.10 each, +1% signups per 100), mascot ($0.15, +2%), holographic ($0.30, +3%)
+- Bulk discount: 5% from 25 stickers, 10% from 50, 15% from 100
+- ` + "`loyalty_signup_boost`" + ` is capped at 25%
+
+*Smiling sandwich face,*
+*Stuck to a laptop lid now,*
+*Punch card in the purse.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"design": schema.StringAttribute{
+				MarkdownDescription: "Sticker design: logo, mascot, or holographic",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description of the sticker resource",
+				Optional:            true,
+			},
+			"quantity": schema.Int64Attribute{
+				MarkdownDescription: "The number of stickers",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Quantity", min: 1, max: math.MaxInt64},
+				},
+			},
+			"price": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The total price of the stickers in dollars (same as `total`)",
+			},
+			"unit_price": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The base price per sticker in dollars (logo=$0.10, mascot=$0.15, holographic=$0.30 unless overridden)",
+			},
+			"subtotal": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The undiscounted price in dollars (quantity × unit_price)",
+			},
+			"discount": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The bulk discount in dollars (5% from 25 units, 10% from 50, 15% from 100)",
+			},
+			"total": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The final price in dollars (subtotal - discount + upcharge)",
+			},
+			"loyalty_signup_boost": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Projected rise in loyalty-card signups in percent: 1 (logo), 2 (mascot) or 3 (holographic) per 100 stickers, up to 25",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Sticker identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *StickerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *StickerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data StickerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Itemize price per sticker of the design, less any bulk discount, then
+	// apply upcharge
+	resp.Diagnostics.Append(r.setPricesAndBoost(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	design := data.Design.ValueString()
+	id := r.client.NewId("sticker", fmt.Sprintf("%s-%d", design, len(design)))
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created a sticker resource", map[string]any{
+		"id":       data.Id.ValueString(),
+		"quantity": data.Quantity.ValueInt64(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StickerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StickerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate price and boost based on design and quantity
+	resp.Diagnostics.Append(r.setPricesAndBoost(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StickerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data StickerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate price and boost based on design and quantity
+	resp.Diagnostics.Append(r.setPricesAndBoost(&data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state StickerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep existing ID unless design changed
+	if !data.Design.Equal(state.Design) {
+		design := data.Design.ValueString()
+		id := r.client.NewId("sticker", fmt.Sprintf("%s-%d", design, len(design)))
+		data.Id = types.StringValue(id)
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StickerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data StickerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a sticker resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *StickerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setPricesAndBoost validates the design and itemizes the sticker price for
+// the configured quantity: the per-sticker price of the design from the
+// pricing engine, the subtotal, the bulk discount and the total including
+// upcharge. Price mirrors the total. It also projects the loyalty-signup
+// boost.
+func (r *StickerResource) setPricesAndBoost(data *StickerResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	design := data.Design.ValueString()
+	appeal, ok := stickerAppeal[design]
+	if !ok {
+		diags.AddAttributeError(
+			path.Root("design"),
+			"Invalid Sticker Design",
+			fmt.Sprintf("Design %q is not supported. Supported designs: %s.", design, strings.Join(slices.Sorted(maps.Keys(stickerAppeal)), ", ")),
+		)
+		return diags
+	}
+
+	quantity := data.Quantity.ValueInt64()
+	bulk := r.client.BulkPriceFor("sticker_"+design, quantity)
+	data.UnitPrice = NewMoneyValue(bulk.UnitPrice)
+	data.Subtotal = NewMoneyValue(bulk.Subtotal)
+	data.Discount = NewMoneyValue(bulk.Discount)
+	data.Total = r.client.Price(bulk.Total)
+	data.Price = r.client.Price(bulk.Total)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+
+	boost := math.Min(math.Round(float64(quantity)/100*appeal*10)/10, maxLoyaltySignupBoost)
+	data.LoyaltySignupBoost = types.NumberValue(big.NewFloat(math.Max(boost, 0)))
+	return diags
+}
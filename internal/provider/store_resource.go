@@ -3,8 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/big"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,6 +23,7 @@ import (
 
 var _ resource.Resource = &StoreResource{}
 var _ resource.ResourceWithImportState = &StoreResource{}
+var _ resource.ResourceWithUpgradeState = &StoreResource{}
 
 func NewStoreResource() resource.Resource {
 	return &StoreResource{}
@@ -26,17 +33,59 @@ type StoreResource struct {
 	client *ProviderConfig
 }
 
+// OperatingHoursModel describes the operating_hours block data model.
+type OperatingHoursModel struct {
+	Day   types.String `tfsdk:"day"`
+	Open  types.String `tfsdk:"open"`
+	Close types.String `tfsdk:"close"`
+}
+
 type StoreResourceModel struct {
-	Name                  types.String `tfsdk:"name"`
-	OvenId                types.String `tfsdk:"oven_id"`
-	CookIds                types.List   `tfsdk:"cook_ids"`
-	TablesId              types.String `tfsdk:"tables_id"`
-	ChairsId              types.String `tfsdk:"chairs_id"`
-	FridgeId              types.String `tfsdk:"fridge_id"`
-	Description           types.String `tfsdk:"description"`
-	Cost                  types.Number `tfsdk:"cost"`
-	CustomersPerHour      types.Number `tfsdk:"customers_per_hour"`
-	Id                    types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	OvenId                 types.String `tfsdk:"oven_id"`
+	OvenIds                types.Set    `tfsdk:"oven_ids"`
+	CookIds                types.Set    `tfsdk:"cook_ids"`
+	EmployeeIds            types.Set    `tfsdk:"employee_ids"`
+	TablesId               types.String `tfsdk:"tables_id"`
+	ChairsId               types.String `tfsdk:"chairs_id"`
+	FridgeId               types.String `tfsdk:"fridge_id"`
+	AmenityIds             types.Set    `tfsdk:"amenity_ids"`
+	ParkingLotId           types.String `tfsdk:"parking_lot_id"`
+	BinIds                 types.Set    `tfsdk:"bin_ids"`
+	JanitorIds             types.Set    `tfsdk:"janitor_ids"`
+	LastDeepClean          types.String `tfsdk:"last_deep_clean"`
+	WifiId                 types.String `tfsdk:"wifi_id"`
+	MenuItemIds            types.Set    `tfsdk:"menu_item_ids"`
+	DessertCaseId          types.String `tfsdk:"dessert_case_id"`
+	Location               types.String `tfsdk:"location"`
+	SquareFeet             types.Number `tfsdk:"square_feet"`
+	RegionalMultiplier     types.Number `tfsdk:"regional_multiplier"`
+	Description            types.String `tfsdk:"description"`
+	Status                 types.String `tfsdk:"status"`
+	DeletionProtection     types.Bool   `tfsdk:"deletion_protection"`
+	Cost                   MoneyValue   `tfsdk:"cost"`
+	CostBreakdown          types.Object `tfsdk:"cost_breakdown"`
+	CookCapacity           types.Number `tfsdk:"cook_capacity"`
+	SuggestedCooks         types.Int64  `tfsdk:"suggested_additional_cooks"`
+	CustomersPerHour       types.Number `tfsdk:"customers_per_hour"`
+	Bottleneck             types.String `tfsdk:"bottleneck"`
+	BottleneckAdvice       types.String `tfsdk:"bottleneck_advice"`
+	OperatingHours         types.List   `tfsdk:"operating_hours"`
+	EstimatedWeeklyRevenue MoneyValue   `tfsdk:"estimated_weekly_revenue"`
+	AverageRating          types.Number `tfsdk:"average_rating"`
+	AmbianceScore          types.Number `tfsdk:"ambiance_score"`
+	SustainabilityScore    types.Number `tfsdk:"sustainability_score"`
+	CleanlinessScore       types.Number `tfsdk:"cleanliness_score"`
+	DwellTimeFactor        types.Number `tfsdk:"dwell_time_factor"`
+	PriceMultiplier        types.Number `tfsdk:"price_multiplier"`
+	MenuPayload            types.String `tfsdk:"menu_payload"`
+	MenuUrl                types.String `tfsdk:"menu_url"`
+	LastSyncedAt           types.String `tfsdk:"last_synced_at"`
+	Version                types.Int64  `tfsdk:"version"`
+	Tags                   types.Map    `tfsdk:"tags"`
+	TagsAll                types.Map    `tfsdk:"tags_all"`
+	Haiku                  types.String `tfsdk:"haiku"`
+	Id                     types.String `tfsdk:"id"`
 }
 
 func (r *StoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -45,7 +94,9 @@ func (r *StoreResource) Metadata(ctx context.Context, req resource.MetadataReque
 
 func (r *StoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: `The complete sandwich shop resource that brings together all components into a functioning business. Demonstrates complex resource dependencies, list attributes, and computed values that aggregate costs and calculate capacity from multiple child resources.
+		// Version 1 turned cook_ids, oven_ids and amenity_ids into sets
+		Version: 1,
+		MarkdownDescription: `The complete sandwich shop resource that brings together all components into a functioning business. Demonstrates complex resource dependencies, set attributes, and computed values that aggregate costs and calculate capacity from multiple child resources.
 
 **Example Usage:**
 
@@ -94,8 +145,20 @@ resource "hw_store" "main" {
   chairs_id   = hw_chairs.seating.id
   fridge_id   = hw_fridge.storage.id
   description = "Main downtown location"
+
+  operating_hours {
+    day   = "monday"
+    open  = "07:00"
+    close = "15:00"
+  }
+
+  operating_hours {
+    day   = "saturday"
+    open  = "09:00"
+    close = "13:30"
+  }
   
-  # cost and customers_per_hour are automatically computed
+  # cost, customers_per_hour and estimated_weekly_revenue are automatically computed
 }
 
 # Output the store details
@@ -104,16 +167,66 @@ output "store_info" {
     name              = hw_store.main.name
     total_cost        = hw_store.main.cost
     customers_per_hour = hw_store.main.customers_per_hour
+    cook_capacity      = hw_store.main.cook_capacity
+    next_upgrade       = hw_store.main.bottleneck_advice
+    weekly_revenue     = hw_store.main.estimated_weekly_revenue
   }
 }
+
+# Show where the money goes
+output "store_costs" {
+  value = hw_store.main.cost_breakdown
+}
+
+# A busier location with two ovens
+resource "hw_oven" "backup" {
+  type = "commercial"
+}
+
+resource "hw_amenity" "drive_thru" {
+  type = "drive_thru"
+}
+
+resource "hw_store" "uptown" {
+  name        = "Uptown Deli"
+  location    = "metro"
+  oven_ids    = [hw_oven.main.id, hw_oven.backup.id]
+  cook_ids    = [hw_cook.chef1.id, hw_cook.chef2.id]
+  tables_id   = hw_tables.dining.id
+  chairs_id   = hw_chairs.seating.id
+  fridge_id   = hw_fridge.storage.id
+  amenity_ids = [hw_amenity.drive_thru.id]
+}
 ` + "```" + `
 
 **Key Concepts:**
 - Demonstrates **complex resource dependencies**
-- Requires: oven, at least one cook, tables, chairs, and fridge
-- Shows **list attributes** (cook_ids can have multiple cooks)
+- Requires: at least one oven, staff (` + "`cook_ids`" + ` or ` + "`employee_ids`" + `, but not both), tables, chairs, and fridge
+- Warns when the chairs provide fewer seats than the tables need (a **cross-resource invariant**)
+- Scale the hot side with ` + "`oven_ids`" + ` - each oven adds throughput (standard 20, commercial 30, high-capacity 40 customers/hour)
+- Shows **set attributes** (cook_ids can have multiple cooks, and reordering them causes no diff)
+- Optional ` + "`amenity_ids`" + ` reference ` + "`hw_amenity`" + ` resources of different types, each with its own effect on cost, capacity, or revenue
+- Weights ` + "`cook_capacity`" + ` by each cook's experience (junior 8, experienced 12, expert 15 customers/hour)
 - Computes total cost from all components
+- Scales component and labor costs by ` + "`location`" + ` (rural ×0.85, suburban ×1, urban ×1.2, metro ×1.5)
+- Itemizes that cost in the ` + "`cost_breakdown`" + ` nested attribute
+- Uses **nested blocks** (` + "`operating_hours`" + `) for per-day schedules
+- Estimates weekly revenue as capacity × open hours × the menu's average ticket, raised by active ` + "`hw_marketing_campaign`" + ` resources on the refresh after they are created; this needs the provider's ` + "`backend_path`" + `
 - Calculates customers_per_hour based on capacity
+- Optional ` + "`parking_lot_id`" + ` caps capacity at what the ` + "`hw_parking_lot`" + ` can park
+- Names the limiting component in ` + "`bottleneck`" + ` and what to add next in ` + "`bottleneck_advice`" + `
+- Suggests how many cooks to hire in ` + "`suggested_additional_cooks`" + `, a number ready to drive a ` + "`count`" + ` or ` + "`for_each`" + ` of ` + "`hw_cook`" + ` resources
+- Optional ` + "`square_feet`" + ` lets linked equipment such as ` + "`hw_security_camera`" + ` compute how much of the floor it covers
+- Averages the ` + "`hw_review`" + ` ratings written about the store into ` + "`average_rating`" + ` on the refresh after they are written; this needs the provider's ` + "`backend_path`" + `
+- Takes its ` + "`ambiance_score`" + ` from the best ` + "`hw_music_playlist`" + ` playing in it on the refresh after the playlist is created; this needs the provider's ` + "`backend_path`" + `
+- Scores ` + "`sustainability_score`" + ` from the ` + "`hw_compost_bin`" + ` and ` + "`hw_recycling_bin`" + ` resources in ` + "`bin_ids`" + `
+- Lets ` + "`cleanliness_score`" + ` decay day by day after ` + "`last_deep_clean`" + ` unless the ` + "`hw_janitor`" + ` resources in ` + "`janitor_ids`" + ` cover enough shifts
+- Takes its ` + "`dwell_time_factor`" + ` from the ` + "`hw_wifi`" + ` in ` + "`wifi_id`" + `
+- Only lists cookies, brownies, or stroopwafels in ` + "`menu_item_ids`" + ` with an ` + "`hw_dessert_case`" + ` in ` + "`dessert_case_id`" + ` that has a tray for each
+- With ` + "`deletion_protection = true`" + `, destroying or replacing the store fails until the protection is turned off and applied
+- ` + "`menu_payload`" + ` and ` + "`menu_url`" + ` encode the menu for **piping into other providers**, such as a ` + "`local_file`" + ` or a DNS TXT record
+- A **noise attribute** for practicing ` + "`lifecycle { ignore_changes }`" + `: with ` + "`drift = [\"hw_store\"]`" + ` in the provider, ` + "`last_synced_at`" + ` changes on every refresh
+- A **lifecycle attribute**: ` + "`status`" + ` is open, closed, or seasonal. A closed store serves 0 customers per hour, earns nothing, and refuses new ` + "`hw_order`" + ` and ` + "`hw_reservation`" + ` resources. A closed or seasonal store must be opened before it moves to the other. Opening a closed store requires an ` + "`hw_occupancy_permit`" + ` for more people than ` + "`customers_per_hour`" + `
 
 *All pieces unite,*
 *Kitchen, staff, and seating,*
@@ -121,17 +234,27 @@ output "store_info" {
 
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the store",
+				MarkdownDescription: "Name of the store, unique among the provider's stores unless the provider sets `allow_duplicate_names`. The check sees every store only with the provider's `backend_path`; without one it misses stores the apply leaves unchanged",
 				Required:            true,
 			},
 			"oven_id": schema.StringAttribute{
-				MarkdownDescription: "ID of the hw_oven resource (required)",
-				Required:            true,
+				MarkdownDescription: "ID of the hw_oven resource. Set this, `oven_ids`, or both; the store needs at least one oven",
+				Optional:            true,
 			},
-			"cook_ids": schema.ListAttribute{
+			"oven_ids": schema.SetAttribute{
 				ElementType:         types.StringType,
-				MarkdownDescription: "List of hw_cook resource IDs (at least one required)",
-				Required:            true,
+				MarkdownDescription: "Set of hw_oven resource IDs for stores with several ovens. Combined with `oven_id` (duplicates are ignored), and each oven adds its throughput to capacity",
+				Optional:            true,
+			},
+			"cook_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Set of hw_cook resource IDs. Exactly one of `cook_ids` or `employee_ids` must be set",
+				Optional:            true,
+			},
+			"employee_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Set of hw_employee resource IDs, an alternative to `cook_ids` that accounts for the whole team. Every employee adds their daily cost; only cooks add `cook_capacity`. Exactly one of `cook_ids` or `employee_ids` must be set",
+				Optional:            true,
 			},
 			"tables_id": schema.StringAttribute{
 				MarkdownDescription: "ID of the hw_tables resource (required)",
@@ -145,24 +268,187 @@ output "store_info" {
 				MarkdownDescription: "ID of the hw_fridge resource (required)",
 				Required:            true,
 			},
+			"amenity_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Set of hw_amenity resource IDs. Each amenity adds its cost, and depending on its type raises capacity or the average ticket",
+				Optional:            true,
+			},
+			"parking_lot_id": schema.StringAttribute{
+				MarkdownDescription: "ID of an hw_parking_lot. When set, `customers_per_hour` can't exceed the customers per hour the lot can park",
+				Optional:            true,
+			},
+			"bin_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Set of hw_compost_bin and hw_recycling_bin resource IDs. Each kind of bin raises `sustainability_score`",
+				Optional:            true,
+			},
+			"janitor_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Set of hw_janitor resource IDs. Their weekly shifts slow the decay of `cleanliness_score`",
+				Optional:            true,
+			},
+			"last_deep_clean": schema.StringAttribute{
+				MarkdownDescription: "Date of the store's last deep clean (`YYYY-MM-DD`). `cleanliness_score` decays from 100 after it; without it the store is treated as freshly cleaned",
+				Optional:            true,
+			},
+			"wifi_id": schema.StringAttribute{
+				MarkdownDescription: "ID of an hw_wifi offered to customers. Sets `dwell_time_factor`",
+				Optional:            true,
+			},
+			"menu_item_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Set of menu item IDs the store sells (hw_sandwich, hw_drink, hw_soup, hw_salad, hw_cookie, hw_brownie, hw_stroopwafel). Desserts require `dessert_case_id`",
+				Optional:            true,
+			},
+			"dessert_case_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_dessert_case desserts are displayed in. Required when `menu_item_ids` lists any cookie, brownie, or stroopwafel, and needs a tray for each",
+				Optional:            true,
+			},
+			"location": schema.StringAttribute{
+				MarkdownDescription: "Where the store is: rural, suburban, urban, or metro. Scales component and labor costs by the regional multiplier (defaults to suburban)",
+				Optional:            true,
+			},
+			"square_feet": schema.NumberAttribute{
+				MarkdownDescription: "Floor area of the store in square feet. Used by linked equipment such as `hw_security_camera` to compute coverage",
+				Optional:            true,
+			},
+			"regional_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Multiplier applied to component and labor costs for the store's `location` (rural 0.85, suburban 1, urban 1.2, metro 1.5)",
+			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Description of the store",
 				Optional:            true,
 			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Trading status: open (the default), closed, or seasonal. Closed stores serve no customers and take no orders or reservations; seasonal stores trade as open ones do while in season. Closed and seasonal stores must go back to open before switching to the other. Opening a closed store requires an `hw_occupancy_permit` whose max_occupancy exceeds `customers_per_hour`",
+				Optional:            true,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Whether to refuse to delete the store, including deletes for replacement. Set it to false and apply before destroying the store",
+				Optional:            true,
+			},
 			"cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
 				Computed:            true,
 				MarkdownDescription: "Total cost of the store (sum of all component costs)",
 				PlanModifiers: []planmodifier.Number{
 					numberplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"cost_breakdown": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"oven": schema.NumberAttribute{
+						CustomType:          MoneyType{},
+						MarkdownDescription: "Estimated cost of all ovens",
+						Computed:            true,
+					},
+					"cooks": schema.NumberAttribute{
+						CustomType:          MoneyType{},
+						MarkdownDescription: "Estimated cost of all cooks, or of all employees when staffed with `employee_ids`",
+						Computed:            true,
+					},
+					"tables": schema.NumberAttribute{
+						CustomType:          MoneyType{},
+						MarkdownDescription: "Estimated tables cost",
+						Computed:            true,
+					},
+					"chairs": schema.NumberAttribute{
+						CustomType:          MoneyType{},
+						MarkdownDescription: "Estimated chairs cost",
+						Computed:            true,
+					},
+					"fridge": schema.NumberAttribute{
+						CustomType:          MoneyType{},
+						MarkdownDescription: "Estimated fridge cost",
+						Computed:            true,
+					},
+					"amenities": schema.NumberAttribute{
+						CustomType:          MoneyType{},
+						MarkdownDescription: "Cost of all amenities",
+						Computed:            true,
+					},
+					"upcharge": schema.NumberAttribute{
+						CustomType:          MoneyType{},
+						MarkdownDescription: "Provider upcharge added to the total",
+						Computed:            true,
+					},
+				},
+				MarkdownDescription: "Itemized contributions to `cost` (the items sum to the total)",
+				Computed:            true,
+			},
+			"cook_capacity": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Customers per hour the cooks can serve, weighted by experience (junior 8, experienced 12, expert 15). Cooks whose `hw_cook` record is not known to the provider count as 12",
+			},
+			"suggested_additional_cooks": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "How many more cooks it takes for `cook_capacity` to reach the seating capacity (40 customers/hour plus amenities), assuming new cooks work like the store's `hw_cook` resources on average (12 customers/hour each without any). 0 when the cooks already keep up",
+			},
 			"customers_per_hour": schema.NumberAttribute{
 				Computed:            true,
-				MarkdownDescription: "Maximum customers per hour capacity (based on cooks, tables, and oven)",
+				MarkdownDescription: "Maximum customers per hour capacity (based on cooks, tables, and the combined throughput of all ovens)",
 				PlanModifiers: []planmodifier.Number{
 					numberplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"bottleneck": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The component that limits `customers_per_hour`: cooks, seating, oven, parking, or register, or closed when the store's `status` is closed",
+			},
+			"bottleneck_advice": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "What to add next to raise `customers_per_hour` past the current `bottleneck`",
+			},
+			"estimated_weekly_revenue": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Estimated weekly revenue in dollars: `customers_per_hour` × weekly open hours × the average menu ticket (0 when no `operating_hours` are configured), raised by the `demand_boost_percent` of the store's active `hw_marketing_campaign` resources. Campaigns are read after their store, so the store only learns of them from the provider's `backend_path`: with one set, new campaigns appear here on the next refresh, and without one they never do",
+			},
+			"average_rating": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Mean star rating of the store's `hw_review` resources, rounded to one decimal (null until a review is known). Reviews are read after their store, so the store only learns of them from the provider's `backend_path`: with one set, new reviews appear here on the next refresh, and without one this stays null",
+			},
+			"ambiance_score": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "How pleasant the store is, from 0 to 100: the best `ambiance_score` of the `hw_music_playlist` resources playing in it (null until a playlist is known). Playlists are read after their store, so the store only learns of them from the provider's `backend_path`: with one set, changes appear here on the next refresh, and without one this stays null",
+			},
+			"sustainability_score": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "How green the store is, from 0 to 100: 20 to start, 30 for any compost bin in `bin_ids` and 10 more if one is large, and 10 for each distinct material its recycling bins take",
+			},
+			"cleanliness_score": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "How clean the store is as of the provider's `as_of` date (or today), from 0 to 100: 100 less 3 points a day since `last_deep_clean`, scaled by the share of 7 weekly shifts its janitors leave uncovered",
+			},
+			"dwell_time_factor": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "How much longer customers stay than without wifi, as a multiplier: the `dwell_time_factor` of the `hw_wifi` in `wifi_id` (1 without wifi or until the wifi is known)",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"menu_payload": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The store's menu as base64url-encoded JSON (unpadded): its `name` and an `items` list of `{id, item, price}` sorted by ID. Deterministic, so it only changes when the menu, prices, or upcharge do",
+			},
+			"menu_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Link to the store's menu, carrying `menu_payload` in its `m` query parameter. Ready to render as a QR code",
+			},
+			"last_synced_at": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "When the store was last synced, as an RFC 3339 UTC timestamp. Defaults to when it was created. When the provider's `drift` lists `hw_store`, every refresh moves it to the current time, so setting it in configuration gives a diff on each plan unless it is listed in `lifecycle { ignore_changes }`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version":  versionAttribute(),
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Store identifier",
@@ -171,6 +457,27 @@ output "store_info" {
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"operating_hours": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"day": schema.StringAttribute{
+							MarkdownDescription: "Day of the week, lowercase (`monday` through `sunday`)",
+							Required:            true,
+						},
+						"open": schema.StringAttribute{
+							MarkdownDescription: "Opening time in 24-hour `HH:MM` format (e.g., `07:00`)",
+							Required:            true,
+						},
+						"close": schema.StringAttribute{
+							MarkdownDescription: "Closing time in 24-hour `HH:MM` format, after `open` (`24:00` closes at midnight)",
+							Required:            true,
+						},
+					},
+				},
+				MarkdownDescription: "Opening hours for one day of the week. Repeat the block once per open day; days without a block are closed.",
+			},
+		},
 	}
 }
 
@@ -204,64 +511,38 @@ func (r *StoreResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Note: In a real implementation, we would read the actual resources from state
 	// For this teaching example, we compute based on reasonable assumptions
 	
-	// Get number of cooks
-	var cookIds []types.String
-	resp.Diagnostics.Append(data.CookIds.ElementsAs(ctx, &cookIds, false)...)
+	inputs, diags := storeInputsFrom(ctx, &data, r.client)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	numCooks := float64(len(cookIds))
-
-	// Estimate costs based on typical values (students will optimize these)
-	// These are simplified estimates - in practice, would read from actual resources
-	ovenCost := big.NewFloat(1000.0)   // Average oven cost
-	cookCost := big.NewFloat(160.0)    // Average daily cook cost
-	tablesCost := big.NewFloat(500.0)  // Average tables cost
-	chairsCost := big.NewFloat(300.0)  // Average chairs cost
-	fridgeCost := big.NewFloat(500.0)  // Average fridge cost
-
-	// Calculate total cost
-	var totalCost big.Float
-	totalCost.Add(&totalCost, ovenCost)
-	
-	var cookTotalCost big.Float
-	cookTotalCost.Mul(big.NewFloat(numCooks), cookCost)
-	totalCost.Add(&totalCost, &cookTotalCost)
-	
-	totalCost.Add(&totalCost, tablesCost)
-	totalCost.Add(&totalCost, chairsCost)
-	totalCost.Add(&totalCost, fridgeCost)
-
-	// Apply upcharge if configured
-	finalCost := ApplyUpcharge(&totalCost, r.client.Upcharge)
-	data.Cost = types.NumberValue(finalCost)
 
-	// Calculate customers per hour capacity
-	// Based on: cooks (8-15 per hour each), tables (2 customers/hour per seat), oven (10-30/hour)
-	// Simplified calculation: min of cook capacity, table capacity, oven capacity
-	
-	// Cook capacity: average 12 customers/hour per cook
-	cookCapacity := numCooks * 12.0
-	
-	// Table capacity: estimate 20 seats * 2 customers/hour = 40 customers/hour
-	tableCapacity := 40.0
-	
-	// Oven capacity: estimate 20 customers/hour
-	ovenCapacity := 20.0
-	
-	// Customers per hour is the minimum (bottleneck)
-	customersPerHour := cookCapacity
-	if tableCapacity < customersPerHour {
-		customersPerHour = tableCapacity
+	resp.Diagnostics.Append(r.estimate(inputs).apply(&data, r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if ovenCapacity < customersPerHour {
-		customersPerHour = ovenCapacity
+
+	resp.Diagnostics.Append(seatingShortfall(r.client.Registry, data.TablesId.ValueString(), data.ChairsId.ValueString())...)
+	resp.Diagnostics.Append(checkDessertCase(ctx, r.client, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	data.CustomersPerHour = types.NumberValue(big.NewFloat(customersPerHour))
+	resp.Diagnostics.Append(checkStoreName(r.client, &data, "")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	id := fmt.Sprintf("store-%s-%d", data.Name.ValueString(), len(data.Name.ValueString()))
+	id := r.client.NewId("store", fmt.Sprintf("%s-%d", data.Name.ValueString(), len(data.Name.ValueString())))
 	data.Id = types.StringValue(id)
+	if data.LastSyncedAt.IsUnknown() {
+		data.LastSyncedAt = syncedNow()
+	}
+	setChildAggregates(&data, r.client.Registry, StoreResourceModel{})
+	resp.Diagnostics.Append(setMenuPayload(ctx, r.client, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Trace(ctx, "created a store resource", map[string]any{
 		"id":                data.Id.ValueString(),
@@ -270,6 +551,11 @@ func (r *StoreResource) Create(ctx context.Context, req resource.CreateRequest,
 		"customers_per_hour": data.CustomersPerHour.ValueBigFloat().String(),
 	})
 
+	data.Version = types.Int64Value(1)
+	r.client.Registry.SetVersion(data.Id.ValueString(), 1)
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -281,48 +567,33 @@ func (r *StoreResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-
 	// Recalculate cost and capacity (same logic as Create)
-	var cookIds []types.String
-	resp.Diagnostics.Append(data.CookIds.ElementsAs(ctx, &cookIds, false)...)
+	inputs, diags := storeInputsFrom(ctx, &data, r.client)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	numCooks := float64(len(cookIds))
-
-	ovenCost := big.NewFloat(1000.0)
-	cookCost := big.NewFloat(160.0)
-	tablesCost := big.NewFloat(500.0)
-	chairsCost := big.NewFloat(300.0)
-	fridgeCost := big.NewFloat(500.0)
 
-	var totalCost big.Float
-	totalCost.Add(&totalCost, ovenCost)
-	
-	var cookTotalCost big.Float
-	cookTotalCost.Mul(big.NewFloat(numCooks), cookCost)
-	totalCost.Add(&totalCost, &cookTotalCost)
-	
-	totalCost.Add(&totalCost, tablesCost)
-	totalCost.Add(&totalCost, chairsCost)
-	totalCost.Add(&totalCost, fridgeCost)
+	resp.Diagnostics.Append(r.estimate(inputs).apply(&data, r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	setChildAggregates(&data, r.client.Registry, data)
+	resp.Diagnostics.Append(setMenuPayload(ctx, r.client, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	finalCost := ApplyUpcharge(&totalCost, r.client.Upcharge)
-	data.Cost = types.NumberValue(finalCost)
+	data.Version = refreshVersion(r.client.Registry, data.Id.ValueString(), data.Version)
 
-	cookCapacity := numCooks * 12.0
-	tableCapacity := 40.0
-	ovenCapacity := 20.0
-	
-	customersPerHour := cookCapacity
-	if tableCapacity < customersPerHour {
-		customersPerHour = tableCapacity
-	}
-	if ovenCapacity < customersPerHour {
-		customersPerHour = ovenCapacity
+	// Drifting stores resync on every refresh, which changes the record
+	if r.client.Drifts("hw_store") {
+		data.LastSyncedAt = syncedNow()
+		data.Version = types.Int64Value(r.client.Registry.Bump(data.Id.ValueString()))
 	}
 
-	data.CustomersPerHour = types.NumberValue(big.NewFloat(customersPerHour))
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -335,48 +606,23 @@ func (r *StoreResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-
 	// Recalculate cost and capacity (same logic as Create)
-	var cookIds []types.String
-	resp.Diagnostics.Append(data.CookIds.ElementsAs(ctx, &cookIds, false)...)
+	inputs, diags := storeInputsFrom(ctx, &data, r.client)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	numCooks := float64(len(cookIds))
-
-	ovenCost := big.NewFloat(1000.0)
-	cookCost := big.NewFloat(160.0)
-	tablesCost := big.NewFloat(500.0)
-	chairsCost := big.NewFloat(300.0)
-	fridgeCost := big.NewFloat(500.0)
 
-	var totalCost big.Float
-	totalCost.Add(&totalCost, ovenCost)
-	
-	var cookTotalCost big.Float
-	cookTotalCost.Mul(big.NewFloat(numCooks), cookCost)
-	totalCost.Add(&totalCost, &cookTotalCost)
-	
-	totalCost.Add(&totalCost, tablesCost)
-	totalCost.Add(&totalCost, chairsCost)
-	totalCost.Add(&totalCost, fridgeCost)
-
-	finalCost := ApplyUpcharge(&totalCost, r.client.Upcharge)
-	data.Cost = types.NumberValue(finalCost)
-
-	cookCapacity := numCooks * 12.0
-	tableCapacity := 40.0
-	ovenCapacity := 20.0
-	
-	customersPerHour := cookCapacity
-	if tableCapacity < customersPerHour {
-		customersPerHour = tableCapacity
-	}
-	if ovenCapacity < customersPerHour {
-		customersPerHour = ovenCapacity
+	resp.Diagnostics.Append(r.estimate(inputs).apply(&data, r.client)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	data.CustomersPerHour = types.NumberValue(big.NewFloat(customersPerHour))
+	resp.Diagnostics.Append(seatingShortfall(r.client.Registry, data.TablesId.ValueString(), data.ChairsId.ValueString())...)
+	resp.Diagnostics.Append(checkDessertCase(ctx, r.client, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	var state StoreResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -384,12 +630,50 @@ func (r *StoreResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(checkVersion(r.client.Registry, state.Id.ValueString(), state.Version)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if from, to := storeStatus(state), storeStatus(data); !storeStatusTransitionAllowed(from, to) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("status"),
+			"Invalid Store Status Transition",
+			fmt.Sprintf("%s can't go from %s to %s directly. Set status = \"open\" and apply first, then change it to %s.", state.Id.ValueString(), from, to, to),
+		)
+		return
+	}
+
 	if !data.Name.Equal(state.Name) {
-		id := fmt.Sprintf("store-%s-%d", data.Name.ValueString(), len(data.Name.ValueString()))
+		resp.Diagnostics.Append(checkStoreName(r.client, &data, state.Id.ValueString())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		id := r.client.NewId("store", fmt.Sprintf("%s-%d", data.Name.ValueString(), len(data.Name.ValueString())))
 		data.Id = types.StringValue(id)
 	} else {
 		data.Id = state.Id
 	}
+	resp.Diagnostics.Append(checkOccupancyPermit(r.client.Registry, &state, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.LastSyncedAt.IsUnknown() {
+		data.LastSyncedAt = state.LastSyncedAt
+	}
+	setChildAggregates(&data, r.client.Registry, state)
+	resp.Diagnostics.Append(setMenuPayload(ctx, r.client, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Id.Equal(state.Id) {
+		r.client.Registry.Delete(state.Id.ValueString())
+	}
+	data.Version = nextVersion(state.Version)
+	r.client.Registry.SetVersion(data.Id.ValueString(), data.Version.ValueInt64())
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -402,12 +686,701 @@ func (r *StoreResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("deletion_protection"),
+			"Store Deletion Protected",
+			fmt.Sprintf("%s has deletion_protection enabled, so it can't be destroyed or replaced. Set deletion_protection = false and apply, then try again.", data.Id.ValueString()),
+		)
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
 
 	tflog.Trace(ctx, "deleted a store resource", map[string]any{
 		"id": data.Id.ValueString(),
 	})
 }
 
+func (r *StoreResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: listToSetUpgrader("cook_ids", "oven_ids", "amenity_ids"),
+	}
+}
+
 func (r *StoreResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// storeCostBreakdownAttrTypes are the attribute types of cost_breakdown.
+var storeCostBreakdownAttrTypes = map[string]attr.Type{
+	"oven":      MoneyType{},
+	"cooks":     MoneyType{},
+	"tables":    MoneyType{},
+	"chairs":    MoneyType{},
+	"fridge":    MoneyType{},
+	"amenities": MoneyType{},
+	"upcharge":  MoneyType{},
+}
+
+// storeEstimate is the itemized cost and capacity estimate for a store.
+type storeEstimate struct {
+	OvenCost         *big.Float
+	CooksCost        *big.Float
+	TablesCost       *big.Float
+	ChairsCost       *big.Float
+	FridgeCost       *big.Float
+	AmenitiesCost    *big.Float
+	RegionPercent    int64
+	PriceMultiplier  *big.Float
+	Upcharge         *big.Float
+	CookCapacity     float64
+	CustomersPerHour float64
+	// SuggestedCooks is how many more cooks close the gap between
+	// CookCapacity and the seating capacity
+	SuggestedCooks int64
+	// Bottleneck is the component that limits CustomersPerHour
+	Bottleneck          string
+	WeeklyRevenue       *big.Float
+	SustainabilityScore int64
+	CleanlinessScore    float64
+	DwellTimeFactor     *big.Float
+}
+
+// apply copies the estimate into the store's computed attributes, rounding
+// the costs by the provider's rounding policy.
+func (e storeEstimate) apply(data *StoreResourceModel, c *ProviderConfig) diag.Diagnostics {
+	items := map[string]MoneyValue{
+		"oven":      c.Price(e.OvenCost),
+		"cooks":     c.Price(e.CooksCost),
+		"tables":    c.Price(e.TablesCost),
+		"chairs":    c.Price(e.ChairsCost),
+		"fridge":    c.Price(e.FridgeCost),
+		"amenities": c.Price(e.AmenitiesCost),
+		"upcharge":  c.Price(e.Upcharge),
+	}
+
+	// Total the rounded items so the breakdown always adds up
+	total := new(big.Float)
+	values := make(map[string]attr.Value, len(items))
+	for name, item := range items {
+		total.Add(total, item.ValueBigFloat())
+		values[name] = item
+	}
+
+	breakdown, diags := types.ObjectValue(storeCostBreakdownAttrTypes, values)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Cost = c.Price(total)
+	data.CostBreakdown = breakdown
+	data.PriceMultiplier = types.NumberValue(e.PriceMultiplier)
+	data.RegionalMultiplier = types.NumberValue(new(big.Float).Quo(big.NewFloat(float64(e.RegionPercent)), big.NewFloat(100)))
+	data.CookCapacity = types.NumberValue(big.NewFloat(e.CookCapacity))
+	data.SuggestedCooks = types.Int64Value(e.SuggestedCooks)
+	data.CustomersPerHour = types.NumberValue(big.NewFloat(e.CustomersPerHour))
+	data.Bottleneck = types.StringValue(e.Bottleneck)
+	data.BottleneckAdvice = types.StringValue(bottleneckAdvice[e.Bottleneck])
+	data.EstimatedWeeklyRevenue = NewMoneyValue(e.WeeklyRevenue)
+	data.SustainabilityScore = types.NumberValue(big.NewFloat(float64(e.SustainabilityScore)))
+	data.CleanlinessScore = types.NumberValue(big.NewFloat(e.CleanlinessScore))
+	data.DwellTimeFactor = types.NumberValue(e.DwellTimeFactor)
+	return diags
+}
+
+// storeInputs are the component references and schedule a store's estimate
+// is computed from.
+type storeInputs struct {
+	OvenIds     []string
+	CookIds     []string
+	EmployeeIds []string
+	// AmenityTypes are the resolved types of the amenity_ids, in order
+	AmenityTypes []string
+	// RegionPercent is the regional multiplier for the location, in percent
+	RegionPercent int64
+	// ParkingCapacity is the customers per hour the parking lot can park, or
+	// 0 when the store has no parking lot known to the provider
+	ParkingCapacity float64
+	// SustainabilityScore is scored from the bins in bin_ids
+	SustainabilityScore int64
+	// JanitorShifts are the weekly shifts of the janitors in janitor_ids
+	JanitorShifts int64
+	// LastDeepClean is the date of last_deep_clean, or zero when unset
+	LastDeepClean time.Time
+	// DwellTimeFactor is the dwell time factor of the wifi in wifi_id, or 1
+	// when the store has no wifi known to the provider
+	DwellTimeFactor *big.Float
+	// MarketingBoost is the demand boost, in percent, of the store's active
+	// hw_marketing_campaign resources
+	MarketingBoost float64
+	WeeklyHours    float64
+	// Closed is set when the store's status is closed
+	Closed bool
+}
+
+// storeInputsFrom collects and validates the estimate inputs from the store's
+// configuration: the union of oven_id and oven_ids, the cook IDs, the amenity
+// types resolved through the registry, the location's regional multiplier and
+// the weekly open hours from the operating_hours blocks.
+func storeInputsFrom(ctx context.Context, data *StoreResourceModel, client *ProviderConfig) (storeInputs, diag.Diagnostics) {
+	registry := client.Registry
+	var inputs storeInputs
+	var diags diag.Diagnostics
+
+	// Collect ovens from oven_id and oven_ids, ignoring duplicates
+	if !data.OvenId.IsNull() && data.OvenId.ValueString() != "" {
+		inputs.OvenIds = append(inputs.OvenIds, data.OvenId.ValueString())
+	}
+	if !data.OvenIds.IsNull() && !data.OvenIds.IsUnknown() {
+		var ovenIds []string
+		diags.Append(data.OvenIds.ElementsAs(ctx, &ovenIds, false)...)
+		if diags.HasError() {
+			return inputs, diags
+		}
+		for _, id := range ovenIds {
+			if !slices.Contains(inputs.OvenIds, id) {
+				inputs.OvenIds = append(inputs.OvenIds, id)
+			}
+		}
+	}
+	if len(inputs.OvenIds) == 0 {
+		diags.AddAttributeError(
+			path.Root("oven_ids"),
+			"Missing Oven",
+			"A store needs at least one oven. Set oven_id, oven_ids, or both.",
+		)
+		return inputs, diags
+	}
+
+	// Get the staff from exactly one of cook_ids and employee_ids
+	hasCooks := !data.CookIds.IsNull()
+	hasEmployees := !data.EmployeeIds.IsNull()
+	switch {
+	case hasCooks && hasEmployees:
+		diags.AddAttributeError(
+			path.Root("employee_ids"),
+			"Conflicting Staff",
+			"Set either cook_ids or employee_ids, not both. employee_ids can include cooks as hw_employee resources with role = \"cook\".",
+		)
+		return inputs, diags
+	case !hasCooks && !hasEmployees:
+		diags.AddAttributeError(
+			path.Root("cook_ids"),
+			"Missing Staff",
+			"A store needs staff. Set cook_ids to hw_cook IDs or employee_ids to hw_employee IDs.",
+		)
+		return inputs, diags
+	}
+	if !data.CookIds.IsUnknown() {
+		diags.Append(data.CookIds.ElementsAs(ctx, &inputs.CookIds, false)...)
+	}
+	if !data.EmployeeIds.IsUnknown() {
+		diags.Append(data.EmployeeIds.ElementsAs(ctx, &inputs.EmployeeIds, false)...)
+	}
+	if diags.HasError() {
+		return inputs, diags
+	}
+
+	// Resolve each amenity to its type
+	if !data.AmenityIds.IsNull() && !data.AmenityIds.IsUnknown() {
+		var amenityIds []string
+		diags.Append(data.AmenityIds.ElementsAs(ctx, &amenityIds, false)...)
+		if diags.HasError() {
+			return inputs, diags
+		}
+		for _, id := range amenityIds {
+			amenityType, ok := amenityTypeOf(client, id)
+			if !ok {
+				diags.AddAttributeError(
+					path.Root("amenity_ids").AtSetValue(types.StringValue(id)),
+					"Unknown Amenity",
+					fmt.Sprintf("%q is not the ID of an hw_amenity resource.", id),
+				)
+				continue
+			}
+			inputs.AmenityTypes = append(inputs.AmenityTypes, amenityType)
+		}
+		if diags.HasError() {
+			return inputs, diags
+		}
+	}
+
+	// Look up the regional multiplier for the location
+	location := defaultLocation
+	if !data.Location.IsNull() && !data.Location.IsUnknown() {
+		location = data.Location.ValueString()
+	}
+	percent, ok := regionalMultipliers[location]
+	if !ok {
+		diags.AddAttributeError(
+			path.Root("location"),
+			"Invalid Location",
+			fmt.Sprintf("Location %q is not supported. Supported locations: %s.", location, strings.Join(LocationKeys(), ", ")),
+		)
+		return inputs, diags
+	}
+	inputs.RegionPercent = percent
+
+	if !data.SquareFeet.IsNull() && !data.SquareFeet.IsUnknown() && data.SquareFeet.ValueBigFloat().Sign() <= 0 {
+		diags.AddAttributeError(
+			path.Root("square_feet"),
+			"Invalid Square Feet",
+			fmt.Sprintf("square_feet must be greater than zero, got %s.", data.SquareFeet.ValueBigFloat().String()),
+		)
+		return inputs, diags
+	}
+
+	// Parking caps capacity when the lot's record is known
+	if lot, ok := LookupRecord[ParkingLotResourceModel](registry, data.ParkingLotId.ValueString()); ok {
+		inputs.ParkingCapacity, _ = lot.CustomersPerHour.ValueBigFloat().Float64()
+	}
+
+	// Score the compost and recycling bins
+	if !data.BinIds.IsNull() && !data.BinIds.IsUnknown() {
+		var binIds []string
+		diags.Append(data.BinIds.ElementsAs(ctx, &binIds, false)...)
+		if diags.HasError() {
+			return inputs, diags
+		}
+		score, binDiags := sustainabilityScore(client, binIds)
+		diags.Append(binDiags...)
+		if diags.HasError() {
+			return inputs, diags
+		}
+		inputs.SustainabilityScore = score
+	} else {
+		inputs.SustainabilityScore = baseSustainabilityScore
+	}
+
+	// Total the janitors' shifts and parse the last deep clean for the
+	// cleanliness score
+	if !data.JanitorIds.IsNull() && !data.JanitorIds.IsUnknown() {
+		var janitorIds []string
+		diags.Append(data.JanitorIds.ElementsAs(ctx, &janitorIds, false)...)
+		if diags.HasError() {
+			return inputs, diags
+		}
+		for _, id := range janitorIds {
+			if !client.IsIdOf(id, "janitor") {
+				diags.AddAttributeError(
+					path.Root("janitor_ids").AtSetValue(types.StringValue(id)),
+					"Unknown Janitor",
+					fmt.Sprintf("%q is not the ID of an hw_janitor resource.", id),
+				)
+			}
+		}
+		if diags.HasError() {
+			return inputs, diags
+		}
+		inputs.JanitorShifts = janitorShifts(registry, janitorIds)
+	}
+	if !data.LastDeepClean.IsNull() && !data.LastDeepClean.IsUnknown() {
+		cleaned, err := time.Parse(dateLayout, data.LastDeepClean.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("last_deep_clean"),
+				"Invalid Last Deep Clean",
+				fmt.Sprintf("last_deep_clean must be a date in YYYY-MM-DD format, got %q.", data.LastDeepClean.ValueString()),
+			)
+			return inputs, diags
+		}
+		inputs.LastDeepClean = cleaned
+	}
+
+	// Customers linger when the store's wifi record is known
+	inputs.DwellTimeFactor = big.NewFloat(1)
+	if wifiId := data.WifiId.ValueString(); wifiId != "" {
+		if !client.IsIdOf(wifiId, "wifi") {
+			diags.AddAttributeError(
+				path.Root("wifi_id"),
+				"Unknown Wifi",
+				fmt.Sprintf("%q is not the ID of an hw_wifi resource.", wifiId),
+			)
+			return inputs, diags
+		}
+		if wifi, ok := LookupRecord[WifiResourceModel](registry, wifiId); ok {
+			inputs.DwellTimeFactor = wifi.DwellTimeFactor.ValueBigFloat()
+		}
+	}
+
+	inputs.MarketingBoost = client.MarketingBoost(data.Id.ValueString())
+
+	if !data.Status.IsUnknown() {
+		status := storeStatus(*data)
+		if !slices.Contains(storeStatuses, status) {
+			diags.AddAttributeError(
+				path.Root("status"),
+				"Invalid Store Status",
+				fmt.Sprintf("status must be one of %s, got %q.", strings.Join(storeStatuses, ", "), status),
+			)
+			return inputs, diags
+		}
+		inputs.Closed = status == "closed"
+	}
+
+	// Total the weekly open hours from the operating_hours blocks
+	weeklyHours, hoursDiags := weeklyOperatingHours(ctx, data.OperatingHours)
+	diags.Append(hoursDiags...)
+	inputs.WeeklyHours = weeklyHours
+
+	return inputs, diags
+}
+
+// registerCapacity is how many customers per hour the store's register can
+// ring up, the last limit on capacity once the kitchen and seating scale up.
+const registerCapacity = 60.0
+
+// bottleneckAdvice is what to add next for each bottleneck.
+var bottleneckAdvice = map[string]string{
+	"cooks":    "Hire another cook or raise a cook's experience (junior 8, experienced 12, expert 15 customers/hour).",
+	"seating":  "Add tables and chairs, or an hw_amenity that serves customers without a table (drive_thru, patio).",
+	"oven":     "Add an oven to oven_ids or switch to a commercial or high-capacity oven.",
+	"parking":  "Add spaces to the store's hw_parking_lot (each space parks 2 customers/hour).",
+	"register": "The register is at its limit of 60 customers/hour; open another store to grow further.",
+	"closed":   "The store is closed. Set status = \"open\" to serve customers again.",
+}
+
+// storeStatuses are the valid store statuses. Stores without a status are
+// open.
+var storeStatuses = []string{"open", "closed", "seasonal"}
+
+// storeStatus returns the store's status, open when it has none.
+func storeStatus(store StoreResourceModel) string {
+	if store.Status.IsNull() || store.Status.ValueString() == "" {
+		return "open"
+	}
+	return store.Status.ValueString()
+}
+
+// storeStatusTransitionAllowed reports whether a store can go from status
+// from to status to in one apply. Closed and seasonal stores reopen before
+// switching to the other.
+func storeStatusTransitionAllowed(from, to string) bool {
+	return from == to || from == "open" || to == "open"
+}
+
+// checkStoreName refuses a store name another store in the registry, other
+// than the one with ID self, already has, unless the provider allows
+// duplicate names. Without a backend the registry misses the stores an apply
+// leaves unchanged, so only a backend catches every conflict.
+func checkStoreName(client *ProviderConfig, data *StoreResourceModel, self string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if client.AllowDuplicateNames {
+		return diags
+	}
+
+	for _, store := range ListRecords[StoreResourceModel](client.Registry) {
+		if store.Id.ValueString() != self && store.Name.Equal(data.Name) {
+			diags.AddAttributeError(
+				path.Root("name"),
+				"Store Name Conflict",
+				fmt.Sprintf("%s is already named %q, and store names must be unique. Choose another name, or set allow_duplicate_names = true in the provider.",
+					store.Id.ValueString(), data.Name.ValueString()),
+			)
+			break
+		}
+	}
+	return diags
+}
+
+// estimate itemizes the store's cost and computes its customers-per-hour
+// capacity. Component costs are the store_* estimates from the pricing engine
+// (per oven and per cook) plus amenities, scaled by the regional multiplier.
+// Capacity is the minimum (bottleneck) of cook capacity (weighted by each
+// cook's experience), table capacity (20 seats * 2 customers/hour = 40, plus
+// any amenity capacity), the combined throughput of all ovens, the parking
+// lot (when known) and the register, or 0 when the store is closed. Weekly
+// revenue is that capacity over the weekly open hours at the average ticket,
+// raised by any amenity ticket bonuses and active marketing campaigns. The
+// suggested additional cooks close the gap from cook to table capacity.
+func (r *StoreResource) estimate(inputs storeInputs) storeEstimate {
+	numOvens := big.NewFloat(float64(len(inputs.OvenIds)))
+	numCooks := float64(len(inputs.CookIds))
+
+	e := storeEstimate{
+		OvenCost:        new(big.Float).Mul(numOvens, r.client.BasePrice("store_oven")),
+		CooksCost:       new(big.Float).Mul(big.NewFloat(numCooks), r.client.BasePrice("store_cook")),
+		TablesCost:      r.client.BasePrice("store_tables"),
+		ChairsCost:      r.client.BasePrice("store_chairs"),
+		FridgeCost:      r.client.BasePrice("store_fridge"),
+		AmenitiesCost:   big.NewFloat(0),
+		PriceMultiplier: r.client.PriceMultiplier(),
+		Upcharge:        big.NewFloat(0),
+	}
+	if r.client.Upcharge != nil {
+		e.Upcharge = r.client.Upcharge
+	}
+
+	// Employees are priced by role and experience instead of the flat
+	// per-cook estimate
+	var employeeCapacity float64
+	if len(inputs.EmployeeIds) > 0 {
+		e.CooksCost, employeeCapacity = r.client.employeeStaffing(inputs.EmployeeIds)
+	}
+
+	// Amenities dispatch on their type for cost, capacity and ticket effects
+	var amenityCapacity, ticketBonus float64
+	for _, amenityType := range inputs.AmenityTypes {
+		e.AmenitiesCost.Add(e.AmenitiesCost, r.client.BasePrice("amenity_"+amenityType))
+		effect := amenityEffectFor(amenityType)
+		amenityCapacity += effect.ExtraCapacity
+		ticketBonus += effect.TicketBonus
+	}
+
+	// Scale component and labor costs by the location
+	e.RegionPercent = inputs.RegionPercent
+	for _, cost := range []**big.Float{&e.OvenCost, &e.CooksCost, &e.TablesCost, &e.ChairsCost, &e.FridgeCost, &e.AmenitiesCost} {
+		*cost = RegionalCost(*cost, inputs.RegionPercent)
+	}
+
+	// Cooks add up: each contributes the throughput of their experience
+	// level, read from the registry. Cooks without a record count as
+	// experienced, the previous flat assumption.
+	e.CookCapacity = employeeCapacity
+	var cooksCapacity float64
+	for _, id := range inputs.CookIds {
+		cook, ok := LookupRecord[CookResourceModel](r.client.Registry, id)
+		if !ok {
+			cooksCapacity += cookThroughput("experienced")
+			continue
+		}
+		cooksCapacity += cookThroughput(cook.Experience.ValueString())
+	}
+	e.CookCapacity += cooksCapacity
+	tableCapacity := 40.0 + amenityCapacity
+
+	// Suggest enough cooks like the current ones, on average, to keep up
+	// with the seating
+	perCook := cookThroughput("experienced")
+	if len(inputs.CookIds) > 0 {
+		perCook = cooksCapacity / float64(len(inputs.CookIds))
+	}
+	if gap := tableCapacity - e.CookCapacity; gap > 0 {
+		e.SuggestedCooks = int64(math.Ceil(gap / perCook))
+	}
+
+	// Ovens add up: each contributes the throughput of its type
+	ovenCapacity := 0.0
+	for _, id := range inputs.OvenIds {
+		ovenCapacity += ovenThroughput(r.client.KindFromId(id, "oven"))
+	}
+
+	// Customers per hour is the minimum (bottleneck); ties go to the
+	// component listed first
+	e.CustomersPerHour = e.CookCapacity
+	e.Bottleneck = "cooks"
+	if tableCapacity < e.CustomersPerHour {
+		e.CustomersPerHour = tableCapacity
+		e.Bottleneck = "seating"
+	}
+	if ovenCapacity < e.CustomersPerHour {
+		e.CustomersPerHour = ovenCapacity
+		e.Bottleneck = "oven"
+	}
+	if inputs.ParkingCapacity > 0 && inputs.ParkingCapacity < e.CustomersPerHour {
+		e.CustomersPerHour = inputs.ParkingCapacity
+		e.Bottleneck = "parking"
+	}
+	if registerCapacity < e.CustomersPerHour {
+		e.CustomersPerHour = registerCapacity
+		e.Bottleneck = "register"
+	}
+	if inputs.Closed {
+		e.CustomersPerHour = 0
+		e.Bottleneck = "closed"
+	}
+
+	e.WeeklyRevenue = big.NewFloat(e.CustomersPerHour * inputs.WeeklyHours)
+	ticket := new(big.Float).Add(r.client.AverageTicket(), big.NewFloat(ticketBonus))
+	e.WeeklyRevenue.Mul(e.WeeklyRevenue, ticket)
+
+	// Marketing brings in more customers than the estimate's steady trade
+	e.WeeklyRevenue.Mul(e.WeeklyRevenue, big.NewFloat(1+inputs.MarketingBoost/100))
+	e.SustainabilityScore = inputs.SustainabilityScore
+	e.CleanlinessScore = r.client.CleanlinessScore(inputs.LastDeepClean, inputs.JanitorShifts)
+	e.DwellTimeFactor = inputs.DwellTimeFactor
+
+	return e
+}
+
+// Sustainability scoring: a store without bins scores 20. Any compost bin
+// adds 30 and a large one 10 more, and each distinct material taken by its
+// recycling bins adds 10, for at most 100 with all four.
+const (
+	baseSustainabilityScore = 20
+	compostPoints           = 30
+	largeCompostPoints      = 10
+	recycledMaterialPoints  = 10
+)
+
+// sustainabilityScore scores a store's bin_ids, resolving each to a compost
+// bin's size or a recycling bin's materials.
+func sustainabilityScore(client *ProviderConfig, binIds []string) (int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var hasCompost, hasLargeCompost bool
+	var materials []string
+	for _, id := range binIds {
+		if size, ok := compostSizeOf(client, id); ok {
+			hasCompost = true
+			hasLargeCompost = hasLargeCompost || size == "large"
+			continue
+		}
+		if taken, ok := recycledMaterialsOf(client, id); ok {
+			for _, material := range taken {
+				if !slices.Contains(materials, material) {
+					materials = append(materials, material)
+				}
+			}
+			continue
+		}
+		diags.AddAttributeError(
+			path.Root("bin_ids").AtSetValue(types.StringValue(id)),
+			"Unknown Bin",
+			fmt.Sprintf("%q is not the ID of an hw_compost_bin or hw_recycling_bin resource.", id),
+		)
+	}
+
+	score := int64(baseSustainabilityScore)
+	if hasCompost {
+		score += compostPoints
+	}
+	if hasLargeCompost {
+		score += largeCompostPoints
+	}
+	score += recycledMaterialPoints * int64(len(materials))
+	return min(score, 100), diags
+}
+
+// setChildAggregates sets the attributes the store aggregates from child
+// resources in the registry: average_rating from hw_review and ambiance_score
+// from hw_music_playlist. Each keeps prior's value while none of its child
+// records are known (children are read after their store).
+func setChildAggregates(data *StoreResourceModel, registry *Registry, prior StoreResourceModel) {
+	data.AverageRating = prior.AverageRating
+	if rating, ok := storeAverageRating(registry, data.Id.ValueString()); ok {
+		data.AverageRating = types.NumberValue(rating)
+	}
+
+	data.AmbianceScore = prior.AmbianceScore
+	if score, ok := storeAmbianceScore(registry, data.Id.ValueString()); ok {
+		data.AmbianceScore = types.NumberValue(score)
+	}
+}
+
+// seatingShortfall warns when the referenced chairs provide fewer seats than
+// the referenced tables seat, naming the exact shortfall. The check needs both
+// records in the registry and is skipped otherwise.
+func seatingShortfall(registry *Registry, tablesId, chairsId string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tables, ok := LookupRecord[TablesResourceModel](registry, tablesId)
+	if !ok || tables.Capacity.IsNull() || tables.Capacity.IsUnknown() {
+		return diags
+	}
+	chairs, ok := LookupRecord[ChairsResourceModel](registry, chairsId)
+	if !ok || chairs.Quantity.IsNull() || chairs.Quantity.IsUnknown() {
+		return diags
+	}
+
+	seats := tables.Capacity.ValueInt64()
+	shortfall := seats - chairs.Quantity.ValueInt64()
+	if shortfall <= 0 {
+		return diags
+	}
+
+	diags.AddAttributeWarning(
+		path.Root("chairs_id"),
+		"Not Enough Chairs",
+		fmt.Sprintf("The tables seat %d customers but %s only provides %d chairs, %d short. Raise the chairs quantity by %d.",
+			seats, chairsId, chairs.Quantity.ValueInt64(), shortfall, shortfall),
+	)
+	return diags
+}
+
+// weekdays are the day names accepted by the operating_hours block.
+var weekdays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+// weeklyOperatingHours validates the operating_hours blocks and returns the
+// total number of hours the store is open per week.
+func weeklyOperatingHours(ctx context.Context, hours types.List) (float64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if hours.IsNull() || hours.IsUnknown() {
+		return 0, diags
+	}
+
+	var days []OperatingHoursModel
+	diags.Append(hours.ElementsAs(ctx, &days, false)...)
+	if diags.HasError() {
+		return 0, diags
+	}
+
+	seen := make(map[string]bool, len(days))
+	var total float64
+	for i, day := range days {
+		blockPath := path.Root("operating_hours").AtListIndex(i)
+		name := day.Day.ValueString()
+		if !slices.Contains(weekdays, name) {
+			diags.AddAttributeError(
+				blockPath.AtName("day"),
+				"Invalid Operating Hours",
+				fmt.Sprintf("Day must be one of %s, got %q.", strings.Join(weekdays, ", "), name),
+			)
+			continue
+		}
+		if seen[name] {
+			diags.AddAttributeError(
+				blockPath.AtName("day"),
+				"Invalid Operating Hours",
+				fmt.Sprintf("Only one operating_hours block is allowed per day, but %q appears more than once.", name),
+			)
+			continue
+		}
+		seen[name] = true
+
+		open, ok := parseClock(day.Open.ValueString())
+		if !ok {
+			diags.AddAttributeError(
+				blockPath.AtName("open"),
+				"Invalid Operating Hours",
+				fmt.Sprintf("Time must be in 24-hour HH:MM format (e.g., 07:30), got %q.", day.Open.ValueString()),
+			)
+			continue
+		}
+		closing, ok := parseClock(day.Close.ValueString())
+		if !ok {
+			diags.AddAttributeError(
+				blockPath.AtName("close"),
+				"Invalid Operating Hours",
+				fmt.Sprintf("Time must be in 24-hour HH:MM format (e.g., 15:00), got %q.", day.Close.ValueString()),
+			)
+			continue
+		}
+		if closing <= open {
+			diags.AddAttributeError(
+				blockPath.AtName("close"),
+				"Invalid Operating Hours",
+				fmt.Sprintf("On %s the store must close after it opens (open %s, close %s).", name, day.Open.ValueString(), day.Close.ValueString()),
+			)
+			continue
+		}
+
+		total += float64(closing-open) / 60
+	}
+
+	return total, diags
+}
+
+// parseClock parses a 24-hour "HH:MM" time into minutes after midnight.
+// "24:00" is accepted as the end of the day.
+func parseClock(value string) (int, bool) {
+	if value == "24:00" {
+		return 24 * 60, true
+	}
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, false
+	}
+	return t.Hour()*60 + t.Minute(), true
+}
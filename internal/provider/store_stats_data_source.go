@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &StoreStatsDataSource{}
+
+func NewStoreStatsDataSource() datasource.DataSource {
+	return &StoreStatsDataSource{}
+}
+
+// StoreStatsDataSource defines the data source implementation.
+type StoreStatsDataSource struct {
+	client *ProviderConfig
+}
+
+// StoreStatsDataSourceModel describes the data source data model.
+type StoreStatsDataSourceModel struct {
+	StoreId           types.String `tfsdk:"store_id"`
+	OrdersPlaced      types.Int64  `tfsdk:"orders_placed"`
+	OpenOrders        types.Int64  `tfsdk:"open_orders"`
+	InventoryConsumed types.Int64  `tfsdk:"inventory_consumed"`
+	OrdersRefunded    types.Int64  `tfsdk:"orders_refunded"`
+	RefundedTotal     MoneyValue   `tfsdk:"refunded_total"`
+	ReviewsCount      types.Int64  `tfsdk:"reviews_count"`
+	AverageRating     types.Number `tfsdk:"average_rating"`
+	Id                types.String `tfsdk:"id"`
+}
+
+func (d *StoreStatsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_store_stats"
+}
+
+func (d *StoreStatsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Live numbers for one store, counted from the orders and reviews the provider is managing right now. Unlike the store's own attributes, these change as soon as orders are placed or reviews written, so refreshing the data source after an apply shows the difference.
+
+**Example Usage:**
+
+` + "```hcl" + `
+data "hw_store_stats" "main" {
+  store_id = hw_store.main.id
+
+  # Count the orders and reviews created in this apply
+  depends_on = [hw_order.lunch, hw_review.first_visit]
+}
+
+output "main_stats" {
+  value = {
+    orders         = data.hw_store_stats.main.orders_placed
+    items_sold     = data.hw_store_stats.main.inventory_consumed
+    reviews        = data.hw_store_stats.main.reviews_count
+    average_rating = data.hw_store_stats.main.average_rating
+  }
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates a data source reading **live provider state** from the registry
+- Counts only resources in the same configuration; use ` + "`depends_on`" + ` so they are created first
+- ` + "`inventory_consumed`" + ` counts the menu items across the store's orders; refunds (see ` + "`hw_refund_order`" + `) put their items back
+
+*Tickets on the rail,*
+*Stars tallied at closing time,*
+*Refresh, and it's grown.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_store to report on",
+				Required:            true,
+			},
+			"orders_placed": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of hw_order resources placed at the store",
+			},
+			"open_orders": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of the store's orders not yet delivered or refunded",
+			},
+			"inventory_consumed": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of menu items across the store's orders, leaving out refunded orders",
+			},
+			"orders_refunded": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of the store's orders refunded by hw_refund_order",
+			},
+			"refunded_total": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Dollars refunded across the store's refunded orders",
+			},
+			"reviews_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of hw_review resources written about the store",
+			},
+			"average_rating": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Mean rating of the store's reviews, rounded to one decimal (null without reviews)",
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *StoreStatsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	d.client = config
+}
+
+func (d *StoreStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StoreStatsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var registry *Registry
+	if d.client != nil {
+		registry = d.client.Registry
+	}
+
+	storeId := data.StoreId.ValueString()
+	_, _, diags := lookupReference[StoreResourceModel](d.client, path.Root("store_id"), storeId, "store")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var placed, open, consumed, refunded, refundedCents int64
+	for _, order := range ListRecords[OrderResourceModel](registry) {
+		if order.StoreId.ValueString() != storeId {
+			continue
+		}
+		placed++
+		if order.Status.ValueString() == "refunded" {
+			// A refund returns the order's items to inventory
+			refunded++
+			refundedCents += order.Total.Cents()
+			continue
+		}
+		if order.Status.ValueString() != "delivered" {
+			open++
+		}
+		consumed += int64(len(order.ItemIds.Elements()))
+	}
+
+	var reviews int64
+	for _, review := range ListRecords[ReviewResourceModel](registry) {
+		if review.StoreId.ValueString() == storeId {
+			reviews++
+		}
+	}
+
+	data.OrdersPlaced = types.Int64Value(placed)
+	data.OpenOrders = types.Int64Value(open)
+	data.InventoryConsumed = types.Int64Value(consumed)
+	data.OrdersRefunded = types.Int64Value(refunded)
+	data.RefundedTotal = NewMoneyCents(refundedCents)
+	data.ReviewsCount = types.Int64Value(reviews)
+	data.AverageRating = types.NumberNull()
+	if rating, ok := storeAverageRating(registry, storeId); ok {
+		data.AverageRating = types.NumberValue(rating)
+	}
+	data.Id = types.StringValue("store-stats-" + storeId)
+
+	tflog.Trace(ctx, "read store stats data source", map[string]any{
+		"store_id":      storeId,
+		"orders_placed": placed,
+		"reviews_count": reviews,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &StrawResource{}
+var _ resource.ResourceWithImportState = &StrawResource{}
+
+func NewStrawResource() resource.Resource {
+	return &StrawResource{}
+}
+
+// StrawResource defines the resource implementation.
+type StrawResource struct {
+	client *ProviderConfig
+}
+
+// StrawResourceModel describes the resource data model.
+type StrawResourceModel struct {
+	Description     types.String `tfsdk:"description"`
+	Quantity        types.Int64  `tfsdk:"quantity"`
+	Price           MoneyValue   `tfsdk:"price"`
+	UnitPrice       MoneyValue   `tfsdk:"unit_price"`
+	Subtotal        MoneyValue   `tfsdk:"subtotal"`
+	Discount        MoneyValue   `tfsdk:"discount"`
+	Total           MoneyValue   `tfsdk:"total"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
+}
+
+func (r *StrawResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_straw"
+}
+
+func (r *StrawResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Paper straws, one for every cup. A bag that carries cups needs at least as many straws in its ` + "`packaging_ids`" + `.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_straw" "paper" {
+  quantity = 100
+  # total computed as $4.25 ($5.00 less the 15% bulk discount)
+}
+
+resource "hw_bag" "drinks" {
+  sandwiches    = [hw_sandwich.turkey.id]
+  packaging_ids = [hw_to_go_box.lunch_rush.id, hw_cup.medium.id, hw_straw.paper.id]
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates **quantity-based resources** sharing the bulk-discount engine with ` + "`hw_napkin`" + `
+- Shows **computed pricing** ($0.05 per straw)
+- Itemizes ` + "`unit_price`" + `, ` + "`subtotal`" + `, ` + "`discount`" + ` and ` + "`total`" + `
+- Bulk discount: 5% from 25 straws, 10% from 50, 15% from 100
+- Counts toward the straw-per-cup check on ` + "`hw_bag`" + ` ` + "`packaging_ids`" + `
+
+*Paper straw, striped red,*
+*Pierces the lid with a pop,*
+*Lemonade rises.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description of the straw resource",
+				Optional:            true,
+			},
+			"quantity": schema.Int64Attribute{
+				MarkdownDescription: "The number of straws",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Quantity", min: 1, max: math.MaxInt64},
+				},
+			},
+			"price": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The total price of the straws in dollars (same as `total`)",
+			},
+			"unit_price": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The base price per straw in dollars ($0.05 unless overridden)",
+			},
+			"subtotal": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The undiscounted price in dollars (quantity × unit_price)",
+			},
+			"discount": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The bulk discount in dollars (5% from 25 units, 10% from 50, 15% from 100)",
+			},
+			"total": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "The final price in dollars (subtotal - discount + upcharge)",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Straw identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *StrawResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *StrawResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data StrawResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Itemize price: $0.05 per straw, less any bulk discount, then apply upcharge
+	quantity := data.Quantity.ValueInt64()
+	r.setPrices(&data)
+
+	id := r.client.NewId("straw", fmt.Sprintf("qty-%d", quantity))
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created a straw resource", map[string]any{
+		"id":       data.Id.ValueString(),
+		"quantity": data.Quantity.ValueInt64(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
+	// Record the straws so hw_bag can check its packaging
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StrawResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StrawResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate price based on quantity
+	r.setPrices(&data)
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StrawResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data StrawResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate price based on quantity
+	quantity := data.Quantity.ValueInt64()
+	r.setPrices(&data)
+
+	var state StrawResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep existing ID unless quantity changed
+	if !data.Quantity.Equal(state.Quantity) {
+		id := r.client.NewId("straw", fmt.Sprintf("qty-%d", quantity))
+		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StrawResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data StrawResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted a straw resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *StrawResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setPrices itemizes the straw price for the configured quantity: the
+// per-straw price from the pricing engine ($0.05 by default), the subtotal,
+// the bulk discount and the total including upcharge. Price mirrors the total.
+func (r *StrawResource) setPrices(data *StrawResourceModel) {
+	bulk := r.client.BulkPriceFor("straw", data.Quantity.ValueInt64())
+	data.UnitPrice = NewMoneyValue(bulk.UnitPrice)
+	data.Subtotal = NewMoneyValue(bulk.Subtotal)
+	data.Discount = NewMoneyValue(bulk.Discount)
+	data.Total = r.client.Price(bulk.Total)
+	data.Price = r.client.Price(bulk.Total)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+}
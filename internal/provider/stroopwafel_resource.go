@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -29,10 +28,15 @@ type StroopwafelResource struct {
 
 // StroopwafelResourceModel describes the resource data model.
 type StroopwafelResourceModel struct {
-	Description types.String `tfsdk:"description"`
-	Kind        types.String `tfsdk:"kind"`
-	Price       types.Number `tfsdk:"price"`
-	Id          types.String `tfsdk:"id"`
+	Description     types.String `tfsdk:"description"`
+	Kind            types.String `tfsdk:"kind"`
+	Price           MoneyValue   `tfsdk:"price"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	HappyHourActive types.Bool   `tfsdk:"happy_hour_active"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
 }
 
 func (r *StroopwafelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -98,9 +102,21 @@ resource "hw_stroopwafel" "variety_pack" {
 				Required:            true,
 			},
 			"price": schema.NumberAttribute{
+				CustomType:          MoneyType{},
 				Computed:            true,
 				MarkdownDescription: "The price of the stroopwafel in dollars (hardcoded to $1.75)",
 			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"happy_hour_active": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the provider's `happy_hour` discount was applied to `price`",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Stroopwafel identifier",
@@ -142,13 +158,15 @@ func (r *StroopwafelResource) Create(ctx context.Context, req resource.CreateReq
 
 	// Simulate API delay
 
-	// Set base price: $1.75, then apply upcharge
-	basePrice := big.NewFloat(1.75)
-	finalPrice := ApplyUpcharge(basePrice, r.client.Upcharge)
-	data.Price = types.NumberValue(finalPrice)
+	// Set base price: $1.75, then apply upcharge and any happy hour discount
+	basePrice := r.client.BasePrice("stroopwafel")
+	finalPrice, happyHour := r.client.HappyHourPrice(ApplyUpcharge(basePrice, r.client.Upcharge))
+	data.Price = r.client.Price(finalPrice)
+	data.HappyHourActive = types.BoolValue(happyHour)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
 
 	// Mock resource creation - generate a fake ID based on the kind
-	id := fmt.Sprintf("stroopwafel-%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString()))
+	id := r.client.NewId("stroopwafel", fmt.Sprintf("%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString())))
 	data.Id = types.StringValue(id)
 
 	tflog.Trace(ctx, "created a stroopwafel resource", map[string]any{
@@ -156,6 +174,8 @@ func (r *StroopwafelResource) Create(ctx context.Context, req resource.CreateReq
 		"kind": data.Kind.ValueString(),
 	})
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -173,13 +193,17 @@ func (r *StroopwafelResource) Read(ctx context.Context, req resource.ReadRequest
 	// Simulate API delay
 
 	// Ensure price is set (in case it wasn't in state)
-	basePrice := big.NewFloat(1.75)
-	finalPrice := ApplyUpcharge(basePrice, r.client.Upcharge)
-	data.Price = types.NumberValue(finalPrice)
+	basePrice := r.client.BasePrice("stroopwafel")
+	finalPrice, happyHour := r.client.HappyHourPrice(ApplyUpcharge(basePrice, r.client.Upcharge))
+	data.Price = r.client.Price(finalPrice)
+	data.HappyHourActive = types.BoolValue(happyHour)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
 
 	// Mock resource read - just return the existing state
 	// In a real implementation, this would fetch from an API
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -197,9 +221,11 @@ func (r *StroopwafelResource) Update(ctx context.Context, req resource.UpdateReq
 	// Simulate API delay
 
 	// Ensure price is always set to $1.75 + upcharge
-	basePrice := big.NewFloat(1.75)
-	finalPrice := ApplyUpcharge(basePrice, r.client.Upcharge)
-	data.Price = types.NumberValue(finalPrice)
+	basePrice := r.client.BasePrice("stroopwafel")
+	finalPrice, happyHour := r.client.HappyHourPrice(ApplyUpcharge(basePrice, r.client.Upcharge))
+	data.Price = r.client.Price(finalPrice)
+	data.HappyHourActive = types.BoolValue(happyHour)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
 
 	// Mock resource update - regenerate ID if kind changed
 	var state StroopwafelResourceModel
@@ -210,13 +236,15 @@ func (r *StroopwafelResource) Update(ctx context.Context, req resource.UpdateReq
 
 	// If kind changed, regenerate ID
 	if !data.Kind.Equal(state.Kind) {
-		id := fmt.Sprintf("stroopwafel-%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString()))
+		id := r.client.NewId("stroopwafel", fmt.Sprintf("%s-%d", data.Kind.ValueString(), len(data.Kind.ValueString())))
 		data.Id = types.StringValue(id)
 	} else {
 		// Keep existing ID
 		data.Id = state.Id
 	}
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,403 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &SupplyOrderResource{}
+var _ resource.ResourceWithImportState = &SupplyOrderResource{}
+
+func NewSupplyOrderResource() resource.Resource {
+	return &SupplyOrderResource{}
+}
+
+type SupplyOrderResource struct {
+	client *ProviderConfig
+}
+
+type SupplyOrderResourceModel struct {
+	Supplier        types.String `tfsdk:"supplier"`
+	PantryId        types.String `tfsdk:"pantry_id"`
+	Ingredients     types.Map    `tfsdk:"ingredients"`
+	LeadTimeDays    types.Int64  `tfsdk:"lead_time_days"`
+	OrderedOn       types.String `tfsdk:"ordered_on"`
+	DeliveryDate    types.String `tfsdk:"delivery_date"`
+	Status          types.String `tfsdk:"status"`
+	TotalQuantity   types.Number `tfsdk:"total_quantity"`
+	Cost            MoneyValue   `tfsdk:"cost"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
+}
+
+// supplierLeadTimes are the accepted suppliers and the days each takes to
+// deliver by default. Each supplier's price per pound is the
+// "supplier_<name>" base price.
+var supplierLeadTimes = map[string]int64{
+	"farm_fresh":      2,
+	"harbor_foods":    3,
+	"metro_wholesale": 5,
+}
+
+// maxLeadTimeDays is the longest lead time a supply order accepts.
+const maxLeadTimeDays = 90
+
+func (r *SupplyOrderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_supply_order"
+}
+
+func (r *SupplyOrderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `A truckload of ingredients on its way from a supplier. The order is placed when it is created and arrives after the supplier's lead time, when the ingredients join the stock of the pantry they were ordered for.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_supply_order" "weekly" {
+  supplier  = "metro_wholesale"
+  pantry_id = hw_pantry.back_room.id
+
+  ingredients = {
+    flour  = 50
+    onions = 20
+  }
+  # status computed as "ordered", then "delivered" from delivery_date on
+  # delivery_date computed as 5 days after ordered_on
+  # cost computed as $56 (70 pounds × $0.80)
+}
+
+output "flour_in_stock" {
+  value = hw_pantry.back_room.stock["flour"]
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates **status that advances over time**: ` + "`status`" + ` is ordered until ` + "`delivery_date`" + `, then delivered, as of the provider's ` + "`as_of`" + ` date or today, so plans are repeatable with ` + "`as_of`" + ` set
+- Suppliers: farm_fresh (2 days, $1.50 a pound), harbor_foods (3 days, $1.20), metro_wholesale (5 days, $0.80); ` + "`lead_time_days`" + ` overrides the supplier's lead time
+- Once delivered, the ingredients add to the ` + "`stock`" + ` of the ` + "`hw_pantry`" + ` in ` + "`pantry_id`" + `. Orders are read after their pantry, so a delivery shows in its stock on the pantry's next refresh
+- Quantities are in pounds and must be positive
+
+*The truck is late again,*
+*Flour sacks ride the morning road,*
+*The shelf waits, half bare.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"supplier": schema.StringAttribute{
+				MarkdownDescription: "Supplier filling the order: farm_fresh, harbor_foods, or metro_wholesale",
+				Required:            true,
+			},
+			"pantry_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_pantry the ingredients are delivered to. Without it, the delivery stocks no pantry",
+				Optional:            true,
+			},
+			"ingredients": schema.MapAttribute{
+				ElementType:         types.NumberType,
+				MarkdownDescription: "Map of ingredient name to the quantity ordered, in pounds",
+				Required:            true,
+			},
+			"lead_time_days": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Days from ordering to delivery, from 0 to %d. Defaults to the supplier's lead time (farm_fresh 2, harbor_foods 3, metro_wholesale 5)", maxLeadTimeDays),
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Lead Time", min: 0, max: maxLeadTimeDays},
+				},
+			},
+			"ordered_on": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Day the order was placed (`YYYY-MM-DD`): the day it was created, or the provider's `as_of` date when set",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"delivery_date": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Day the order arrives (`YYYY-MM-DD`): `lead_time_days` after `ordered_on`",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ordered until `delivery_date`, then delivered, as of the provider's `as_of` date or today",
+			},
+			"total_quantity": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Total pounds of ingredients ordered",
+			},
+			"cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Cost in dollars: `total_quantity` × the supplier's price per pound (farm_fresh $1.50, harbor_foods $1.20, metro_wholesale $0.80)",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Supply order identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SupplyOrderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *SupplyOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SupplyOrderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The order is placed today
+	data.OrderedOn = types.StringValue(r.client.Today().Format(dateLayout))
+
+	resp.Diagnostics.Append(r.setDelivery(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(r.newId(ctx, data))
+
+	tflog.Trace(ctx, "created a supply order resource", map[string]any{
+		"id":            data.Id.ValueString(),
+		"delivery_date": data.DeliveryDate.ValueString(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SupplyOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SupplyOrderResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The order may have arrived since the last refresh
+	resp.Diagnostics.Append(r.setDelivery(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SupplyOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SupplyOrderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state SupplyOrderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changing the order keeps the day it was placed
+	data.OrderedOn = state.OrderedOn
+
+	resp.Diagnostics.Append(r.setDelivery(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Supplier.Equal(state.Supplier) || !data.Ingredients.Equal(state.Ingredients) {
+		data.Id = types.StringValue(r.newId(ctx, data))
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SupplyOrderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SupplyOrderResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted a supply order resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *SupplyOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// newId returns the ID of a supply order: its supplier and the ingredients
+// ordered.
+func (r *SupplyOrderResource) newId(ctx context.Context, data SupplyOrderResourceModel) string {
+	names := slices.Sorted(maps.Keys(data.Ingredients.Elements()))
+	return r.client.NewId("supply-order", fmt.Sprintf("%s-%s-%d", data.Supplier.ValueString(), strings.Join(names, "-"), len(names)))
+}
+
+// setDelivery validates the order's supplier, pantry and quantities and
+// computes its total, cost, delivery date and status as of Today. The order
+// must already have its ordered_on date.
+func (r *SupplyOrderResource) setDelivery(ctx context.Context, data *SupplyOrderResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	supplier := data.Supplier.ValueString()
+	leadTime, ok := supplierLeadTimes[supplier]
+	if !ok {
+		suppliers := slices.Sorted(maps.Keys(supplierLeadTimes))
+		diags.AddAttributeError(
+			path.Root("supplier"),
+			"Unknown Supplier",
+			fmt.Sprintf("supplier must be one of %s, got %q.%s", strings.Join(suppliers, ", "), supplier, didYouMean(supplier, suppliers)),
+		)
+	}
+	if pantryId := data.PantryId.ValueString(); pantryId != "" && !r.client.IsIdOf(pantryId, "pantry") {
+		diags.AddAttributeError(
+			path.Root("pantry_id"),
+			"Unknown Pantry",
+			fmt.Sprintf("%q is not the ID of an hw_pantry resource.", pantryId),
+		)
+	}
+
+	var ingredients map[string]types.Number
+	ingredientDiags := data.Ingredients.ElementsAs(ctx, &ingredients, false)
+	diags.Append(ingredientDiags...)
+	if ingredientDiags.HasError() {
+		return diags
+	}
+
+	total := new(big.Float)
+	for _, name := range slices.Sorted(maps.Keys(ingredients)) {
+		quantity := ingredients[name]
+		if quantity.IsNull() || quantity.IsUnknown() {
+			continue
+		}
+		if quantity.ValueBigFloat().Sign() <= 0 {
+			diags.AddAttributeError(
+				path.Root("ingredients").AtMapKey(name),
+				"Invalid Ingredient Quantity",
+				fmt.Sprintf("The quantity of %s ordered must be positive, got %s.", name, quantity.ValueBigFloat().String()),
+			)
+			continue
+		}
+		total.Add(total, quantity.ValueBigFloat())
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if !data.LeadTimeDays.IsNull() && !data.LeadTimeDays.IsUnknown() {
+		leadTime = data.LeadTimeDays.ValueInt64()
+	}
+	ordered, err := time.Parse(dateLayout, data.OrderedOn.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("ordered_on"),
+			"Invalid Ordered On",
+			fmt.Sprintf("ordered_on must be a date in YYYY-MM-DD format, got %q. Import the order again to reset it.", data.OrderedOn.ValueString()),
+		)
+		return diags
+	}
+	delivery := ordered.AddDate(0, 0, int(leadTime))
+
+	data.LeadTimeDays = types.Int64Value(leadTime)
+	data.DeliveryDate = types.StringValue(delivery.Format(dateLayout))
+	data.Status = types.StringValue(deliveryStatus(delivery, r.client.Today()))
+	data.TotalQuantity = types.NumberValue(total)
+	data.Cost = r.client.Price(ApplyUpcharge(new(big.Float).Mul(total, r.client.BasePrice("supplier_"+supplier)), r.client.Upcharge))
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+	return diags
+}
+
+// deliveryStatus returns the status as of today of an order arriving on
+// delivery.
+func deliveryStatus(delivery, today time.Time) string {
+	if today.Before(delivery) {
+		return "ordered"
+	}
+	return "delivered"
+}
+
+// deliveredStock totals, in pounds, the ingredients of the supply orders in
+// the registry for the pantry with ID pantryId that have been delivered as of
+// Today.
+func (c *ProviderConfig) deliveredStock(ctx context.Context, pantryId string) map[string]*big.Float {
+	stock := map[string]*big.Float{}
+	today := c.Today()
+	for _, order := range ListRecords[SupplyOrderResourceModel](c.Registry) {
+		if order.PantryId.ValueString() != pantryId {
+			continue
+		}
+		delivery, err := time.Parse(dateLayout, order.DeliveryDate.ValueString())
+		if err != nil || deliveryStatus(delivery, today) != "delivered" {
+			continue
+		}
+
+		var ingredients map[string]types.Number
+		if diags := order.Ingredients.ElementsAs(ctx, &ingredients, false); diags.HasError() {
+			continue
+		}
+		for name, quantity := range ingredients {
+			if stock[name] == nil {
+				stock[name] = new(big.Float)
+			}
+			stock[name].Add(stock[name], quantity.ValueBigFloat())
+		}
+	}
+	return stock
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDeliveryStatus(t *testing.T) {
+	delivery := time.Date(2026, time.October, 6, 0, 0, 0, 0, time.UTC)
+	tests := map[string]string{
+		"2026-10-01": "ordered",
+		"2026-10-05": "ordered",
+		"2026-10-06": "delivered",
+		"2026-11-01": "delivered",
+	}
+	for day, want := range tests {
+		today, _ := time.Parse(dateLayout, day)
+		if got := deliveryStatus(delivery, today); got != want {
+			t.Errorf("deliveryStatus on %s = %s, want %s", day, got, want)
+		}
+	}
+}
+
+func TestDeliveredStock(t *testing.T) {
+	config := &ProviderConfig{Registry: NewRegistry(), AsOf: time.Date(2026, time.October, 6, 0, 0, 0, 0, time.UTC)}
+	order := func(pantryId, delivery string, flour float64) SupplyOrderResourceModel {
+		return SupplyOrderResourceModel{
+			PantryId:     types.StringValue(pantryId),
+			DeliveryDate: types.StringValue(delivery),
+			Ingredients:  types.MapValueMust(types.NumberType, map[string]attr.Value{"flour": types.NumberValue(big.NewFloat(flour))}),
+		}
+	}
+	config.Registry.Put("supply-order-1", order("pantry-medium-6", "2026-10-03", 50))
+	config.Registry.Put("supply-order-2", order("pantry-medium-6", "2026-10-06", 20))
+	config.Registry.Put("supply-order-3", order("pantry-medium-6", "2026-10-07", 40))
+	config.Registry.Put("supply-order-4", order("pantry-small-5", "2026-10-01", 10))
+
+	stock := config.deliveredStock(context.Background(), "pantry-medium-6")
+	if len(stock) != 1 || stock["flour"].Cmp(big.NewFloat(70)) != 0 {
+		t.Errorf("got stock %v, want 70 pounds of flour", stock)
+	}
+}
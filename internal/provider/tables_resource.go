@@ -3,20 +3,24 @@ package provider
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/numberplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &TablesResource{}
 var _ resource.ResourceWithImportState = &TablesResource{}
+var _ resource.ResourceWithUpgradeState = &TablesResource{}
 
 func NewTablesResource() resource.Resource {
 	return &TablesResource{}
@@ -27,12 +31,16 @@ type TablesResource struct {
 }
 
 type TablesResourceModel struct {
-	Quantity    types.Number `tfsdk:"quantity"`
-	Size        types.String `tfsdk:"size"`
-	Description types.String `tfsdk:"description"`
-	Cost        types.Number `tfsdk:"cost"`
-	Capacity    types.Number `tfsdk:"capacity"`
-	Id          types.String `tfsdk:"id"`
+	Quantity        types.Int64  `tfsdk:"quantity"`
+	Size            types.String `tfsdk:"size"`
+	Description     types.String `tfsdk:"description"`
+	Cost            MoneyValue   `tfsdk:"cost"`
+	Capacity        types.Int64  `tfsdk:"capacity"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
 }
 
 func (r *TablesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -41,6 +49,8 @@ func (r *TablesResource) Metadata(ctx context.Context, req resource.MetadataRequ
 
 func (r *TablesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		// Version 1 made quantity and capacity whole numbers
+		Version: 1,
 		MarkdownDescription: `The foundation of dining space, where customers gather to enjoy their meals. Demonstrates quantity-based resources, size variations, and capacity calculations that scale with your restaurant's needs.
 
 **Example Usage:**
@@ -103,9 +113,12 @@ resource "hw_tables" "variable" {
 *Gathering place set.*`,
 
 		Attributes: map[string]schema.Attribute{
-			"quantity": schema.NumberAttribute{
+			"quantity": schema.Int64Attribute{
 				MarkdownDescription: "Number of tables",
 				Required:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Quantity", min: 0, max: math.MaxInt64},
+				},
 			},
 			"size": schema.StringAttribute{
 				MarkdownDescription: "Size of tables (small=2 seats, medium=4 seats, large=6 seats)",
@@ -116,19 +129,27 @@ resource "hw_tables" "variable" {
 				Optional:            true,
 			},
 			"cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
 				Computed:            true,
 				MarkdownDescription: "Total cost in dollars (small=$50/table, medium=$100/table, large=$150/table)",
 				PlanModifiers: []planmodifier.Number{
 					numberplanmodifier.UseStateForUnknown(),
 				},
 			},
-			"capacity": schema.NumberAttribute{
+			"capacity": schema.Int64Attribute{
 				Computed:            true,
 				MarkdownDescription: "Total seating capacity (quantity * seats per table)",
-				PlanModifiers: []planmodifier.Number{
-					numberplanmodifier.UseStateForUnknown(),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Tables identifier",
@@ -167,47 +188,43 @@ func (r *TablesResource) Create(ctx context.Context, req resource.CreateRequest,
 
 
 	// Calculate cost per table based on size
-	var costPerTable *big.Float
-	var seatsPerTable *big.Float
+	var seatsPerTable int64
 	size := data.Size.ValueString()
+	costPerTable := r.client.VariantPrice("tables", size, "small")
 	switch size {
-	case "small":
-		costPerTable = big.NewFloat(50.00)
-		seatsPerTable = big.NewFloat(2.0)
 	case "medium":
-		costPerTable = big.NewFloat(100.00)
-		seatsPerTable = big.NewFloat(4.0)
+		seatsPerTable = 4
 	case "large":
-		costPerTable = big.NewFloat(150.00)
-		seatsPerTable = big.NewFloat(6.0)
+		seatsPerTable = 6
 	default:
-		costPerTable = big.NewFloat(50.00)
-		seatsPerTable = big.NewFloat(2.0)
+		seatsPerTable = 2
 	}
 
 	// Calculate total cost
-	quantity := data.Quantity.ValueBigFloat()
+	quantity := data.Quantity.ValueInt64()
 	var totalCost big.Float
-	totalCost.Mul(quantity, costPerTable)
+	totalCost.Mul(big.NewFloat(float64(quantity)), costPerTable)
 	finalCost := ApplyUpcharge(&totalCost, r.client.Upcharge)
-	data.Cost = types.NumberValue(finalCost)
+	data.Cost = r.client.Price(finalCost)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
 
 	// Calculate capacity
-	var totalCapacity big.Float
-	totalCapacity.Mul(quantity, seatsPerTable)
-	data.Capacity = types.NumberValue(&totalCapacity)
+	data.Capacity = types.Int64Value(quantity * seatsPerTable)
 
-	id := fmt.Sprintf("tables-%s-%d", size, len(size))
+	id := r.client.NewId("tables", fmt.Sprintf("%s-%d", size, len(size)))
 	data.Id = types.StringValue(id)
 
 	tflog.Trace(ctx, "created a tables resource", map[string]any{
 		"id":       data.Id.ValueString(),
-		"quantity": quantity.String(),
+		"quantity": quantity,
 		"size":     size,
 		"cost":     data.Cost.ValueBigFloat().String(),
-		"capacity": data.Capacity.ValueBigFloat().String(),
+		"capacity": data.Capacity.ValueInt64(),
 	})
 
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -221,33 +238,29 @@ func (r *TablesResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 
 	// Recalculate cost and capacity
-	var costPerTable *big.Float
-	var seatsPerTable *big.Float
+	var seatsPerTable int64
 	size := data.Size.ValueString()
+	costPerTable := r.client.VariantPrice("tables", size, "small")
 	switch size {
-	case "small":
-		costPerTable = big.NewFloat(50.00)
-		seatsPerTable = big.NewFloat(2.0)
 	case "medium":
-		costPerTable = big.NewFloat(100.00)
-		seatsPerTable = big.NewFloat(4.0)
+		seatsPerTable = 4
 	case "large":
-		costPerTable = big.NewFloat(150.00)
-		seatsPerTable = big.NewFloat(6.0)
+		seatsPerTable = 6
 	default:
-		costPerTable = big.NewFloat(50.00)
-		seatsPerTable = big.NewFloat(2.0)
+		seatsPerTable = 2
 	}
 
-	quantity := data.Quantity.ValueBigFloat()
+	quantity := data.Quantity.ValueInt64()
 	var totalCost big.Float
-	totalCost.Mul(quantity, costPerTable)
+	totalCost.Mul(big.NewFloat(float64(quantity)), costPerTable)
 	finalCost := ApplyUpcharge(&totalCost, r.client.Upcharge)
-	data.Cost = types.NumberValue(finalCost)
+	data.Cost = r.client.Price(finalCost)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+
+	data.Capacity = types.Int64Value(quantity * seatsPerTable)
 
-	var totalCapacity big.Float
-	totalCapacity.Mul(quantity, seatsPerTable)
-	data.Capacity = types.NumberValue(&totalCapacity)
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -262,33 +275,26 @@ func (r *TablesResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 
 	// Recalculate cost and capacity
-	var costPerTable *big.Float
-	var seatsPerTable *big.Float
+	var seatsPerTable int64
 	size := data.Size.ValueString()
+	costPerTable := r.client.VariantPrice("tables", size, "small")
 	switch size {
-	case "small":
-		costPerTable = big.NewFloat(50.00)
-		seatsPerTable = big.NewFloat(2.0)
 	case "medium":
-		costPerTable = big.NewFloat(100.00)
-		seatsPerTable = big.NewFloat(4.0)
+		seatsPerTable = 4
 	case "large":
-		costPerTable = big.NewFloat(150.00)
-		seatsPerTable = big.NewFloat(6.0)
+		seatsPerTable = 6
 	default:
-		costPerTable = big.NewFloat(50.00)
-		seatsPerTable = big.NewFloat(2.0)
+		seatsPerTable = 2
 	}
 
-	quantity := data.Quantity.ValueBigFloat()
+	quantity := data.Quantity.ValueInt64()
 	var totalCost big.Float
-	totalCost.Mul(quantity, costPerTable)
+	totalCost.Mul(big.NewFloat(float64(quantity)), costPerTable)
 	finalCost := ApplyUpcharge(&totalCost, r.client.Upcharge)
-	data.Cost = types.NumberValue(finalCost)
+	data.Cost = r.client.Price(finalCost)
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
 
-	var totalCapacity big.Float
-	totalCapacity.Mul(quantity, seatsPerTable)
-	data.Capacity = types.NumberValue(&totalCapacity)
+	data.Capacity = types.Int64Value(quantity * seatsPerTable)
 
 	var state TablesResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -297,12 +303,18 @@ func (r *TablesResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	if !data.Size.Equal(state.Size) {
-		id := fmt.Sprintf("tables-%s-%d", size, len(size))
+		id := r.client.NewId("tables", fmt.Sprintf("%s-%d", size, len(size)))
 		data.Id = types.StringValue(id)
 	} else {
 		data.Id = state.Id
 	}
 
+	if !data.Id.Equal(state.Id) {
+		r.client.Registry.Delete(state.Id.ValueString())
+	}
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -315,11 +327,19 @@ func (r *TablesResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 
+	r.client.Registry.Delete(data.Id.ValueString())
+
 	tflog.Trace(ctx, "deleted a tables resource", map[string]any{
 		"id": data.Id.ValueString(),
 	})
 }
 
+func (r *TablesResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: numberToInt64Upgrader("quantity", "capacity"),
+	}
+}
+
 func (r *TablesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
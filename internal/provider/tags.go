@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// tagsAttribute is the tags argument of every resource.
+func tagsAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		ElementType:         types.StringType,
+		MarkdownDescription: "Tags to assign to the resource. They are merged over the provider's `default_tags`; a tag set here wins over a default tag with the same key",
+		Optional:            true,
+	}
+}
+
+// tagsAllAttribute is the tags_all attribute of every resource.
+func tagsAllAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		ElementType:         types.StringType,
+		MarkdownDescription: "All tags of the resource: the provider's `default_tags` merged with `tags` (null when there are none)",
+		Computed:            true,
+	}
+}
+
+// mergeTags returns the tags_all of a resource: defaults overlaid with tags.
+// It is unknown while tags or any of their values are, and null when the
+// result is empty, so resources without tags don't show an empty map.
+func mergeTags(defaults map[string]string, tags types.Map) types.Map {
+	if tags.IsUnknown() {
+		return types.MapUnknown(types.StringType)
+	}
+
+	merged := maps.Clone(defaults)
+	if merged == nil {
+		merged = map[string]string{}
+	}
+	for key, value := range tags.Elements() {
+		tag, ok := value.(types.String)
+		if !ok || tag.IsUnknown() {
+			return types.MapUnknown(types.StringType)
+		}
+		if tag.IsNull() {
+			continue
+		}
+		merged[key] = tag.ValueString()
+	}
+
+	if len(merged) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	values := make(map[string]attr.Value, len(merged))
+	for key, value := range merged {
+		values[key] = types.StringValue(value)
+	}
+	return types.MapValueMust(types.StringType, values)
+}
+
+// TagsAll returns the tags_all of a resource with the given tags, merged
+// over the provider's default_tags. It is safe to call on a nil config.
+func (c *ProviderConfig) TagsAll(tags types.Map) types.Map {
+	if c == nil {
+		return mergeTags(nil, tags)
+	}
+	return mergeTags(c.DefaultTags, tags)
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func stringMap(values map[string]string) types.Map {
+	elements := make(map[string]attr.Value, len(values))
+	for key, value := range values {
+		elements[key] = types.StringValue(value)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+func TestMergeTags(t *testing.T) {
+	tests := []struct {
+		name     string
+		defaults map[string]string
+		tags     types.Map
+		want     types.Map
+	}{
+		{
+			name: "no tags",
+			tags: types.MapNull(types.StringType),
+			want: types.MapNull(types.StringType),
+		},
+		{
+			name: "empty tags",
+			tags: stringMap(map[string]string{}),
+			want: types.MapNull(types.StringType),
+		},
+		{
+			name:     "defaults only",
+			defaults: map[string]string{"team": "kitchen"},
+			tags:     types.MapNull(types.StringType),
+			want:     stringMap(map[string]string{"team": "kitchen"}),
+		},
+		{
+			name: "tags only",
+			tags: stringMap(map[string]string{"shift": "morning"}),
+			want: stringMap(map[string]string{"shift": "morning"}),
+		},
+		{
+			name:     "tags merge over defaults",
+			defaults: map[string]string{"team": "kitchen", "env": "dev"},
+			tags:     stringMap(map[string]string{"env": "prod", "shift": "morning"}),
+			want:     stringMap(map[string]string{"team": "kitchen", "env": "prod", "shift": "morning"}),
+		},
+		{
+			name:     "null tag value keeps the default",
+			defaults: map[string]string{"env": "dev"},
+			tags:     types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringNull()}),
+			want:     stringMap(map[string]string{"env": "dev"}),
+		},
+		{
+			name:     "unknown tags",
+			defaults: map[string]string{"team": "kitchen"},
+			tags:     types.MapUnknown(types.StringType),
+			want:     types.MapUnknown(types.StringType),
+		},
+		{
+			name:     "unknown tag value",
+			defaults: map[string]string{"team": "kitchen"},
+			tags:     types.MapValueMust(types.StringType, map[string]attr.Value{"owner": types.StringUnknown()}),
+			want:     types.MapUnknown(types.StringType),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mergeTags(tt.defaults, tt.tags); !got.Equal(tt.want) {
+				t.Errorf("mergeTags() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeTagsLeavesDefaultsUnchanged(t *testing.T) {
+	defaults := map[string]string{"env": "dev"}
+	mergeTags(defaults, stringMap(map[string]string{"env": "prod"}))
+	if defaults["env"] != "dev" {
+		t.Errorf("defaults[env] = %q after merge, want dev", defaults["env"])
+	}
+}
+
+func TestTagsAllNilConfig(t *testing.T) {
+	var config *ProviderConfig
+	want := stringMap(map[string]string{"shift": "morning"})
+	if got := config.TagsAll(want); !got.Equal(want) {
+		t.Errorf("TagsAll() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TaxRatesDataSource{}
+
+func NewTaxRatesDataSource() datasource.DataSource {
+	return &TaxRatesDataSource{}
+}
+
+// TaxRatesDataSource defines the data source implementation.
+type TaxRatesDataSource struct {
+	client *ProviderConfig
+}
+
+// TaxRatesDataSourceModel describes the data source data model.
+type TaxRatesDataSourceModel struct {
+	Region        types.String `tfsdk:"region"`
+	AsOf          types.String `tfsdk:"as_of"`
+	Rate          types.Number `tfsdk:"rate"`
+	EffectiveDate types.String `tfsdk:"effective_date"`
+	Rates         types.Map    `tfsdk:"rates"`
+	Id            types.String `tfsdk:"id"`
+}
+
+// taxRateChange is a statewide sales tax rate taking effect on a date.
+type taxRateChange struct {
+	effective string
+	percent   float64
+}
+
+// taxRateHistory holds the statewide sales tax rate changes of each region,
+// keyed by two-letter state code, in date order. A region has no rate before
+// its first change.
+var taxRateHistory = map[string][]taxRateChange{
+	"CA": {{effective: "2013-01-01", percent: 7.5}, {effective: "2017-01-01", percent: 7.25}},
+	"CO": {{effective: "2001-01-01", percent: 2.9}},
+	"FL": {{effective: "1988-02-01", percent: 6}},
+	"IL": {{effective: "1990-01-01", percent: 6.25}},
+	"MA": {{effective: "2009-08-01", percent: 6.25}},
+	"NJ": {{effective: "2017-01-01", percent: 6.875}, {effective: "2018-01-01", percent: 6.625}},
+	"NY": {{effective: "2005-06-01", percent: 4}},
+	"OR": {{effective: "1970-01-01", percent: 0}},
+	"TX": {{effective: "1990-07-01", percent: 6.25}},
+	"WA": {{effective: "1983-01-01", percent: 6.5}},
+}
+
+// TaxRegions returns the sorted regions the tax rate history covers.
+func TaxRegions() []string {
+	return slices.Sorted(maps.Keys(taxRateHistory))
+}
+
+// taxRateOn returns the sales tax rate of region in effect on date and the
+// date it took effect, reporting false when the region had no rate yet.
+func taxRateOn(region string, date time.Time) (taxRateChange, bool) {
+	var current taxRateChange
+	found := false
+	for _, change := range taxRateHistory[region] {
+		effective, _ := time.Parse(dateLayout, change.effective)
+		if effective.After(date) {
+			break
+		}
+		current, found = change, true
+	}
+	return current, found
+}
+
+func (d *TaxRatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tax_rates"
+}
+
+func (d *TaxRatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Statewide sales tax rates by region, as they stood on a given date. Feed a rate into the provider's ` + "`tax_rate`" + ` or an ` + "`hw_receipt`" + `'s ` + "`tax_percent`" + `, or use the whole table in locals.
+
+**Example Usage:**
+
+` + "```hcl" + `
+data "hw_tax_rates" "ca" {
+  region = "CA"
+  as_of  = "2024-06-01"
+  # rate computed as 7.25
+}
+
+resource "hw_receipt" "lunch" {
+  order_id    = hw_order.lunch.id
+  tax_percent = data.hw_tax_rates.ca.rate
+}
+
+locals {
+  tax_free_regions = [for region, rate in data.hw_tax_rates.ca.rates : region if rate == 0]
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates a **time-aware lookup**: ` + "`as_of`" + ` pins the date so plans stay reproducible as rates change
+- ` + "`as_of`" + ` defaults to the provider's ` + "`as_of`" + `, or today
+- Regions: CA, CO, FL, IL, MA, NJ, NY, OR, TX, WA
+- Rates are statewide percentages; local surtaxes aren't included
+
+*New year, new percent,*
+*The register learns a digit,*
+*Pennies shift their weight.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Two-letter state code to look up `rate` for (e.g., `CA`)",
+				Optional:            true,
+			},
+			"as_of": schema.StringAttribute{
+				MarkdownDescription: "Date (`YYYY-MM-DD`) to look rates up for. Defaults to the provider's `as_of`, or today",
+				Optional:            true,
+			},
+			"rate": schema.NumberAttribute{
+				MarkdownDescription: "Sales tax percentage of `region` on the `as_of` date (null without a region)",
+				Computed:            true,
+			},
+			"effective_date": schema.StringAttribute{
+				MarkdownDescription: "Date (`YYYY-MM-DD`) `rate` took effect (null without a region)",
+				Computed:            true,
+			},
+			"rates": schema.MapAttribute{
+				ElementType:         types.NumberType,
+				MarkdownDescription: "Map of region to its sales tax percentage on the `as_of` date, for every region with a rate by then",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *TaxRatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	d.client = config
+}
+
+func (d *TaxRatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TaxRatesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	asOf := d.client.Today()
+	if !data.AsOf.IsNull() && !data.AsOf.IsUnknown() {
+		parsed, err := time.Parse(dateLayout, data.AsOf.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("as_of"),
+				"Invalid As Of Date",
+				fmt.Sprintf("as_of must be a date in YYYY-MM-DD format, got %q.", data.AsOf.ValueString()),
+			)
+			return
+		}
+		asOf = parsed
+	}
+
+	rates := make(map[string]*big.Float)
+	for _, region := range TaxRegions() {
+		if change, ok := taxRateOn(region, asOf); ok {
+			rates[region] = big.NewFloat(change.percent)
+		}
+	}
+
+	data.Rate = types.NumberNull()
+	data.EffectiveDate = types.StringNull()
+	if region := data.Region.ValueString(); region != "" {
+		if _, ok := taxRateHistory[region]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("region"),
+				"Unknown Tax Region",
+				fmt.Sprintf("%q is not a region with known tax rates. Regions: %s.", region, strings.Join(TaxRegions(), ", ")),
+			)
+			return
+		}
+		change, ok := taxRateOn(region, asOf)
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("as_of"),
+				"No Tax Rate",
+				fmt.Sprintf("%s has no known tax rate on %s; its history starts %s.", region, asOf.Format(dateLayout), taxRateHistory[region][0].effective),
+			)
+			return
+		}
+		data.Rate = types.NumberValue(big.NewFloat(change.percent))
+		data.EffectiveDate = types.StringValue(change.effective)
+	}
+
+	ratesValue, diags := types.MapValueFrom(ctx, types.NumberType, rates)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Rates = ratesValue
+	data.Id = types.StringValue("tax-rates-" + asOf.Format(dateLayout))
+
+	tflog.Trace(ctx, "read tax rates data source", map[string]any{
+		"region": data.Region.ValueString(),
+		"as_of":  asOf.Format(dateLayout),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
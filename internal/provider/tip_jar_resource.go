@@ -0,0 +1,534 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"math"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &TipJarResource{}
+var _ resource.ResourceWithImportState = &TipJarResource{}
+
+func NewTipJarResource() resource.Resource {
+	return &TipJarResource{}
+}
+
+type TipJarResource struct {
+	client *ProviderConfig
+}
+
+type TipJarResourceModel struct {
+	StoreId     types.String `tfsdk:"store_id"`
+	Name        types.String `tfsdk:"name"`
+	Amount      MoneyValue   `tfsdk:"amount"`
+	Split       types.String `tfsdk:"split"`
+	CustomSplit types.Map    `tfsdk:"custom_split"`
+	Shares      types.Map    `tfsdk:"shares"`
+	Payouts     types.Map    `tfsdk:"payouts"`
+	Tags        types.Map    `tfsdk:"tags"`
+	TagsAll     types.Map    `tfsdk:"tags_all"`
+	Haiku       types.String `tfsdk:"haiku"`
+	Id          types.String `tfsdk:"id"`
+}
+
+// tipSplits are the accepted values of the tip jar split attribute.
+var tipSplits = []string{"equal", "by_hours", "by_role"}
+
+// defaultTipSplit is the split of a tip jar without one.
+const defaultTipSplit = "equal"
+
+// roleTipPoints weigh each staff member's share of a by_role split without a
+// custom_split: the register takes the tips, the kitchen makes the food, and
+// managers don't share in tips at all.
+var roleTipPoints = map[string]int64{
+	"cashier": 3,
+	"cook":    2,
+	"janitor": 1,
+	"manager": 0,
+}
+
+// tipStaffer is a staff member sharing a store's tip jar.
+type tipStaffer struct {
+	Id    string
+	Role  string
+	Hours float64
+}
+
+func (r *TipJarResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tip_jar"
+}
+
+func (r *TipJarResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The jar by the register, and the end-of-week argument about how to split it. A tip jar pays out its ` + "`amount`" + ` to the staff of its store, the cooks, employees and janitors the store references, by one of three rules.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_tip_jar" "register" {
+  store_id = hw_store.main.id
+  name     = "register"
+  amount   = 300
+  split    = "by_hours"
+  # payouts computed per staff member ID, in proportion to weekly hours
+}
+
+resource "hw_tip_jar" "friday" {
+  store_id = hw_store.main.id
+  name     = "friday"
+  amount   = 500
+  split    = "by_role"
+
+  custom_split = {
+    cashier = 50
+    cook    = 40
+    janitor = 10
+  }
+  # each role's percentage is shared equally by the staff in that role
+}
+
+output "chef_tips" {
+  value = hw_tip_jar.register.payouts[hw_cook.chef.id]
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates **computed maps keyed by referenced resource IDs**, recomputed on refresh as the store's staff changes
+- ` + "`equal`" + ` (the default) shares the jar evenly; ` + "`by_hours`" + ` in proportion to weekly hours (a cook's ` + "`hours_per_week`" + `, 8 per janitor shift, 40 for employees)
+- ` + "`by_role`" + ` weighs each person by role: cashier 3, cook 2, janitor 1, manager 0
+- ` + "`custom_split`" + ` gives ` + "`by_role`" + ` a percentage per role instead, totaling 100; a role nobody at the store works gives its percentage up to the others
+- Payouts are worked in whole cents and always add up to ` + "`amount`" + `, with leftover cents going to the largest remainders
+- The split needs the store's record for its staff; when an apply leaves the store untouched, ` + "`shares`" + ` and ` + "`payouts`" + ` are null until the next refresh splits the jar
+
+*Coins in a glass jar,*
+*Counted out at closing time,*
+*Everyone gets some.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_store whose staff share the tips",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the jar, unique within its store, such as the register it sits by",
+				Required:            true,
+			},
+			"amount": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				MarkdownDescription: "Tips in the jar, in dollars, rounded to the cent; may not be negative",
+				Required:            true,
+			},
+			"split": schema.StringAttribute{
+				MarkdownDescription: "How the tips are split: equal, by_hours, or by_role. Defaults to equal",
+				Optional:            true,
+			},
+			"custom_split": schema.MapAttribute{
+				ElementType:         types.NumberType,
+				MarkdownDescription: "Map of role (cook, cashier, janitor, manager) to its percentage of the jar with `split = \"by_role\"`, replacing the default role weights. The percentages must total 100",
+				Optional:            true,
+			},
+			"shares": schema.MapAttribute{
+				ElementType:         types.NumberType,
+				Computed:            true,
+				MarkdownDescription: "Map of staff member ID to their percentage of the jar, to two decimal places. Null until the provider has read the store, which an apply that leaves the store unchanged doesn't; the next refresh fills it in",
+			},
+			"payouts": schema.MapAttribute{
+				ElementType:         MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Map of staff member ID to their payout in dollars. The payouts add up to `amount`. Null until the provider has read the store, like `shares`",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Tip jar identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *TipJarResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *TipJarResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TipJarResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	store, storeKnown, diags := lookupReference[StoreResourceModel](r.client, path.Root("store_id"), data.StoreId.ValueString(), "store")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setPayouts(ctx, &data, store, storeKnown)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(r.newId(data))
+
+	tflog.Trace(ctx, "created a tip jar resource", map[string]any{
+		"id":    data.Id.ValueString(),
+		"staff": len(data.Payouts.Elements()),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TipJarResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TipJarResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Re-split for the store's current staff, or keep the last split when
+	// the store's record is not known
+	if store, ok := LookupRecord[StoreResourceModel](r.client.Registry, data.StoreId.ValueString()); ok {
+		resp.Diagnostics.Append(r.setPayouts(ctx, &data, store, true)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TipJarResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TipJarResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state TipJarResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	store, storeKnown, diags := lookupReference[StoreResourceModel](r.client, path.Root("store_id"), data.StoreId.ValueString(), "store")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setPayouts(ctx, &data, store, storeKnown)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.StoreId.Equal(state.StoreId) || !data.Name.Equal(state.Name) {
+		data.Id = types.StringValue(r.newId(data))
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TipJarResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TipJarResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted a tip jar resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *TipJarResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// newId returns the ID of a store's tip jar.
+func (r *TipJarResource) newId(data TipJarResourceModel) string {
+	name := data.Name.ValueString()
+	store := r.client.KindFromId(data.StoreId.ValueString(), "store")
+	return r.client.NewId("tip-jar", fmt.Sprintf("%s-%s-%d", store, name, len(name)))
+}
+
+// setPayouts validates the tip jar's amount and split rule and splits the
+// amount between the staff of store into shares and payouts. Without the
+// store's record, when storeKnown is false, the shares and payouts are null.
+func (r *TipJarResource) setPayouts(ctx context.Context, data *TipJarResourceModel, store StoreResourceModel, storeKnown bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	amount := data.Amount.ValueBigFloat()
+	if amount.Sign() < 0 {
+		diags.AddAttributeError(
+			path.Root("amount"),
+			"Invalid Tip Amount",
+			fmt.Sprintf("amount may not be negative, got %s.", amount.Text('f', -1)),
+		)
+	}
+
+	split := defaultTipSplit
+	if !data.Split.IsNull() {
+		split = data.Split.ValueString()
+	}
+	if !slices.Contains(tipSplits, split) {
+		diags.AddAttributeError(
+			path.Root("split"),
+			"Invalid Tip Split",
+			fmt.Sprintf("split must be one of %s, got %q.%s", strings.Join(tipSplits, ", "), split, didYouMean(split, tipSplits)),
+		)
+	}
+
+	var percents map[string]float64
+	if !data.CustomSplit.IsNull() {
+		if split != "by_role" {
+			diags.AddAttributeError(
+				path.Root("custom_split"),
+				"Custom Split Needs by_role",
+				fmt.Sprintf("custom_split gives each role a percentage of the jar, so it only applies with split = \"by_role\", not %q.", split),
+			)
+		}
+		var custom map[string]types.Number
+		diags.Append(data.CustomSplit.ElementsAs(ctx, &custom, false)...)
+		var splitDiags diag.Diagnostics
+		percents, splitDiags = customTipSplit(custom)
+		diags.Append(splitDiags...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	// The staff are the store's, so the jar is split on the next refresh
+	if !storeKnown {
+		data.Shares = types.MapNull(types.NumberType)
+		data.Payouts = types.MapNull(MoneyType{})
+		return diags
+	}
+
+	staff, staffDiags := tipStaff(ctx, r.client.Registry, store)
+	diags.Append(staffDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	weights := tipWeights(staff, split, percents)
+	var total float64
+	for _, weight := range weights {
+		total += weight
+	}
+	if total <= 0 {
+		diags.AddAttributeError(
+			path.Root("split"),
+			"Nobody Shares the Tips",
+			fmt.Sprintf("Nobody at %s would get any of the tips split %s: the store has no cooks, employees or janitors, or none that the split gives a share. Add staff to the store or choose another split.",
+				data.StoreId.ValueString(), split),
+		)
+		return diags
+	}
+
+	cents := splitCents(data.Amount.Cents(), weights)
+	shares := make(map[string]attr.Value, len(staff))
+	payouts := make(map[string]attr.Value, len(staff))
+	for i, staffer := range staff {
+		shares[staffer.Id] = types.NumberValue(big.NewFloat(math.Round(weights[i]/total*10000) / 100))
+		payouts[staffer.Id] = NewMoneyCents(cents[i])
+	}
+
+	var valueDiags diag.Diagnostics
+	data.Shares, valueDiags = types.MapValue(types.NumberType, shares)
+	diags.Append(valueDiags...)
+	data.Payouts, valueDiags = types.MapValue(MoneyType{}, payouts)
+	diags.Append(valueDiags...)
+	return diags
+}
+
+// customTipSplit validates a custom_split and returns its percentages by
+// role.
+func customTipSplit(custom map[string]types.Number) (map[string]float64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	percents := make(map[string]float64, len(custom))
+	total := new(big.Float)
+	for _, role := range slices.Sorted(maps.Keys(custom)) {
+		percent := custom[role]
+		if percent.IsNull() || percent.IsUnknown() {
+			continue
+		}
+		if !slices.Contains(employeeRoles, role) {
+			diags.AddAttributeError(
+				path.Root("custom_split").AtMapKey(role),
+				"Unknown Role",
+				fmt.Sprintf("%q is not a staff role. Roles are: %s.%s", role, strings.Join(employeeRoles, ", "), didYouMean(role, employeeRoles)),
+			)
+			continue
+		}
+		if percent.ValueBigFloat().Sign() < 0 {
+			diags.AddAttributeError(
+				path.Root("custom_split").AtMapKey(role),
+				"Invalid Custom Split",
+				fmt.Sprintf("The %s percentage can't be negative, got %s.", role, percent.ValueBigFloat().String()),
+			)
+			continue
+		}
+		total.Add(total, percent.ValueBigFloat())
+		percents[role], _ = percent.ValueBigFloat().Float64()
+	}
+	if !diags.HasError() && total.Cmp(big.NewFloat(100)) != 0 {
+		diags.AddAttributeError(
+			path.Root("custom_split"),
+			"Invalid Custom Split",
+			fmt.Sprintf("The custom_split percentages total %s, but must total 100.", total.Text('f', -1)),
+		)
+	}
+	return percents, diags
+}
+
+// tipStaff returns the staff sharing store's tip jar, sorted by ID: its cooks,
+// employees and janitors, with their role and weekly hours from the registry.
+// Staff whose record is not known are estimated at a standard week, with
+// store employees estimated as cooks, like employeeStaffing does.
+func tipStaff(ctx context.Context, registry *Registry, store StoreResourceModel) ([]tipStaffer, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	staffIds := func(ids types.Set) []string {
+		var elements []string
+		if !ids.IsNull() && !ids.IsUnknown() {
+			diags.Append(ids.ElementsAs(ctx, &elements, false)...)
+		}
+		return elements
+	}
+
+	byId := map[string]tipStaffer{}
+	for _, id := range staffIds(store.CookIds) {
+		hours := float64(cookStandardWeek)
+		if cook, ok := LookupRecord[CookResourceModel](registry, id); ok && !cook.HoursPerWeek.IsNull() {
+			hours, _ = cook.HoursPerWeek.ValueBigFloat().Float64()
+		}
+		byId[id] = tipStaffer{Id: id, Role: "cook", Hours: hours}
+	}
+	for _, id := range staffIds(store.EmployeeIds) {
+		role := "cook"
+		if employee, ok := LookupRecord[EmployeeResourceModel](registry, id); ok {
+			role = employee.Role.ValueString()
+		}
+		byId[id] = tipStaffer{Id: id, Role: role, Hours: cookStandardWeek}
+	}
+	for _, id := range staffIds(store.JanitorIds) {
+		byId[id] = tipStaffer{Id: id, Role: "janitor", Hours: float64(janitorShifts(registry, []string{id}) * cookHoursPerDay)}
+	}
+
+	staff := slices.Collect(maps.Values(byId))
+	slices.SortFunc(staff, func(a, b tipStaffer) int {
+		return strings.Compare(a.Id, b.Id)
+	})
+	return staff, diags
+}
+
+// tipWeights returns how much of the jar each staff member is owed under the
+// split rule, relative to the others. With percents, by_role shares each
+// role's percentage equally between the staff in that role.
+func tipWeights(staff []tipStaffer, split string, percents map[string]float64) []float64 {
+	inRole := map[string]int{}
+	for _, staffer := range staff {
+		inRole[staffer.Role]++
+	}
+
+	weights := make([]float64, len(staff))
+	for i, staffer := range staff {
+		switch {
+		case split == "by_hours":
+			weights[i] = staffer.Hours
+		case split == "by_role" && percents != nil:
+			weights[i] = percents[staffer.Role] / float64(inRole[staffer.Role])
+		case split == "by_role":
+			weights[i] = float64(roleTipPoints[staffer.Role])
+		default:
+			weights[i] = 1
+		}
+	}
+	return weights
+}
+
+// splitCents splits totalCents in proportion to weights, which must have a
+// positive sum, into whole cents that add up to it exactly. Each share is
+// rounded down, and the leftover cents go one each to the shares with the
+// largest remainders, ties to the first.
+func splitCents(totalCents int64, weights []float64) []int64 {
+	var sum float64
+	for _, weight := range weights {
+		sum += weight
+	}
+
+	cents := make([]int64, len(weights))
+	remainders := make([]float64, len(weights))
+	leftover := totalCents
+	for i, weight := range weights {
+		exact := float64(totalCents) * weight / sum
+		cents[i] = int64(math.Floor(exact))
+		remainders[i] = exact - float64(cents[i])
+		leftover -= cents[i]
+	}
+
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(remainders[b], remainders[a])
+	})
+	for i := 0; leftover > 0 && len(order) > 0; i = (i + 1) % len(order) {
+		cents[order[i]]++
+		leftover--
+	}
+	return cents
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSplitCents(t *testing.T) {
+	tests := []struct {
+		total   int64
+		weights []float64
+		want    []int64
+	}{
+		{10000, []float64{1, 1}, []int64{5000, 5000}},
+		{10000, []float64{1, 1, 1}, []int64{3334, 3333, 3333}},
+		{10001, []float64{20, 40}, []int64{3334, 6667}},
+		{100, []float64{3, 0, 1}, []int64{75, 0, 25}},
+		{0, []float64{1, 2}, []int64{0, 0}},
+	}
+	for _, test := range tests {
+		got := splitCents(test.total, test.weights)
+		if !slices.Equal(got, test.want) {
+			t.Errorf("splitCents(%d, %v) = %v, want %v", test.total, test.weights, got, test.want)
+		}
+	}
+}
+
+func TestTipWeights(t *testing.T) {
+	staff := []tipStaffer{
+		{Id: "cook-a", Role: "cook", Hours: 20},
+		{Id: "cook-b", Role: "cook", Hours: 40},
+		{Id: "employee-c", Role: "cashier", Hours: 40},
+		{Id: "employee-m", Role: "manager", Hours: 40},
+	}
+	tests := map[string]struct {
+		split    string
+		percents map[string]float64
+		want     []float64
+	}{
+		"equal":    {"equal", nil, []float64{1, 1, 1, 1}},
+		"by_hours": {"by_hours", nil, []float64{20, 40, 40, 40}},
+		"by_role":  {"by_role", nil, []float64{2, 2, 3, 0}},
+		"custom":   {"by_role", map[string]float64{"cook": 60, "cashier": 30, "janitor": 10}, []float64{30, 30, 30, 0}},
+	}
+	for name, test := range tests {
+		if got := tipWeights(staff, test.split, test.percents); !slices.Equal(got, test.want) {
+			t.Errorf("%s: tipWeights = %v, want %v", name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &TranslateItemFunction{}
+
+func NewTranslateItemFunction() function.Function {
+	return &TranslateItemFunction{}
+}
+
+// TranslateItemFunction defines the function implementation.
+type TranslateItemFunction struct{}
+
+func (f *TranslateItemFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "translate_item"
+}
+
+func (f *TranslateItemFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Translates a menu item's name into another language",
+		MarkdownDescription: `Looks a menu item up in the provider's translation table, so a configuration can render the menu in several languages without keeping its own copy of the translations. Items are named by their ` + "`hw_menu`" + ` key, such as ` + "`sandwich`" + ` or ` + "`dogtreat_small`" + `.
+
+**Example Usage:**
+
+` + "```hcl" + `
+data "hw_menu" "today" {}
+
+output "menu_es" {
+  value = {
+    for item, price in data.hw_menu.today.prices :
+    provider::hw::translate_item(item, "es") => price
+  }
+}
+
+output "soup_fr" {
+  value = provider::hw::translate_item("soup", "fr-CA") # "soupe"
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates a **lookup table exposed as a function**
+- Locales: en, es, fr, de, nl. Case and region are ignored, so ` + "`es-MX`" + ` reads as ` + "`es`" + `
+- An item the table doesn't know comes back unchanged, so new items show up untranslated rather than failing the plan; an unknown locale is an argument error
+
+*Soup, sopa, soupe, Suppe,*
+*Four words for the same warm bowl,*
+*Served in every tongue.*`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "Menu item to translate, by its `hw_menu` key",
+			},
+			function.StringParameter{
+				Name:                "locale",
+				MarkdownDescription: "Language to translate into: en, es, fr, de, or nl, optionally with a region such as `es-MX`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *TranslateItemFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name, locale string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name, &locale))
+	if resp.Error != nil {
+		return
+	}
+
+	language, ok := menuLocale(locale)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("locale must be one of %s, got %q.%s", strings.Join(menuLocales, ", "), locale, didYouMean(locale, menuLocales)))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, translateItem(name, language)))
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TrashDataSource{}
+
+func NewTrashDataSource() datasource.DataSource {
+	return &TrashDataSource{}
+}
+
+// TrashDataSource defines the data source implementation.
+type TrashDataSource struct {
+	client *ProviderConfig
+}
+
+// TrashDataSourceModel describes the data source data model.
+type TrashDataSourceModel struct {
+	Records types.List   `tfsdk:"records"`
+	Id      types.String `tfsdk:"id"`
+}
+
+// trashedRecordAttrTypes are the attribute types of a records list element.
+var trashedRecordAttrTypes = map[string]attr.Type{
+	"id":            types.StringType,
+	"resource_type": types.StringType,
+	"deleted_at":    types.StringType,
+	"expires_at":    types.StringType,
+}
+
+// trashedRecordModel is an element of the records list.
+type trashedRecordModel struct {
+	Id           string `tfsdk:"id"`
+	ResourceType string `tfsdk:"resource_type"`
+	DeletedAt    string `tfsdk:"deleted_at"`
+	ExpiresAt    string `tfsdk:"expires_at"`
+}
+
+func (d *TrashDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trash"
+}
+
+func (d *TrashDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `The provider's recycle bin. With the provider's ` + "`trash_retention`" + ` set, destroying a resource moves its backend record here instead of erasing it, and the ` + "`hw_restore`" + ` action can bring it back until the retention window ends, the way many real APIs soft-delete.
+
+**Example Usage:**
+
+` + "```hcl" + `
+provider "hw" {
+  backend_path    = "hashiwich.json"
+  trash_retention = "1h"
+}
+
+data "hw_trash" "bin" {}
+
+output "recently_deleted" {
+  value = data.hw_trash.bin.records[*].id
+}
+
+action "hw_restore" "old_store" {
+  config {
+    id = "store-downtown-8"
+  }
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates **soft delete**: a destroyed resource's record stays in the trash, not in Terraform state
+- Only resources that keep a backend record (the ones other resources can reference by ID) are trashed
+- Restoring brings the record back to the backend; ` + "`terraform import`" + ` it to manage it with Terraform again
+- Recreating a resource with the same ID drops its trashed record
+- The trash is kept in the provider's ` + "`backend_path`" + ` file, which ` + "`trash_retention`" + ` requires, so a later command can list and restore what an earlier one destroyed
+- The data source is read when the plan is made, so it lists what earlier commands destroyed, not what the same apply destroys
+
+*Crumbs swept to the bin,*
+*Lid still open for an hour,*
+*Second chance for lunch.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"records": schema.ListNestedAttribute{
+				MarkdownDescription: "Trashed records whose retention window is still open, sorted by ID",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "ID of the deleted resource",
+							Computed:            true,
+						},
+						"resource_type": schema.StringAttribute{
+							MarkdownDescription: "Resource type, such as `hw_store`",
+							Computed:            true,
+						},
+						"deleted_at": schema.StringAttribute{
+							MarkdownDescription: "When the resource was deleted, in RFC 3339 format",
+							Computed:            true,
+						},
+						"expires_at": schema.StringAttribute{
+							MarkdownDescription: "When the record leaves the trash for good, in RFC 3339 format",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Data source identifier",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *TrashDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	d.client = config
+}
+
+func (d *TrashDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TrashDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var registry *Registry
+	if d.client != nil {
+		registry = d.client.Registry
+	}
+
+	records := []trashedRecordModel{}
+	for _, trashed := range registry.ListTrash() {
+		records = append(records, trashedRecordModel{
+			Id:           trashed.Id,
+			ResourceType: trashed.ResourceType,
+			DeletedAt:    trashed.DeletedAt.UTC().Format(time.RFC3339),
+			ExpiresAt:    trashed.ExpiresAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	recordsValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: trashedRecordAttrTypes}, records)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Records = recordsValue
+	data.Id = types.StringValue("trash")
+
+	tflog.Trace(ctx, "read trash data source", map[string]any{
+		"count": len(records),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+)
+
+// unitSizes holds every unit convert_units understands, as its size in the
+// base unit of its dimension: grams for mass (g, kg, oz, lb) and milliliters
+// for volume (ml, l). Sizes are exact decimal strings, so conversions are
+// carried out in rational arithmetic and 16 oz is exactly 1 lb.
+var unitSizes = map[string]struct {
+	dimension string
+	size      string
+}{
+	"g":  {dimension: "mass", size: "1"},
+	"kg": {dimension: "mass", size: "1000"},
+	"oz": {dimension: "mass", size: "28.349523125"},
+	"lb": {dimension: "mass", size: "453.59237"},
+	"ml": {dimension: "volume", size: "1"},
+	"l":  {dimension: "volume", size: "1000"},
+}
+
+// unitNames returns the units convert_units understands, sorted.
+func unitNames() []string {
+	names := make([]string, 0, len(unitSizes))
+	for name := range unitSizes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// convertUnits converts value from one unit to another of the same
+// dimension. It fails for units it doesn't know and for conversions between
+// mass and volume, which would need the ingredient's density.
+func convertUnits(value *big.Float, from, to string) (*big.Float, error) {
+	source, ok := unitSizes[from]
+	if !ok {
+		return nil, fmt.Errorf("unknown unit %q; supported units are %s", from, strings.Join(unitNames(), ", "))
+	}
+	target, ok := unitSizes[to]
+	if !ok {
+		return nil, fmt.Errorf("unknown unit %q; supported units are %s", to, strings.Join(unitNames(), ", "))
+	}
+	if source.dimension != target.dimension {
+		return nil, fmt.Errorf("can't convert %s (%s) to %s (%s)", source.dimension, from, target.dimension, to)
+	}
+
+	exact, _ := value.Rat(nil)
+	sourceSize, _ := new(big.Rat).SetString(source.size)
+	targetSize, _ := new(big.Rat).SetString(target.size)
+	exact.Mul(exact, sourceSize)
+	exact.Quo(exact, targetSize)
+	return new(big.Float).SetPrec(53).SetRat(exact), nil
+}
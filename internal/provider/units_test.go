@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestConvertUnits(t *testing.T) {
+	tests := []struct {
+		value    float64
+		from, to string
+		want     string
+	}{
+		{16, "oz", "lb", "1"},
+		{1, "lb", "oz", "16"},
+		{2.5, "kg", "g", "2500"},
+		{453.59237, "g", "lb", "1"},
+		{750, "ml", "l", "0.75"},
+		{3, "l", "l", "3"},
+	}
+
+	for _, tt := range tests {
+		got, err := convertUnits(big.NewFloat(tt.value), tt.from, tt.to)
+		if err != nil {
+			t.Errorf("convertUnits(%v, %q, %q) failed: %s", tt.value, tt.from, tt.to, err)
+			continue
+		}
+		if got.Text('f', -1) != tt.want {
+			t.Errorf("convertUnits(%v, %q, %q) = %s, want %s", tt.value, tt.from, tt.to, got.Text('f', -1), tt.want)
+		}
+	}
+
+	for _, units := range [][2]string{{"ml", "g"}, {"lb", "l"}, {"cup", "ml"}, {"g", "ton"}} {
+		if _, err := convertUnits(big.NewFloat(1), units[0], units[1]); err == nil {
+			t.Errorf("convertUnits(1, %q, %q) succeeded, want an error", units[0], units[1])
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// versionAttribute is the version attribute of resources with optimistic
+// locking.
+func versionAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "Version of the resource's record, like an HTTP etag: it goes up with every change to the record, including changes by actions or drift. An update fails with a conflict when the record has moved past the version in state; refresh and plan again",
+	}
+}
+
+// checkVersion fails an update of the record stored under id when the
+// registry has it at a version other than expected, the version in state,
+// because something changed the record since Terraform last read it. Records
+// the registry doesn't track pass.
+func checkVersion(registry *Registry, id string, expected types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	current, ok := registry.Version(id)
+	if !ok || expected.IsNull() || expected.IsUnknown() || current == expected.ValueInt64() {
+		return diags
+	}
+
+	diags.AddAttributeError(
+		path.Root("version"),
+		"Version Conflict",
+		fmt.Sprintf("%s is at version %d, but this update expected version %d: the record changed after Terraform last read it, by an action or drift. Refresh the state and plan the change again.",
+			id, current, expected.ValueInt64()),
+	)
+	return diags
+}
+
+// nextVersion returns the version an update moves a record to from version,
+// the one in state. Records without a version are at version 1.
+func nextVersion(version types.Int64) types.Int64 {
+	return types.Int64Value(max(version.ValueInt64(), 1) + 1)
+}
+
+// refreshVersion returns the version a Read reports for the record stored
+// under id: the registry's when it tracks the record, or else the one in
+// state, which starts the registry tracking it. Records without a version,
+// such as imported ones, are at version 1.
+func refreshVersion(registry *Registry, id string, version types.Int64) types.Int64 {
+	current, ok := registry.Version(id)
+	if !ok {
+		current = max(version.ValueInt64(), 1)
+		registry.SetVersion(id, current)
+	}
+	return types.Int64Value(current)
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestVersion(t *testing.T) {
+	registry := NewRegistry()
+	id := "cook-bob-3"
+
+	// A record the registry doesn't track yet takes the version in state,
+	// and passes any update
+	if got := refreshVersion(registry, id, types.Int64Null()); got.ValueInt64() != 1 {
+		t.Errorf("refreshVersion of an unversioned record = %s, want 1", got)
+	}
+	if diags := checkVersion(registry, "cook-alice-5", types.Int64Value(7)); diags.HasError() {
+		t.Errorf("checkVersion of an untracked record failed: %v", diags)
+	}
+
+	// An update at the tracked version passes and moves it on
+	if diags := checkVersion(registry, id, types.Int64Value(1)); diags.HasError() {
+		t.Errorf("checkVersion at the current version failed: %v", diags)
+	}
+	next := nextVersion(types.Int64Value(1))
+	registry.SetVersion(id, next.ValueInt64())
+	if got, _ := registry.Version(id); got != 2 {
+		t.Errorf("version after update = %d, want 2", got)
+	}
+
+	// A change made elsewhere leaves the state behind until it's refreshed
+	if got := registry.Bump(id); got != 3 {
+		t.Errorf("Bump = %d, want 3", got)
+	}
+	diags := checkVersion(registry, id, next)
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Version Conflict" {
+		t.Errorf("checkVersion behind the registry = %v, want a Version Conflict", diags)
+	}
+	if got := refreshVersion(registry, id, next); got.ValueInt64() != 3 {
+		t.Errorf("refreshVersion = %s, want 3", got)
+	}
+
+	registry.Delete(id)
+	if _, ok := registry.Version(id); ok {
+		t.Errorf("Delete kept the record's version")
+	}
+}
@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &WaitlistResource{}
+var _ resource.ResourceWithImportState = &WaitlistResource{}
+
+func NewWaitlistResource() resource.Resource {
+	return &WaitlistResource{}
+}
+
+type WaitlistResource struct {
+	client *ProviderConfig
+}
+
+type WaitlistResourceModel struct {
+	StoreId              types.String `tfsdk:"store_id"`
+	Name                 types.String `tfsdk:"name"`
+	Position             types.Int64  `tfsdk:"position"`
+	EstimatedWaitMinutes types.Number `tfsdk:"estimated_wait_minutes"`
+	Tags                 types.Map    `tfsdk:"tags"`
+	TagsAll              types.Map    `tfsdk:"tags_all"`
+	Haiku                types.String `tfsdk:"haiku"`
+	Id                   types.String `tfsdk:"id"`
+}
+
+func (r *WaitlistResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_waitlist"
+}
+
+func (r *WaitlistResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `A place in line for a table at a busy store. The entry joins the back of the store's queue and moves up on its own, one place on every refresh, while the estimated wait shrinks with it.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_waitlist" "smith" {
+  store_id = hw_store.main.id
+  name     = "Smith"
+  # position computed as 1 (first in line)
+}
+
+resource "hw_waitlist" "jones" {
+  store_id = hw_store.main.id
+  name     = "Jones"
+  # position computed as 2, estimated_wait_minutes from the store's customers_per_hour
+}
+
+output "jones_wait" {
+  value = "${hw_waitlist.jones.estimated_wait_minutes} minutes"
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates **computed values that change on Read**, simulating a queue that moves between runs
+- New entries join the back of the store's waitlist
+- Each refresh moves the entry up one place; position 0 means the party has been seated
+- ` + "`estimated_wait_minutes`" + ` is position ÷ the store's ` + "`customers_per_hour`" + `, in whole minutes
+
+*Names on a clipboard,*
+*One by one the line moves up,*
+*Your table is set.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the hw_store to wait at",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name the party is waiting under",
+				Required:            true,
+			},
+			"position": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Place in the store's queue (1 is next). Moves up one place on each refresh; 0 means the party has been seated",
+			},
+			"estimated_wait_minutes": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Estimated minutes until the party is seated: `position` ÷ the store's `customers_per_hour`, rounded up. Null until the provider has read the store, which an apply that leaves the store unchanged doesn't; the next refresh fills it in",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Waitlist entry identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *WaitlistResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *WaitlistResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WaitlistResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	store, storeKnown, diags := lookupReference[StoreResourceModel](r.client, path.Root("store_id"), data.StoreId.ValueString(), "store")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if storeKnown && store.CustomersPerHour.ValueBigFloat().Sign() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("store_id"),
+			"Store Not Seating",
+			fmt.Sprintf("%s serves 0 customers per hour, so nobody on its waitlist would ever be seated. See the store's bottleneck_advice.", data.StoreId.ValueString()),
+		)
+		return
+	}
+
+	// Join the back of the store's queue
+	position := int64(1)
+	for _, entry := range ListRecords[WaitlistResourceModel](r.client.Registry) {
+		if !entry.StoreId.Equal(data.StoreId) {
+			continue
+		}
+		if other := entry.Position.ValueInt64(); other >= position {
+			position = other + 1
+		}
+	}
+	data.Position = types.Int64Value(position)
+
+	// Without the store's record the wait is estimated on the next refresh
+	data.EstimatedWaitMinutes = types.NumberNull()
+	if storeKnown {
+		data.EstimatedWaitMinutes = types.NumberValue(waitMinutes(position, store.CustomersPerHour.ValueBigFloat()))
+	}
+
+	id := r.client.NewId("waitlist", fmt.Sprintf("%s-%d", data.Name.ValueString(), len(data.Name.ValueString())))
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created a waitlist resource", map[string]any{
+		"id":       data.Id.ValueString(),
+		"position": position,
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WaitlistResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WaitlistResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The line moves between runs: move up one place
+	previous := data.Position.ValueInt64()
+	position := max(previous-1, 0)
+	data.Position = types.Int64Value(position)
+
+	// Re-estimate from the store, or shrink the previous estimate in
+	// proportion when the store's record is not known
+	if store, ok := LookupRecord[StoreResourceModel](r.client.Registry, data.StoreId.ValueString()); ok && store.CustomersPerHour.ValueBigFloat().Sign() > 0 {
+		data.EstimatedWaitMinutes = types.NumberValue(waitMinutes(position, store.CustomersPerHour.ValueBigFloat()))
+	} else if previous > 0 && isSet(data.EstimatedWaitMinutes) {
+		wait := new(big.Float).Mul(data.EstimatedWaitMinutes.ValueBigFloat(), big.NewFloat(float64(position)))
+		data.EstimatedWaitMinutes = types.NumberValue(ceilFloat(wait.Quo(wait, big.NewFloat(float64(previous)))))
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WaitlistResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data WaitlistResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state WaitlistResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Renaming the party keeps its place in line
+	data.Position = state.Position
+	data.EstimatedWaitMinutes = state.EstimatedWaitMinutes
+
+	if !data.Name.Equal(state.Name) {
+		id := r.client.NewId("waitlist", fmt.Sprintf("%s-%d", data.Name.ValueString(), len(data.Name.ValueString())))
+		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WaitlistResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WaitlistResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted a waitlist resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *WaitlistResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// waitMinutes estimates the minutes until the party at position is seated by
+// a store serving customersPerHour, rounded up to whole minutes.
+func waitMinutes(position int64, customersPerHour *big.Float) *big.Float {
+	wait := new(big.Float).Mul(big.NewFloat(float64(position)), big.NewFloat(60))
+	return ceilFloat(wait.Quo(wait, customersPerHour))
+}
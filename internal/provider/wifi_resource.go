@@ -0,0 +1,315 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &WifiResource{}
+var _ resource.ResourceWithImportState = &WifiResource{}
+
+func NewWifiResource() resource.Resource {
+	return &WifiResource{}
+}
+
+type WifiResource struct {
+	client *ProviderConfig
+}
+
+type WifiResourceModel struct {
+	Ssid            types.String `tfsdk:"ssid"`
+	SpeedMbps       types.Int64  `tfsdk:"speed_mbps"`
+	CaptivePortal   types.Bool   `tfsdk:"captive_portal"`
+	Cost            MoneyValue   `tfsdk:"cost"`
+	DwellTimeFactor types.Number `tfsdk:"dwell_time_factor"`
+	PriceMultiplier types.Number `tfsdk:"price_multiplier"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+	Haiku           types.String `tfsdk:"haiku"`
+	Id              types.String `tfsdk:"id"`
+}
+
+// wifiDwellTiers are how much longer customers linger, in percent, on wifi
+// of at least each speed in Mbps, ordered from the fastest down. A captive
+// portal takes wifiPortalDwellPercent off.
+var wifiDwellTiers = []struct {
+	minMbps int64
+	percent int64
+}{
+	{minMbps: 50, percent: 20},
+	{minMbps: 10, percent: 10},
+	{minMbps: 0, percent: 5},
+}
+
+const wifiPortalDwellPercent = 5
+
+// maxSsidLength is the longest network name 802.11 allows, in bytes.
+const maxSsidLength = 32
+
+func (r *WifiResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_wifi"
+}
+
+func (r *WifiResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Free wifi for customers. The faster the network, the longer customers linger over lunch; a store linked with ` + "`wifi_id`" + ` takes on the network's ` + "`dwell_time_factor`" + `.
+
+**Example Usage:**
+
+` + "```hcl" + `
+resource "hw_wifi" "guest" {
+  ssid           = "Hashiwich Guest"
+  speed_mbps     = 100
+  captive_portal = true
+  # cost computed as $70 a month (100 × $0.50 + $20 for the portal)
+  # dwell_time_factor computed as 1.15 (+20% for 50+ Mbps, -5% for the portal)
+}
+
+resource "hw_store" "main" {
+  # ...
+  wifi_id = hw_wifi.guest.id
+}
+` + "```" + `
+
+**Key Concepts:**
+- Demonstrates a **string validator** on ` + "`ssid`" + `: 1 to 32 printable ASCII characters, without leading or trailing spaces
+- Customers stay 5% longer on any wifi, 10% from 10 Mbps, and 20% from 50 Mbps; a captive portal takes 5% off
+- Costs $0.50 per Mbps a month, plus $20 for a captive portal
+
+*Password on the board,*
+*One more coffee, one more tab,*
+*Lunch becomes the day.*`,
+
+		Attributes: map[string]schema.Attribute{
+			"ssid": schema.StringAttribute{
+				MarkdownDescription: "Network name: 1 to 32 printable ASCII characters, without leading or trailing spaces",
+				Required:            true,
+				Validators: []validator.String{
+					ssidValidator{},
+				},
+			},
+			"speed_mbps": schema.Int64Attribute{
+				MarkdownDescription: "Download speed in Mbps (at least 1)",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64RangeValidator{summary: "Invalid Speed", min: 1, max: math.MaxInt64},
+				},
+			},
+			"captive_portal": schema.BoolAttribute{
+				MarkdownDescription: "Whether customers must accept terms on a sign-in page before connecting",
+				Optional:            true,
+			},
+			"cost": schema.NumberAttribute{
+				CustomType:          MoneyType{},
+				Computed:            true,
+				MarkdownDescription: "Monthly cost in dollars (speed_mbps × $0.50, plus $20 for a captive portal)",
+			},
+			"dwell_time_factor": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "How much longer customers stay with this wifi, as a multiplier (1.2 means 20% longer)",
+			},
+			"price_multiplier": schema.NumberAttribute{
+				Computed:            true,
+				MarkdownDescription: "Inflation multiplier applied to built-in prices for the provider's `price_year` (1 for the 2024 base year)",
+			},
+			"tags":     tagsAttribute(),
+			"tags_all": tagsAllAttribute(),
+			"haiku":    haikuAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Wifi identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *WifiResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	config, ok := req.ProviderData.(*ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			"Expected *ProviderConfig, got something else",
+		)
+		return
+	}
+
+	r.client = config
+}
+
+func (r *WifiResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WifiResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setCostAndDwell(&data)
+
+	ssid := data.Ssid.ValueString()
+	id := r.client.NewId("wifi", fmt.Sprintf("%s-%d", ssid, len(ssid)))
+	data.Id = types.StringValue(id)
+
+	tflog.Trace(ctx, "created a wifi resource", map[string]any{
+		"id":                data.Id.ValueString(),
+		"dwell_time_factor": data.DwellTimeFactor.ValueBigFloat().String(),
+	})
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WifiResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WifiResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost and dwell time
+	r.setCostAndDwell(&data)
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WifiResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data WifiResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Recalculate cost and dwell time
+	r.setCostAndDwell(&data)
+
+	var state WifiResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Ssid.Equal(state.Ssid) {
+		ssid := data.Ssid.ValueString()
+		id := r.client.NewId("wifi", fmt.Sprintf("%s-%d", ssid, len(ssid)))
+		data.Id = types.StringValue(id)
+		r.client.Registry.Delete(state.Id.ValueString())
+	} else {
+		data.Id = state.Id
+	}
+
+	data.TagsAll = r.client.TagsAll(data.Tags)
+	r.client.Registry.Put(data.Id.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WifiResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WifiResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.client.Registry.Delete(data.Id.ValueString())
+
+	tflog.Trace(ctx, "deleted a wifi resource", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *WifiResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setCostAndDwell computes the monthly cost and the dwell time factor.
+func (r *WifiResource) setCostAndDwell(data *WifiResourceModel) {
+	mbps := data.SpeedMbps.ValueInt64()
+	totalCost := new(big.Float).Mul(big.NewFloat(float64(mbps)), r.client.BasePrice("wifi_mbps"))
+	var percent int64
+	for _, tier := range wifiDwellTiers {
+		if mbps >= tier.minMbps {
+			percent = tier.percent
+			break
+		}
+	}
+	if data.CaptivePortal.ValueBool() {
+		totalCost.Add(totalCost, r.client.BasePrice("wifi_portal"))
+		percent -= wifiPortalDwellPercent
+	}
+
+	data.Cost = r.client.Price(ApplyUpcharge(totalCost, r.client.Upcharge))
+	data.DwellTimeFactor = types.NumberValue(new(big.Float).Quo(big.NewFloat(float64(100+percent)), big.NewFloat(100)))
+	data.PriceMultiplier = types.NumberValue(r.client.PriceMultiplier())
+}
+
+// ssidValidator checks that a network name is 1 to 32 printable ASCII
+// characters without leading or trailing spaces.
+type ssidValidator struct{}
+
+var _ validator.String = ssidValidator{}
+
+func (v ssidValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("must be 1 to %d printable ASCII characters without leading or trailing spaces", maxSsidLength)
+}
+
+func (v ssidValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ssidValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	ssid := req.ConfigValue.ValueString()
+	var problem string
+	switch {
+	case len(ssid) == 0 || len(ssid) > maxSsidLength:
+		problem = fmt.Sprintf("it is %d characters long", len(ssid))
+	case strings.TrimSpace(ssid) != ssid:
+		problem = "it starts or ends with a space"
+	default:
+		for _, c := range ssid {
+			if c < ' ' || c > '~' {
+				problem = fmt.Sprintf("it contains %q", c)
+				break
+			}
+		}
+	}
+	if problem == "" {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid SSID",
+		fmt.Sprintf("The SSID %q %s, but %s.", ssid, v.Description(ctx), problem),
+	)
+}
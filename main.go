@@ -31,6 +31,11 @@ func main() {
 
 	err := providerserver.Serve(context.Background(), provider.New(version), opts)
 
+	// Write the metrics_file of the configured provider, if any
+	if shutdownErr := provider.Shutdown(); shutdownErr != nil {
+		log.Printf("[WARN] writing provider metrics: %s", shutdownErr)
+	}
+
 	if err != nil {
 		log.Fatal(err.Error())
 	}